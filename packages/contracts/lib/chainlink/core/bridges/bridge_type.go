@@ -10,17 +10,22 @@ import (
 	"strings"
 	"time"
 
+	"github.com/lib/pq"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/bridges/signing"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 // BridgeTypeRequest is the incoming record used to create a BridgeType
 type BridgeTypeRequest struct {
-	Name                   BridgeName    `json:"name"`
-	URL                    models.WebURL `json:"url"`
-	Confirmations          uint32        `json:"confirmations"`
-	MinimumContractPayment *assets.Link  `json:"minimumContractPayment"`
+	Name                   BridgeName     `json:"name"`
+	URL                    models.WebURL  `json:"url"`
+	Confirmations          uint32         `json:"confirmations"`
+	MinimumContractPayment *assets.Link   `json:"minimumContractPayment"`
+	FallbackURLs           WebURLs        `json:"fallbackURLs"`
+	SigningScheme          signing.Scheme `json:"signingScheme"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -51,7 +56,9 @@ type BridgeTypeAuthentication struct {
 }
 
 // BridgeType is used for external adapters and has fields for
-// the name of the adapter and its URL.
+// the name of the adapter and its URL. FallbackURLs are requested
+// in order when URL fails. Requests are signed with SigningScheme
+// unless it is empty.
 type BridgeType struct {
 	Name                   BridgeName
 	URL                    models.WebURL
@@ -60,10 +67,21 @@ type BridgeType struct {
 	Salt                   string
 	OutgoingToken          string
 	MinimumContractPayment *assets.Link
+	FallbackURLs           WebURLs `db:"fallback_urls"`
+	SigningScheme          signing.Scheme
 	CreatedAt              time.Time
 	UpdatedAt              time.Time
 }
 
+// URLs returns the URL of the bridge followed by its fallback URLs.
+func (bt BridgeType) URLs() []string {
+	urls := []string{bt.URL.String()}
+	for _, u := range bt.FallbackURLs {
+		urls = append(urls, u.String())
+	}
+	return urls
+}
+
 // NewBridgeType returns a bridge type authentication (with plaintext
 // password) and a bridge type (with hashed password, for persisting)
 func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
@@ -92,6 +110,8 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 			Salt:                   salt,
 			OutgoingToken:          outgoingToken,
 			MinimumContractPayment: btr.MinimumContractPayment,
+			FallbackURLs:           btr.FallbackURLs,
+			SigningScheme:          btr.SigningScheme,
 		}, nil
 }
 
@@ -200,6 +220,33 @@ func (t *BridgeName) Scan(value interface{}) error {
 	return nil
 }
 
+// WebURLs is a list of URLs, stored as a text array.
+type WebURLs []models.WebURL
+
+// Value returns this instance serialized for database storage.
+func (u WebURLs) Value() (driver.Value, error) {
+	urls := make(pq.StringArray, len(u))
+	for i, w := range u {
+		urls[i] = w.String()
+	}
+	return urls.Value()
+}
+
+// Scan reads the database value and returns an instance.
+func (u *WebURLs) Scan(value interface{}) error {
+	var urls pq.StringArray
+	if err := urls.Scan(value); err != nil {
+		return fmt.Errorf("unable to convert %v of %T to WebURLs: %w", value, value, err)
+	}
+	*u = make(WebURLs, len(urls))
+	for i, s := range urls {
+		if err := (*u)[i].Scan(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type BridgeResponse struct {
 	DotID      string
 	SpecID     int32
@@ -0,0 +1,382 @@
+package bridges
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/timeutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+const (
+	HealthServiceName = "BridgeHealth"
+
+	probeBatchSize = 1000
+)
+
+// ErrCircuitOpen is returned for requests to a bridge URL whose circuit breaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// BreakerState is the state of the circuit breaker of a bridge URL.
+type BreakerState string
+
+const (
+	// BreakerClosed lets requests through.
+	BreakerClosed BreakerState = "closed"
+	// BreakerOpen fails requests fast, after FailureThreshold consecutive failures.
+	BreakerOpen BreakerState = "open"
+	// BreakerHalfOpen lets a single trial request through once OpenTimeout elapsed, which closes the breaker if it
+	// succeeds, and opens it again otherwise.
+	BreakerHalfOpen BreakerState = "halfOpen"
+)
+
+// HealthStatus is the health of a bridge.
+type HealthStatus string
+
+const (
+	// HealthStatusUnknown is the status of bridges that were neither requested nor probed yet.
+	HealthStatusUnknown HealthStatus = "unknown"
+	// HealthStatusHealthy is the status of bridges whose URLs are all healthy.
+	HealthStatusHealthy HealthStatus = "healthy"
+	// HealthStatusDegraded is the status of bridges with a failing URL, but at least one healthy URL.
+	HealthStatusDegraded HealthStatus = "degraded"
+	// HealthStatusUnhealthy is the status of bridges whose URLs are all failing.
+	HealthStatusUnhealthy HealthStatus = "unhealthy"
+)
+
+// URLHealth is the health of a URL of a bridge.
+type URLHealth struct {
+	URL                 string
+	Breaker             BreakerState
+	ConsecutiveFailures uint32
+	// LastCheckedAt is the time of the last request or probe, or zero if there was none.
+	LastCheckedAt time.Time
+	// LastError is the error of the last request or probe, or empty if it succeeded.
+	LastError string
+}
+
+// BridgeHealth is the health of a bridge, from the outcome of its last requests and probes.
+type BridgeHealth struct {
+	Name   BridgeName
+	Status HealthStatus
+	// URLs holds the health of the URL of the bridge, followed by its fallback URLs.
+	URLs []URLHealth
+}
+
+type urlState struct {
+	breaker             BreakerState
+	consecutiveFailures uint32
+	openedAt            time.Time
+	trialInFlight       bool
+	lastCheckedAt       time.Time
+	lastErr             error
+}
+
+func (s *urlState) failing() bool {
+	return s.breaker != BreakerClosed || s.lastErr != nil
+}
+
+// HealthMonitor tracks the health of the bridge URLs from the outcome of the bridge requests, and of active probes
+// when ProbeInterval is set. It runs a circuit breaker per URL when FailureThreshold is set.
+type HealthMonitor struct {
+	services.Service
+	eng *services.Engine
+
+	orm        ORM
+	cfg        config.BridgeHealth
+	httpClient *http.Client
+
+	mu sync.Mutex
+	// urls holds the state of the URLs of each bridge, keyed by URL
+	urls map[BridgeName]map[string]*urlState
+	// order holds the URLs of each bridge in request order
+	order map[BridgeName][]string
+}
+
+// NewHealthMonitor returns a HealthMonitor probing the bridges of orm with httpClient.
+func NewHealthMonitor(orm ORM, cfg config.BridgeHealth, httpClient *http.Client, lggr logger.Logger) *HealthMonitor {
+	if httpClient == nil {
+		httpClient = &http.Client{}
+	}
+	m := &HealthMonitor{
+		orm:        orm,
+		cfg:        cfg,
+		httpClient: httpClient,
+		urls:       map[BridgeName]map[string]*urlState{},
+		order:      map[BridgeName][]string{},
+	}
+	m.Service, m.eng = services.Config{
+		Name:  HealthServiceName,
+		Start: m.start,
+	}.NewServiceEngine(lggr)
+	return m
+}
+
+func (m *HealthMonitor) start(context.Context) error {
+	if interval := m.cfg.ProbeInterval(); interval > 0 {
+		m.eng.GoTick(timeutil.NewTicker(func() time.Duration { return interval }), m.probeAll)
+	}
+	return nil
+}
+
+// HealthReport reports the health of the monitor, and an error for each bridge whose URLs are all failing.
+func (m *HealthMonitor) HealthReport() map[string]error {
+	report := m.Service.HealthReport()
+	for _, h := range m.Healths() {
+		var err error
+		if h.Status == HealthStatusUnhealthy {
+			err = errors.Errorf("all URLs of bridge %s are failing", h.Name)
+		}
+		report[m.Name()+"."+h.Name.String()] = err
+	}
+	return report
+}
+
+// Allow returns ErrCircuitOpen if the circuit breaker of url of bridge name is open, and nil if a request can be
+// sent. Its outcome must then be reported to Record.
+func (m *HealthMonitor) Allow(name BridgeName, url string) error {
+	if m == nil || m.cfg.FailureThreshold() == 0 {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s := m.state(name, url)
+	switch s.breaker {
+	case BreakerOpen:
+		if time.Since(s.openedAt) < m.cfg.OpenTimeout() {
+			return ErrCircuitOpen
+		}
+		s.breaker = BreakerHalfOpen
+		s.trialInFlight = true
+		return nil
+	case BreakerHalfOpen:
+		if s.trialInFlight {
+			return ErrCircuitOpen
+		}
+		s.trialInFlight = true
+		return nil
+	default:
+		return nil
+	}
+}
+
+// Record records the outcome of a request or probe to url of bridge name, err being nil if it succeeded.
+func (m *HealthMonitor) Record(name BridgeName, url string, err error) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s := m.state(name, url)
+	s.lastCheckedAt = time.Now()
+	s.lastErr = err
+	s.trialInFlight = false
+	if err == nil {
+		if s.breaker != BreakerClosed {
+			m.eng.Infow("Bridge URL recovered, closing circuit breaker", "bridge", name, "url", url)
+		}
+		s.consecutiveFailures = 0
+		s.breaker = BreakerClosed
+		return
+	}
+
+	s.consecutiveFailures++
+	threshold := m.cfg.FailureThreshold()
+	if threshold == 0 {
+		return
+	}
+	if s.breaker == BreakerHalfOpen || (s.breaker == BreakerClosed && s.consecutiveFailures >= threshold) {
+		if s.breaker == BreakerClosed {
+			m.eng.Warnw("Bridge URL is failing, opening circuit breaker", "bridge", name, "url", url, "failures", s.consecutiveFailures, "err", err)
+		}
+		s.breaker = BreakerOpen
+		s.openedAt = time.Now()
+	}
+}
+
+// ReleaseTrial lets another trial request to url of bridge name through, when a request allowed by Allow ended without
+// an outcome saying anything about the health of url, e.g. because it was cancelled.
+func (m *HealthMonitor) ReleaseTrial(name BridgeName, url string) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.state(name, url).trialInFlight = false
+}
+
+// Health returns the health of bridge name, or false if it was neither requested nor probed yet.
+func (m *HealthMonitor) Health(name BridgeName) (BridgeHealth, bool) {
+	if m == nil {
+		return BridgeHealth{}, false
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.urls[name]; !ok {
+		return BridgeHealth{}, false
+	}
+	return m.health(name), true
+}
+
+// Healths returns the health of the bridges that were requested or probed, ordered by name.
+func (m *HealthMonitor) Healths() []BridgeHealth {
+	if m == nil {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	healths := make([]BridgeHealth, 0, len(m.urls))
+	for name := range m.urls {
+		healths = append(healths, m.health(name))
+	}
+	sort.Slice(healths, func(i, j int) bool { return healths[i].Name < healths[j].Name })
+	return healths
+}
+
+// SetURLs sets the URLs of bridge name, in request order, forgetting the state of the URLs it no longer has.
+func (m *HealthMonitor) SetURLs(name BridgeName, urls []string) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if slices.Equal(m.order[name], urls) {
+		return
+	}
+	states := map[string]*urlState{}
+	for _, u := range urls {
+		if s, ok := m.urls[name][u]; ok {
+			states[u] = s
+		} else {
+			states[u] = &urlState{breaker: BreakerClosed}
+		}
+	}
+	m.urls[name] = states
+	m.order[name] = urls
+}
+
+// Forget drops the state of bridge name, once it is deleted.
+func (m *HealthMonitor) Forget(name BridgeName) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.urls, name)
+	delete(m.order, name)
+}
+
+// state returns the state of url of bridge name, creating it if needed. m.mu must be held.
+func (m *HealthMonitor) state(name BridgeName, url string) *urlState {
+	states, ok := m.urls[name]
+	if !ok {
+		states = map[string]*urlState{}
+		m.urls[name] = states
+	}
+	s, ok := states[url]
+	if !ok {
+		s = &urlState{breaker: BreakerClosed}
+		states[url] = s
+		m.order[name] = append(m.order[name], url)
+	}
+	return s
+}
+
+// health returns the health of bridge name. m.mu must be held.
+func (m *HealthMonitor) health(name BridgeName) BridgeHealth {
+	h := BridgeHealth{Name: name, Status: HealthStatusUnknown}
+	var checked, failing int
+	for _, u := range m.order[name] {
+		s := m.urls[name][u]
+		uh := URLHealth{
+			URL:                 u,
+			Breaker:             s.breaker,
+			ConsecutiveFailures: s.consecutiveFailures,
+			LastCheckedAt:       s.lastCheckedAt,
+		}
+		if s.lastErr != nil {
+			uh.LastError = s.lastErr.Error()
+		}
+		if !s.lastCheckedAt.IsZero() {
+			checked++
+			if s.failing() {
+				failing++
+			}
+		}
+		h.URLs = append(h.URLs, uh)
+	}
+	switch {
+	case checked == 0:
+	case failing == 0:
+		h.Status = HealthStatusHealthy
+	case failing < len(h.URLs):
+		h.Status = HealthStatusDegraded
+	default:
+		h.Status = HealthStatusUnhealthy
+	}
+	return h
+}
+
+// probeAll probes the URLs of all bridges.
+func (m *HealthMonitor) probeAll(ctx context.Context) {
+	seen := map[BridgeName]struct{}{}
+	for offset := 0; ; offset += probeBatchSize {
+		bts, _, err := m.orm.BridgeTypes(ctx, offset, probeBatchSize)
+		if err != nil {
+			m.eng.Errorw("Failed to load bridges to probe", "err", err)
+			return
+		}
+		for _, bt := range bts {
+			seen[bt.Name] = struct{}{}
+			urls := bt.URLs()
+			m.SetURLs(bt.Name, urls)
+			for _, u := range urls {
+				m.Record(bt.Name, u, m.probe(ctx, u))
+			}
+		}
+		if len(bts) < probeBatchSize {
+			break
+		}
+	}
+
+	m.mu.Lock()
+	for name := range m.urls {
+		if _, ok := seen[name]; !ok {
+			delete(m.urls, name)
+			delete(m.order, name)
+		}
+	}
+	m.mu.Unlock()
+}
+
+// probe sends a GET request to url. Adapters are only considered failing if they cannot be reached, or respond
+// with a server error, as most do not serve GET requests.
+func (m *HealthMonitor) probe(ctx context.Context, url string) error {
+	ctx, cancel := context.WithTimeout(ctx, m.cfg.ProbeTimeout())
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return errors.Errorf("probe responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package bridges_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+type healthConfig struct {
+	probeInterval    time.Duration
+	failureThreshold uint32
+	openTimeout      time.Duration
+}
+
+func (c healthConfig) ProbeInterval() time.Duration { return c.probeInterval }
+func (c healthConfig) ProbeTimeout() time.Duration  { return 5 * time.Second }
+func (c healthConfig) FailureThreshold() uint32     { return c.failureThreshold }
+func (c healthConfig) OpenTimeout() time.Duration   { return c.openTimeout }
+
+func TestHealthMonitor_CircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	const name = bridges.BridgeName("test")
+	const u = "https://bridge.example.com"
+	errFailed := errors.New("connection refused")
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		m := bridges.NewHealthMonitor(mocks.NewORM(t), healthConfig{}, nil, logger.TestLogger(t))
+		for i := 0; i < 10; i++ {
+			require.NoError(t, m.Allow(name, u))
+			m.Record(name, u, errFailed)
+		}
+		h, ok := m.Health(name)
+		require.True(t, ok)
+		assert.Equal(t, bridges.HealthStatusUnhealthy, h.Status)
+		assert.Equal(t, bridges.BreakerClosed, h.URLs[0].Breaker)
+		assert.Equal(t, uint32(10), h.URLs[0].ConsecutiveFailures)
+	})
+
+	t.Run("opens after consecutive failures", func(t *testing.T) {
+		t.Parallel()
+
+		m := bridges.NewHealthMonitor(mocks.NewORM(t), healthConfig{failureThreshold: 3, openTimeout: time.Hour}, nil, logger.TestLogger(t))
+		for i := 0; i < 2; i++ {
+			require.NoError(t, m.Allow(name, u))
+			m.Record(name, u, errFailed)
+		}
+		// a success resets the failures
+		require.NoError(t, m.Allow(name, u))
+		m.Record(name, u, nil)
+		for i := 0; i < 3; i++ {
+			require.NoError(t, m.Allow(name, u))
+			m.Record(name, u, errFailed)
+		}
+		assert.ErrorIs(t, m.Allow(name, u), bridges.ErrCircuitOpen)
+
+		h, ok := m.Health(name)
+		require.True(t, ok)
+		assert.Equal(t, bridges.BreakerOpen, h.URLs[0].Breaker)
+		assert.Equal(t, errFailed.Error(), h.URLs[0].LastError)
+	})
+
+	t.Run("half-opens after open timeout", func(t *testing.T) {
+		t.Parallel()
+
+		m := bridges.NewHealthMonitor(mocks.NewORM(t), healthConfig{failureThreshold: 1, openTimeout: time.Nanosecond}, nil, logger.TestLogger(t))
+		m.Record(name, u, errFailed)
+		time.Sleep(time.Millisecond)
+
+		// a single trial request is let through
+		require.NoError(t, m.Allow(name, u))
+		assert.ErrorIs(t, m.Allow(name, u), bridges.ErrCircuitOpen)
+		h, _ := m.Health(name)
+		assert.Equal(t, bridges.BreakerHalfOpen, h.URLs[0].Breaker)
+
+		// a failed trial opens the breaker again
+		m.Record(name, u, errFailed)
+		h, _ = m.Health(name)
+		assert.Equal(t, bridges.BreakerOpen, h.URLs[0].Breaker)
+		time.Sleep(time.Millisecond)
+
+		// a successful trial closes it
+		require.NoError(t, m.Allow(name, u))
+		m.Record(name, u, nil)
+		h, _ = m.Health(name)
+		assert.Equal(t, bridges.BreakerClosed, h.URLs[0].Breaker)
+		assert.Equal(t, bridges.HealthStatusHealthy, h.Status)
+		require.NoError(t, m.Allow(name, u))
+	})
+
+	t.Run("releases trials without an outcome", func(t *testing.T) {
+		t.Parallel()
+
+		m := bridges.NewHealthMonitor(mocks.NewORM(t), healthConfig{failureThreshold: 1, openTimeout: time.Nanosecond}, nil, logger.TestLogger(t))
+		m.Record(name, u, errFailed)
+		time.Sleep(time.Millisecond)
+
+		require.NoError(t, m.Allow(name, u))
+		assert.ErrorIs(t, m.Allow(name, u), bridges.ErrCircuitOpen)
+		m.ReleaseTrial(name, u)
+		h, _ := m.Health(name)
+		assert.Equal(t, bridges.BreakerHalfOpen, h.URLs[0].Breaker)
+		require.NoError(t, m.Allow(name, u))
+	})
+}
+
+func TestHealthMonitor_Health(t *testing.T) {
+	t.Parallel()
+
+	const name = bridges.BridgeName("test")
+	m := bridges.NewHealthMonitor(mocks.NewORM(t), healthConfig{}, nil, logger.TestLogger(t))
+
+	_, ok := m.Health(name)
+	assert.False(t, ok)
+
+	m.SetURLs(name, []string{"https://a.example.com", "https://b.example.com"})
+	h, ok := m.Health(name)
+	require.True(t, ok)
+	assert.Equal(t, bridges.HealthStatusUnknown, h.Status)
+	require.Len(t, h.URLs, 2)
+	assert.Equal(t, "https://a.example.com", h.URLs[0].URL)
+	assert.Equal(t, "https://b.example.com", h.URLs[1].URL)
+
+	m.Record(name, "https://a.example.com", errors.New("failed"))
+	m.Record(name, "https://b.example.com", nil)
+	h, _ = m.Health(name)
+	assert.Equal(t, bridges.HealthStatusDegraded, h.Status)
+	assert.NoError(t, m.HealthReport()[bridges.HealthServiceName+".test"])
+
+	m.Record(name, "https://b.example.com", errors.New("failed"))
+	h, _ = m.Health(name)
+	assert.Equal(t, bridges.HealthStatusUnhealthy, h.Status)
+	assert.Error(t, m.HealthReport()[bridges.HealthServiceName+".test"])
+
+	// the state of removed URLs is dropped
+	m.SetURLs(name, []string{"https://b.example.com"})
+	h, _ = m.Health(name)
+	require.Len(t, h.URLs, 1)
+	assert.Equal(t, bridges.HealthStatusUnhealthy, h.Status)
+
+	m.Forget(name)
+	_, ok = m.Health(name)
+	assert.False(t, ok)
+	assert.Empty(t, m.Healths())
+}
+
+func TestHealthMonitor_Probe(t *testing.T) {
+	t.Parallel()
+
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// adapters that do not serve GET requests are still reachable
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}))
+	t.Cleanup(healthy.Close)
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(failing.Close)
+
+	healthyURL, err := url.Parse(healthy.URL)
+	require.NoError(t, err)
+	failingURL, err := url.Parse(failing.URL)
+	require.NoError(t, err)
+
+	orm := mocks.NewORM(t)
+	orm.On("BridgeTypes", mock.Anything, 0, 1000).Return([]bridges.BridgeType{
+		{Name: "a", URL: models.WebURL(*healthyURL)},
+		{Name: "b", URL: models.WebURL(*failingURL), FallbackURLs: bridges.WebURLs{models.WebURL(*healthyURL)}},
+		{Name: "c", URL: models.WebURL(*failingURL)},
+	}, 3, nil).Maybe()
+
+	m := bridges.NewHealthMonitor(orm, healthConfig{probeInterval: testutils.TestInterval}, healthy.Client(), logger.TestLogger(t))
+	servicetest.Run(t, m)
+
+	require.Eventually(t, func() bool {
+		healths := m.Healths()
+		return len(healths) == 3 && healths[2].Status != bridges.HealthStatusUnknown
+	}, testutils.WaitTimeout(t), testutils.TestInterval)
+
+	healths := m.Healths()
+	assert.Equal(t, bridges.HealthStatusHealthy, healths[0].Status)
+	assert.Equal(t, bridges.HealthStatusDegraded, healths[1].Status)
+	assert.Equal(t, bridges.HealthStatusUnhealthy, healths[2].Status)
+	assert.Contains(t, healths[2].URLs[0].LastError, "503")
+
+	report := m.HealthReport()
+	assert.NoError(t, report[bridges.HealthServiceName+".a"])
+	assert.NoError(t, report[bridges.HealthServiceName+".b"])
+	assert.Error(t, report[bridges.HealthServiceName+".c"])
+}
@@ -0,0 +1,31 @@
+// Package signing implements the signing of the requests sent by bridge tasks to external adapters, and their
+// verification by the adapters.
+//
+// Bridges with a signing scheme sign the body of each request along with the bridge name, the request URL, a timestamp
+// and a random nonce, so that adapters can reject forged requests, requests replayed from a previous call, and requests
+// to another bridge or URL replayed to them. The signature is sent in the
+// following headers:
+//
+//	X-Chainlink-Signature-Scheme: the signing scheme, "hmac-sha256" or "csa-ed25519"
+//	X-Chainlink-Timestamp:        the time the request was signed at, in seconds since the Unix epoch
+//	X-Chainlink-Nonce:            32 random hex characters, unique to the request
+//	X-Chainlink-Signature:        the hex encoded signature of the message below
+//	X-Chainlink-Public-Key:       the hex encoded public key of the node, for the csa-ed25519 scheme only
+//
+// The signed message is the concatenation of the scheme version, the name of the bridge, the path and query of the
+// request URL, the timestamp, the nonce and the hex encoded SHA-256 digest of the body, separated by newlines:
+//
+//	v2\n<bridge name>\n<path?query>\n<timestamp>\n<nonce>\n<hex(sha256(body))>
+//
+// The path is "/" if the bridge URL has none. Adapters behind a proxy rewriting the path must verify requests against
+// the path of the bridge URL configured on the node.
+//
+// With the hmac-sha256 scheme, the signature is the HMAC-SHA256 of the message keyed with the outgoing token of the
+// bridge, which the adapter already shares with the node. With the csa-ed25519 scheme, it is the Ed25519 signature of
+// the message by the CSA key of the node, whose public key is listed by `chainlink keys csa list`.
+//
+// Adapters verify requests by recomputing the message from the name of their bridge on the node, the request URL, the
+// headers and the raw body, checking the signature, rejecting timestamps further than a maximum clock skew from their
+// own clock, and rejecting nonces they already saw within that skew. Verifier implements these checks for adapters
+// written in Go.
+package signing
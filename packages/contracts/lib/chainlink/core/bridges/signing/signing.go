@@ -0,0 +1,134 @@
+package signing
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	HeaderScheme    = "X-Chainlink-Signature-Scheme"
+	HeaderTimestamp = "X-Chainlink-Timestamp"
+	HeaderNonce     = "X-Chainlink-Nonce"
+	HeaderSignature = "X-Chainlink-Signature"
+	HeaderPublicKey = "X-Chainlink-Public-Key"
+
+	messageVersion = "v2"
+	nonceSize      = 16
+)
+
+// Scheme is the scheme bridge requests are signed with.
+type Scheme string
+
+const (
+	// SchemeNone disables the signing of requests.
+	SchemeNone Scheme = ""
+	// SchemeHMACSHA256 signs requests with HMAC-SHA256, keyed with the outgoing token of the bridge.
+	SchemeHMACSHA256 Scheme = "hmac-sha256"
+	// SchemeCSAEd25519 signs requests with the CSA key of the node.
+	SchemeCSAEd25519 Scheme = "csa-ed25519"
+)
+
+// ParseScheme returns the Scheme named s.
+func ParseScheme(s string) (Scheme, error) {
+	switch scheme := Scheme(s); scheme {
+	case SchemeNone, SchemeHMACSHA256, SchemeCSAEd25519:
+		return scheme, nil
+	default:
+		return "", errors.Errorf("unknown signing scheme %q, must be %q or %q", s, SchemeHMACSHA256, SchemeCSAEd25519)
+	}
+}
+
+// Message returns the message signed for a request to the bridge named bridgeName at requestURI, the path and query of
+// the request URL, with body, signed at timestamp with nonce.
+func Message(bridgeName string, requestURI string, timestamp int64, nonce string, body []byte) []byte {
+	digest := sha256.Sum256(body)
+	return []byte(messageVersion + "\n" + bridgeName + "\n" + requestURI + "\n" + strconv.FormatInt(timestamp, 10) + "\n" + nonce + "\n" + hex.EncodeToString(digest[:]))
+}
+
+// Signer signs the messages of requests.
+type Signer interface {
+	Scheme() Scheme
+	// Sign returns the signature of msg.
+	Sign(msg []byte) ([]byte, error)
+	// PublicKey returns the public key verifying the signatures, or nil for symmetric schemes.
+	PublicKey() []byte
+}
+
+type hmacSigner struct {
+	key []byte
+}
+
+// NewHMACSigner returns a Signer of the hmac-sha256 scheme, keyed with key.
+func NewHMACSigner(key []byte) Signer {
+	return &hmacSigner{key: key}
+}
+
+func (s *hmacSigner) Scheme() Scheme { return SchemeHMACSHA256 }
+
+func (s *hmacSigner) Sign(msg []byte) ([]byte, error) {
+	return hmacSHA256(s.key, msg), nil
+}
+
+func (s *hmacSigner) PublicKey() []byte { return nil }
+
+type ed25519Signer struct {
+	key crypto.Signer
+}
+
+// NewEd25519Signer returns a Signer of the csa-ed25519 scheme, signing with key. The public key of key must be an
+// ed25519.PublicKey.
+func NewEd25519Signer(key crypto.Signer) Signer {
+	return &ed25519Signer{key: key}
+}
+
+func (s *ed25519Signer) Scheme() Scheme { return SchemeCSAEd25519 }
+
+func (s *ed25519Signer) Sign(msg []byte) ([]byte, error) {
+	return s.key.Sign(rand.Reader, msg, crypto.Hash(0))
+}
+
+func (s *ed25519Signer) PublicKey() []byte {
+	pub, _ := s.key.Public().(ed25519.PublicKey)
+	return pub
+}
+
+// Sign returns the headers signing a request to the bridge named bridgeName at requestURL with body at now.
+func Sign(signer Signer, bridgeName string, requestURL *url.URL, now time.Time, body []byte) (http.Header, error) {
+	nonce := make([]byte, nonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Wrap(err, "failed to generate nonce")
+	}
+	timestamp := now.Unix()
+	nonceHex := hex.EncodeToString(nonce)
+
+	signature, err := signer.Sign(Message(bridgeName, requestURL.RequestURI(), timestamp, nonceHex, body))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to sign request with %s", signer.Scheme())
+	}
+
+	h := http.Header{}
+	h.Set(HeaderScheme, string(signer.Scheme()))
+	h.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	h.Set(HeaderNonce, nonceHex)
+	h.Set(HeaderSignature, hex.EncodeToString(signature))
+	if pub := signer.PublicKey(); pub != nil {
+		h.Set(HeaderPublicKey, hex.EncodeToString(pub))
+	}
+	return h, nil
+}
+
+func hmacSHA256(key []byte, msg []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(msg)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,161 @@
+package signing
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultMaxClockSkew is the maximum clock skew of verifiers created with a zero skew.
+const DefaultMaxClockSkew = 5 * time.Minute
+
+var (
+	ErrMissingSignature = errors.New("request is not signed")
+	ErrInvalidSignature = errors.New("invalid request signature")
+	ErrStaleTimestamp   = errors.New("request timestamp is outside of the allowed clock skew")
+	ErrReplayedNonce    = errors.New("request nonce was already used")
+)
+
+// Verifier verifies the signature of bridge requests, for adapters to reject forged and replayed requests. It is safe
+// for concurrent use.
+type Verifier struct {
+	bridgeName string
+	scheme     Scheme
+	hmacKey    []byte
+	publicKeys []ed25519.PublicKey
+	maxSkew    time.Duration
+	now        func() time.Time
+
+	mu sync.Mutex
+	// nonces holds the nonces seen within the clock skew, with the time they expire at
+	nonces map[string]time.Time
+}
+
+// NewHMACVerifier returns a Verifier of requests to the bridge named bridgeName, signed with the hmac-sha256 scheme
+// keyed with the outgoing token of the bridge. Requests with timestamps further than maxSkew from now are rejected.
+func NewHMACVerifier(bridgeName string, outgoingToken string, maxSkew time.Duration) *Verifier {
+	return newVerifier(bridgeName, SchemeHMACSHA256, []byte(outgoingToken), nil, maxSkew)
+}
+
+// NewEd25519Verifier returns a Verifier of requests to the bridge named bridgeName, signed with the csa-ed25519 scheme
+// by one of publicKeys, the CSA public keys of the trusted nodes. Requests with timestamps further than maxSkew from
+// now are rejected.
+func NewEd25519Verifier(bridgeName string, publicKeys []ed25519.PublicKey, maxSkew time.Duration) *Verifier {
+	return newVerifier(bridgeName, SchemeCSAEd25519, nil, publicKeys, maxSkew)
+}
+
+func newVerifier(bridgeName string, scheme Scheme, hmacKey []byte, publicKeys []ed25519.PublicKey, maxSkew time.Duration) *Verifier {
+	if maxSkew <= 0 {
+		maxSkew = DefaultMaxClockSkew
+	}
+	return &Verifier{
+		bridgeName: bridgeName,
+		scheme:     scheme,
+		hmacKey:    hmacKey,
+		publicKeys: publicKeys,
+		maxSkew:    maxSkew,
+		now:        time.Now,
+		nonces:     map[string]time.Time{},
+	}
+}
+
+// Verify returns nil if header signs a request at requestURI, the path and query of the request URL as received by the
+// adapter, with body, and an error otherwise.
+func (v *Verifier) Verify(header http.Header, requestURI string, body []byte) error {
+	scheme := Scheme(header.Get(HeaderScheme))
+	timestampStr := header.Get(HeaderTimestamp)
+	nonce := header.Get(HeaderNonce)
+	signatureHex := header.Get(HeaderSignature)
+	if scheme == SchemeNone || timestampStr == "" || nonce == "" || signatureHex == "" {
+		return ErrMissingSignature
+	}
+	if scheme != v.scheme {
+		return errors.Wrapf(ErrInvalidSignature, "expected scheme %s, got %s", v.scheme, scheme)
+	}
+	timestamp, err := strconv.ParseInt(timestampStr, 10, 64)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidSignature, "invalid timestamp %q", timestampStr)
+	}
+	signature, err := hex.DecodeString(signatureHex)
+	if err != nil {
+		return errors.Wrap(ErrInvalidSignature, "signature is not hex encoded")
+	}
+
+	now := v.now()
+	signedAt := time.Unix(timestamp, 0)
+	if signedAt.Before(now.Add(-v.maxSkew)) || signedAt.After(now.Add(v.maxSkew)) {
+		return ErrStaleTimestamp
+	}
+
+	msg := Message(v.bridgeName, requestURI, timestamp, nonce, body)
+	switch v.scheme {
+	case SchemeHMACSHA256:
+		if !hmac.Equal(signature, hmacSHA256(v.hmacKey, msg)) {
+			return ErrInvalidSignature
+		}
+	case SchemeCSAEd25519:
+		if !v.verifyEd25519(header.Get(HeaderPublicKey), msg, signature) {
+			return ErrInvalidSignature
+		}
+	default:
+		return errors.Wrapf(ErrInvalidSignature, "unsupported scheme %s", v.scheme)
+	}
+
+	// the nonce is only recorded once the signature is verified, so that forged requests cannot burn nonces
+	return v.useNonce(nonce, now, signedAt)
+}
+
+func (v *Verifier) verifyEd25519(publicKeyHex string, msg []byte, signature []byte) bool {
+	for _, pub := range v.publicKeys {
+		// the public key header is only a hint, the trusted keys are authoritative
+		if publicKeyHex != "" && publicKeyHex != hex.EncodeToString(pub) {
+			continue
+		}
+		if ed25519.Verify(pub, msg, signature) {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *Verifier) useNonce(nonce string, now time.Time, signedAt time.Time) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	for n, expiresAt := range v.nonces {
+		if now.After(expiresAt) {
+			delete(v.nonces, n)
+		}
+	}
+	if _, ok := v.nonces[nonce]; ok {
+		return ErrReplayedNonce
+	}
+	// requests signed at signedAt are rejected as stale after signedAt+maxSkew, so the nonce can be forgotten then
+	v.nonces[nonce] = signedAt.Add(v.maxSkew)
+	return nil
+}
+
+// Middleware returns a handler verifying the requests before passing them to next, and responding with 401
+// Unauthorized to those that fail verification.
+func (v *Verifier) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "failed to read request body", http.StatusBadRequest)
+			return
+		}
+		if err = v.Verify(r.Header, r.URL.RequestURI(), body); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,157 @@
+package signing_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges/signing"
+)
+
+func TestParseScheme(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{"", "hmac-sha256", "csa-ed25519"} {
+		scheme, err := signing.ParseScheme(s)
+		require.NoError(t, err)
+		assert.Equal(t, signing.Scheme(s), scheme)
+	}
+	_, err := signing.ParseScheme("rsa")
+	assert.EqualError(t, err, `unknown signing scheme "rsa", must be "hmac-sha256" or "csa-ed25519"`)
+}
+
+func TestVerifier_HMAC(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"data":{"from":"ETH","to":"USD"}}`)
+	reqURL, err := url.Parse("https://adapter.example/price?pair=ETH-USD")
+	require.NoError(t, err)
+	signer := signing.NewHMACSigner([]byte("outgoing-token"))
+	v := signing.NewHMACVerifier("eth-usd", "outgoing-token", 0)
+
+	h, err := signing.Sign(signer, "eth-usd", reqURL, time.Now(), body)
+	require.NoError(t, err)
+	assert.Equal(t, "hmac-sha256", h.Get(signing.HeaderScheme))
+	assert.Len(t, h.Get(signing.HeaderNonce), 32)
+	assert.Empty(t, h.Get(signing.HeaderPublicKey))
+
+	t.Run("tampered body", func(t *testing.T) {
+		assert.ErrorIs(t, v.Verify(h, "/price?pair=ETH-USD", []byte(`{"data":{"from":"BTC","to":"USD"}}`)), signing.ErrInvalidSignature)
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		other := signing.NewHMACVerifier("eth-usd", "other-token", 0)
+		assert.ErrorIs(t, other.Verify(h, "/price?pair=ETH-USD", body), signing.ErrInvalidSignature)
+	})
+
+	t.Run("other bridge", func(t *testing.T) {
+		other := signing.NewHMACVerifier("btc-usd", "outgoing-token", 0)
+		assert.ErrorIs(t, other.Verify(h, "/price?pair=ETH-USD", body), signing.ErrInvalidSignature)
+	})
+
+	t.Run("other URL", func(t *testing.T) {
+		assert.ErrorIs(t, v.Verify(h, "/price?pair=BTC-USD", body), signing.ErrInvalidSignature)
+		assert.ErrorIs(t, v.Verify(h, "/other?pair=ETH-USD", body), signing.ErrInvalidSignature)
+	})
+
+	t.Run("valid then replayed", func(t *testing.T) {
+		require.NoError(t, v.Verify(h, "/price?pair=ETH-USD", body))
+		assert.ErrorIs(t, v.Verify(h, "/price?pair=ETH-USD", body), signing.ErrReplayedNonce)
+	})
+
+	t.Run("stale", func(t *testing.T) {
+		stale, err := signing.Sign(signer, "eth-usd", reqURL, time.Now().Add(-10*time.Minute), body)
+		require.NoError(t, err)
+		assert.ErrorIs(t, v.Verify(stale, "/price?pair=ETH-USD", body), signing.ErrStaleTimestamp)
+	})
+
+	t.Run("unsigned", func(t *testing.T) {
+		assert.ErrorIs(t, v.Verify(http.Header{}, "/price?pair=ETH-USD", body), signing.ErrMissingSignature)
+	})
+}
+
+func TestVerifier_Ed25519(t *testing.T) {
+	t.Parallel()
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	otherPub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	body := []byte(`{"data":{}}`)
+	reqURL, err := url.Parse("https://adapter.example")
+	require.NoError(t, err)
+	h, err := signing.Sign(signing.NewEd25519Signer(priv), "adapter", reqURL, time.Now(), body)
+	require.NoError(t, err)
+	assert.Equal(t, "csa-ed25519", h.Get(signing.HeaderScheme))
+	assert.NotEmpty(t, h.Get(signing.HeaderPublicKey))
+
+	untrusted := signing.NewEd25519Verifier("adapter", []ed25519.PublicKey{otherPub}, 0)
+	assert.ErrorIs(t, untrusted.Verify(h, "/", body), signing.ErrInvalidSignature)
+
+	hmacVerifier := signing.NewHMACVerifier("adapter", "outgoing-token", 0)
+	assert.ErrorIs(t, hmacVerifier.Verify(h, "/", body), signing.ErrInvalidSignature)
+
+	// the CSA key is shared by all the bridges of the node, so requests to another bridge must not verify
+	otherBridge := signing.NewEd25519Verifier("other-adapter", []ed25519.PublicKey{pub}, 0)
+	assert.ErrorIs(t, otherBridge.Verify(h, "/", body), signing.ErrInvalidSignature)
+
+	v := signing.NewEd25519Verifier("adapter", []ed25519.PublicKey{otherPub, pub}, 0)
+	require.NoError(t, v.Verify(h, "/", body))
+	assert.ErrorIs(t, v.Verify(h, "/", body), signing.ErrReplayedNonce)
+}
+
+func TestVerifier_Middleware(t *testing.T) {
+	t.Parallel()
+
+	v := signing.NewHMACVerifier("adapter", "outgoing-token", time.Minute)
+	s := httptest.NewServer(v.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		_, err = w.Write(b)
+		assert.NoError(t, err)
+	})))
+	t.Cleanup(s.Close)
+
+	send := func(h http.Header, path string, body string) (int, string) {
+		req, err := http.NewRequest(http.MethodPost, s.URL+path, strings.NewReader(body))
+		require.NoError(t, err)
+		for k, vs := range h {
+			req.Header[k] = vs
+		}
+		resp, err := s.Client().Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(b)
+	}
+
+	body := `{"data":{}}`
+	reqURL, err := url.Parse(s.URL + "/price")
+	require.NoError(t, err)
+	h, err := signing.Sign(signing.NewHMACSigner([]byte("outgoing-token")), "adapter", reqURL, time.Now(), []byte(body))
+	require.NoError(t, err)
+
+	status, _ := send(h, "/other", body)
+	assert.Equal(t, http.StatusUnauthorized, status)
+
+	status, resp := send(h, "/price", body)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, body, resp)
+
+	status, _ = send(h, "/price", body)
+	assert.Equal(t, http.StatusUnauthorized, status)
+
+	status, _ = send(http.Header{}, "/price", body)
+	assert.Equal(t, http.StatusUnauthorized, status)
+}
@@ -205,8 +205,47 @@ func NewApp(s *Shell) *cli.App {
 				keysCommand("TON", NewTONKeysClient(s)),
 
 				initVRFKeysSubCmd(s),
+				{
+					Name:   "rotate-password",
+					Usage:  "Local command to re-encrypt all keys with a new key store password. The node must be stopped",
+					Action: s.RotateKeystorePassword,
+					Flags: []cli.Flag{
+						cli.StringSliceFlag{
+							Name:  "config, c",
+							Usage: "TOML configuration file(s) of the node. Multiple files can be used (-c configA.toml -c configB.toml), and they are applied in order. [$CL_CONFIG]",
+						},
+						cli.StringSliceFlag{
+							Name:  "secrets, s",
+							Usage: "TOML secrets file(s) of the node, with Database.URL and the current Password.Keystore. If the current password is not set, you will be prompted for it",
+						},
+						cli.StringFlag{
+							Name:  "new-password, n",
+							Usage: "text file holding the new key store password. If omitted, you will be prompted for it",
+						},
+					},
+					Before: func(c *cli.Context) error {
+						configFiles, secretsFiles := s.configFiles, s.secretsFiles
+						if c.IsSet("config") {
+							configFiles = c.StringSlice("config")
+						}
+						if c.IsSet("secrets") {
+							secretsFiles = c.StringSlice("secrets")
+						}
+						cfg, err := initServerConfig(&opts, configFiles, secretsFiles)
+						if err != nil {
+							return err
+						}
+						s.Config = cfg
+						return nil
+					},
+				},
 			},
 		},
+		{
+			Name:        "llo",
+			Usage:       "Commands for debugging LLO (Data Streams) reports offline",
+			Subcommands: initLLOSubCmds(s),
+		},
 		{
 			Name:        "node",
 			Aliases:     []string{"local"},
@@ -1,8 +1,17 @@
 package cmd
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
 	"github.com/urfave/cli"
+	"go.uber.org/multierr"
 
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -13,6 +22,21 @@ func initInitiatorsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Create an authentication key for a user of External Initiators",
 			Action: s.CreateExternalInitiator,
 		},
+		{
+			Name:   "deliveries",
+			Usage:  "List the job notifications sent to external initiators",
+			Action: s.IndexExternalInitiatorDeliveries,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "state",
+					Usage: "only list deliveries in this state: pending, delivered or dead",
+				},
+				cli.IntFlag{
+					Name:  "page",
+					Usage: "page of results to display",
+				},
+			},
+		},
 		{
 			Name:   "destroy",
 			Usage:  "Remove an external initiator by name",
@@ -23,6 +47,18 @@ func initInitiatorsSubCmds(s *Shell) []cli.Command {
 			Usage:  "List all external initiators",
 			Action: s.IndexExternalInitiators,
 		},
+		{
+			Name:      "replay",
+			Usage:     "Resend job notifications to external initiators by delivery ID, or all dead ones if no ID is given",
+			ArgsUsage: "[delivery IDs...]",
+			Action:    s.ReplayExternalInitiatorDeliveries,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "name",
+					Usage: "only replay the deliveries to the external initiator with this name",
+				},
+			},
+		},
 	}
 }
 
@@ -69,3 +105,90 @@ func (eips *ExternalInitiatorPresenters) RenderTable(rt RendererTable) error {
 func (s *Shell) IndexExternalInitiators(c *cli.Context) (err error) {
 	return s.getPage("/v2/external_initiators", c.Int("page"), &ExternalInitiatorPresenters{})
 }
+
+type ExternalInitiatorDeliveryPresenter struct {
+	JAID
+	presenters.ExternalInitiatorDeliveryResource
+}
+
+func (p *ExternalInitiatorDeliveryPresenter) ToRow() []string {
+	var lastError string
+	if p.LastError != nil {
+		lastError = *p.LastError
+	}
+	return []string{
+		p.ID,
+		p.ExternalInitiator,
+		p.JobID,
+		p.Type,
+		p.State,
+		strconv.Itoa(int(p.Attempts)),
+		p.NextAttemptAt.String(),
+		lastError,
+	}
+}
+
+var externalInitiatorDeliveryHeaders = []string{"ID", "External Initiator", "Job ID", "Type", "State", "Attempts", "Next Attempt At", "Last Error"}
+
+type ExternalInitiatorDeliveryPresenters []ExternalInitiatorDeliveryPresenter
+
+func (ps *ExternalInitiatorDeliveryPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable(externalInitiatorDeliveryHeaders)
+	for _, p := range *ps {
+		table.Append(p.ToRow())
+	}
+	render("External Initiator Deliveries:", table)
+	return nil
+}
+
+type ExternalInitiatorDeliveriesReplayPresenter struct {
+	JAID
+	presenters.ExternalInitiatorDeliveriesReplayResource
+}
+
+func (p *ExternalInitiatorDeliveriesReplayPresenter) RenderTable(rt RendererTable) error {
+	_, err := rt.Write([]byte(fmt.Sprintf("Replaying %d external initiator deliveries\n", p.Count)))
+	return err
+}
+
+// IndexExternalInitiatorDeliveries lists the notifications sent to external initiators
+func (s *Shell) IndexExternalInitiatorDeliveries(c *cli.Context) (err error) {
+	state, err := webhook.ParseDeliveryState(c.String("state"))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	uri := "/v2/external_initiator_deliveries"
+	if state != "" {
+		uri += "?state=" + url.QueryEscape(string(state))
+	}
+	return s.getPage(uri, c.Int("page"), &ExternalInitiatorDeliveryPresenters{})
+}
+
+// ReplayExternalInitiatorDeliveries resends notifications to external initiators
+func (s *Shell) ReplayExternalInitiatorDeliveries(c *cli.Context) (err error) {
+	request := webhook.ReplayDeliveriesRequest{ExternalInitiator: c.String("name")}
+	for _, arg := range c.Args() {
+		id, perr := strconv.ParseInt(arg, 10, 64)
+		if perr != nil {
+			return s.errorOut(errors.Wrapf(perr, "invalid delivery ID %q", arg))
+		}
+		request.IDs = append(request.IDs, id)
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/external_initiator_deliveries/replay", bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &ExternalInitiatorDeliveriesReplayPresenter{})
+}
@@ -59,3 +59,34 @@ func TestExternalInitiatorPresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, accessKey)
 	assert.Contains(t, output, outgoingToken)
 }
+
+func TestExternalInitiatorDeliveryPresenters_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer    = bytes.NewBufferString("")
+		r         = cmd.RendererTable{Writer: buffer}
+		lastError = "connection refused"
+	)
+
+	ps := cmd.ExternalInitiatorDeliveryPresenters{{
+		JAID: cmd.JAID{ID: "1"},
+		ExternalInitiatorDeliveryResource: presenters.ExternalInitiatorDeliveryResource{
+			JAID:              presenters.NewJAID("1"),
+			ExternalInitiator: "substrate-ei",
+			JobID:             "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+			Type:              "create",
+			State:             "dead",
+			Attempts:          12,
+			LastError:         &lastError,
+			NextAttemptAt:     time.Now(),
+		},
+	}}
+	require.NoError(t, ps.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "substrate-ei")
+	assert.Contains(t, output, "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46")
+	assert.Contains(t, output, "dead")
+	assert.Contains(t, output, lastError)
+}
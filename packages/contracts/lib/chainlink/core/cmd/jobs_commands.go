@@ -45,11 +45,36 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Delete a job",
 			Action: s.DeleteJob,
 		},
+		{
+			Name:   "pause",
+			Usage:  "Pause a job",
+			Action: s.PauseJob,
+		},
+		{
+			Name:   "resume",
+			Usage:  "Resume a paused job",
+			Action: s.ResumeJob,
+		},
 		{
 			Name:   "run",
 			Usage:  "Trigger a job run",
 			Action: s.TriggerPipelineRun,
 		},
+		{
+			Name:   "simulate",
+			Usage:  "Simulate a run of a job spec's pipeline, without persisting it or executing tasks with side effects",
+			Action: s.SimulateJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "vars",
+					Usage: "JSON string or path to a JSON file with the input variables of the run",
+				},
+				cli.StringFlag{
+					Name:  "stubs",
+					Usage: "JSON string or path to a JSON file mapping task dot IDs to their stubbed output, e.g. {\"ds\": {\"value\": 1}} or {\"ds\": {\"error\": \"timeout\"}}",
+				},
+			},
+		},
 	}
 }
 
@@ -83,6 +108,7 @@ func (p JobPresenter) toRow(task string) []string {
 		p.GetID(),
 		p.Name,
 		p.Type.String(),
+		p.FriendlyStatus(),
 		task,
 		p.FriendlyCreatedAt(),
 	}
@@ -116,6 +142,14 @@ func (p JobPresenter) FriendlyTasks() []string {
 	return taskTypes
 }
 
+// FriendlyStatus returns whether the services of the job are running.
+func (p JobPresenter) FriendlyStatus() string {
+	if p.PausedAt.Valid {
+		return "paused"
+	}
+	return "active"
+}
+
 // FriendlyCreatedAt returns the created at timestamp of the spec which matches the
 // type in RFC3339 format.
 func (p JobPresenter) FriendlyCreatedAt() string {
@@ -187,7 +221,7 @@ func (p JobPresenter) FriendlyCreatedAt() string {
 
 // RenderTable implements TableRenderer
 func (p *JobPresenter) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"ID", "Name", "Type", "Tasks", "Created At"})
+	table := rt.newTable([]string{"ID", "Name", "Type", "Status", "Tasks", "Created At"})
 	table.SetAutoMergeCells(true)
 	for _, r := range p.ToRows() {
 		table.Append(r)
@@ -201,7 +235,7 @@ type JobPresenters []JobPresenter
 
 // RenderTable implements TableRenderer
 func (ps JobPresenters) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"ID", "Name", "Type", "Tasks", "Created At"})
+	table := rt.newTable([]string{"ID", "Name", "Type", "Status", "Tasks", "Created At"})
 	table.SetAutoMergeCells(true)
 	for _, p := range ps {
 		for _, r := range p.ToRows() {
@@ -213,6 +247,47 @@ func (ps JobPresenters) RenderTable(rt RendererTable) error {
 	return nil
 }
 
+// PipelineSimulationPresenter wraps the JSONAPI pipeline simulation resource and adds rendering functionality
+type PipelineSimulationPresenter struct {
+	JAID
+	presenters.PipelineSimulationResource
+}
+
+// RenderTable implements TableRenderer
+func (p *PipelineSimulationPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Task", "Type", "Inputs", "Stubbed", "Output", "Error", "Duration"})
+	for _, tr := range p.TaskRuns {
+		table.Append([]string{
+			tr.DotID,
+			string(tr.Type),
+			strings.Join(tr.Inputs, ", "),
+			fmt.Sprintf("%t", tr.Stubbed),
+			stringOrEmpty(tr.Output),
+			stringOrEmpty(tr.Error),
+			tr.Duration,
+		})
+	}
+	render(fmt.Sprintf("Pipeline simulation (%s)", p.State), table)
+
+	outputs := rt.newTable([]string{"Outputs", "Errors"})
+	for i, out := range p.Outputs {
+		var errStr string
+		if i < len(p.FatalErrors) {
+			errStr = stringOrEmpty(p.FatalErrors[i])
+		}
+		outputs.Append([]string{stringOrEmpty(out), errStr})
+	}
+	render("Results", outputs)
+	return nil
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
 // ListJobs lists all jobs
 func (s *Shell) ListJobs(c *cli.Context) (err error) {
 	return s.getPage("/v2/jobs", c.Int("page"), &JobPresenters{})
@@ -298,6 +373,36 @@ func (s *Shell) DeleteJob(c *cli.Context) error {
 	return nil
 }
 
+// PauseJob stops the services of a job, keeping its spec and runs
+func (s *Shell) PauseJob(c *cli.Context) error {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the job id to be paused"))
+	}
+	return s.setJobPaused(c.Args().First(), "pause", "Job paused")
+}
+
+// ResumeJob starts the services of a paused job
+func (s *Shell) ResumeJob(c *cli.Context) error {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the job id to be resumed"))
+	}
+	return s.setJobPaused(c.Args().First(), "resume", "Job resumed")
+}
+
+func (s *Shell) setJobPaused(id, action, header string) (err error) {
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/"+id+"/"+action, nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobPresenter{}, header)
+}
+
 // TriggerPipelineRun triggers a job run based on a job ID
 func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	if !c.Args().Present() {
@@ -317,3 +422,53 @@ func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	err = s.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// SimulateJob runs the pipeline of a job spec without persisting it or executing tasks with side effects
+// Valid input is a TOML string or a path to TOML file
+func (s *Shell) SimulateJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	request := web.SimulatePipelineRequest{TOML: tomlString}
+	if vars := c.String("vars"); vars != "" {
+		buf, berr := getBufferFromJSON(vars)
+		if berr != nil {
+			return s.errorOut(berr)
+		}
+		if err = json.Unmarshal(buf.Bytes(), &request.Vars); err != nil {
+			return s.errorOut(errors.Wrap(err, "invalid vars"))
+		}
+	}
+	if stubs := c.String("stubs"); stubs != "" {
+		buf, berr := getBufferFromJSON(stubs)
+		if berr != nil {
+			return s.errorOut(berr)
+		}
+		if err = json.Unmarshal(buf.Bytes(), &request.Stubs); err != nil {
+			return s.errorOut(errors.Wrap(err, "invalid stubs"))
+		}
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/pipeline/simulate", bytes.NewReader(body))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &PipelineSimulationPresenter{})
+}
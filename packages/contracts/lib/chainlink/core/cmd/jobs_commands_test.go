@@ -5,6 +5,7 @@ import (
 	_ "embed"
 	"flag"
 	"fmt"
+	"strconv"
 	"testing"
 	"time"
 
@@ -22,6 +23,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -288,15 +290,15 @@ func TestJob_ToRows(t *testing.T) {
 	}
 
 	assert.Equal(t, [][]string{
-		{"1", "Test Job", "directrequest", "ds1 http", now.Format(time.RFC3339)},
-		{"1", "Test Job", "directrequest", "ds1_parse jsonparse", now.Format(time.RFC3339)},
-		{"1", "Test Job", "directrequest", "ds1_multiply multiply", now.Format(time.RFC3339)},
+		{"1", "Test Job", "directrequest", "active", "ds1 http", now.Format(time.RFC3339)},
+		{"1", "Test Job", "directrequest", "active", "ds1_parse jsonparse", now.Format(time.RFC3339)},
+		{"1", "Test Job", "directrequest", "active", "ds1_multiply multiply", now.Format(time.RFC3339)},
 	}, job.ToRows())
 
 	// Produce a single row even if there is not DAG
 	job.PipelineSpec.DotDAGSource = ""
 	assert.Equal(t, [][]string{
-		{"1", "Test Job", "directrequest", "", now.Format(time.RFC3339)},
+		{"1", "Test Job", "directrequest", "active", "", now.Format(time.RFC3339)},
 	}, job.ToRows())
 }
 
@@ -453,6 +455,79 @@ func TestShell_DeleteJob(t *testing.T) {
 	requireJobsCount(t, app.JobORM(), 0)
 }
 
+func TestShell_PauseResumeJob(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].Enabled = ptr(true)
+	})
+	client, r := app.NewShellAndRenderer()
+
+	// Create the job
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	flagSetApplyFromAction(client.CreateJob, fs, "")
+
+	require.NoError(t, fs.Parse([]string{getDirectRequestSpec()}))
+
+	err := client.CreateJob(cli.NewContext(nil, fs, nil))
+	require.NoError(t, err)
+	require.Len(t, r.Renders, 1)
+	output := *r.Renders[0].(*cmd.JobPresenter)
+	jobID, err := strconv.ParseInt(output.ID, 10, 32)
+	require.NoError(t, err)
+	cltest.AwaitJobActive(t, app.JobSpawner(), int32(jobID), 3*time.Second)
+
+	// Must supply job id
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.PauseJob, set, "")
+	c := cli.NewContext(nil, set, nil)
+	require.Equal(t, "must pass the job id to be paused", client.PauseJob(c).Error())
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.PauseJob, set, "")
+	require.NoError(t, set.Parse([]string{output.ID}))
+	require.NoError(t, client.PauseJob(cli.NewContext(nil, set, nil)))
+
+	paused := *r.Renders[1].(*cmd.JobPresenter)
+	assert.Equal(t, "paused", paused.FriendlyStatus())
+	assert.NotContains(t, app.JobSpawner().ActiveJobs(), int32(jobID))
+	requireJobsCount(t, app.JobORM(), 1)
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ResumeJob, set, "")
+	require.NoError(t, set.Parse([]string{output.ID}))
+	require.NoError(t, client.ResumeJob(cli.NewContext(nil, set, nil)))
+
+	resumed := *r.Renders[2].(*cmd.JobPresenter)
+	assert.Equal(t, "active", resumed.FriendlyStatus())
+	cltest.AwaitJobActive(t, app.JobSpawner(), int32(jobID), 3*time.Second)
+}
+
+func TestShell_SimulateJob(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.SimulateJob, set, "")
+	require.Equal(t, "must pass in TOML or filepath", client.SimulateJob(cli.NewContext(nil, set, nil)).Error())
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.SimulateJob, set, "")
+	require.NoError(t, set.Set("stubs", `{"ds": {"value": "{\"data\":{\"price\":2}}"}}`))
+	require.NoError(t, set.Parse([]string{fmt.Sprintf(testspecs.CronSpecTemplate, uuid.New())}))
+	require.NoError(t, client.SimulateJob(cli.NewContext(nil, set, nil)))
+
+	require.Len(t, r.Renders, 1)
+	simulation := *r.Renders[0].(*cmd.PipelineSimulationPresenter)
+	assert.Equal(t, "completed", string(simulation.State))
+	require.Len(t, simulation.Outputs, 1)
+	assert.Equal(t, "200", *simulation.Outputs[0])
+	require.Len(t, simulation.TaskRuns, 3)
+	requireJobsCount(t, app.JobORM(), 0)
+}
+
 func requireJobsCount(t *testing.T, orm job.ORM, expected int) {
 	ctx := testutils.Context(t)
 	jobs, _, err := orm.FindJobs(ctx, 0, 1000)
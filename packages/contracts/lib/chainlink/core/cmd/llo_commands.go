@@ -0,0 +1,266 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/decoder"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/export"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/retirement"
+)
+
+func initLLOSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:   "decode",
+			Usage:  "Decode an LLO report to JSON and verify its signatures offline. The report is either passed with --report and --format, or read from --record-file.",
+			Action: s.DecodeLLOReport,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "report",
+					Usage: "hex-encoded report",
+				},
+				cli.StringFlag{
+					Name:  "format",
+					Usage: "report format, e.g. json, evm_premium_legacy, evm_abi_encode_unpacked, evm_streamlined or capability_trigger",
+				},
+				cli.StringFlag{
+					Name:  "record-file",
+					Usage: "file holding reports as written by the file transmitter, one JSON record per line. Every record is decoded and verified",
+				},
+				cli.StringFlag{
+					Name:  "channel-definitions-file",
+					Usage: "JSON file holding the channel definitions, used to decode the stream values of EVM reports",
+				},
+				cli.UintFlag{
+					Name:  "channel-id",
+					Usage: "ID of the channel of the report in --channel-definitions-file, if the report does not encode it",
+				},
+				cli.StringFlag{
+					Name:  "config-digest",
+					Usage: "hex-encoded config digest of the report",
+				},
+				cli.Uint64Flag{
+					Name:  "seq-nr",
+					Usage: "sequence number of the report",
+				},
+				cli.StringSliceFlag{
+					Name:  "signature",
+					Usage: "attributed signature of the report as <signer index>:<hex signature>. Can be repeated",
+				},
+				cli.StringSliceFlag{
+					Name:  "signer",
+					Usage: "hex-encoded onchain public key of a signer, in the order of the oracles of the config. Can be repeated. The signatures are verified if set",
+				},
+				cli.UintFlag{
+					Name:  "f",
+					Usage: "maximum number of faulty oracles of the config; more than f valid signatures are required",
+				},
+				cli.UintFlag{
+					Name:  "don-id",
+					Usage: "ID of the DON which signed the report",
+				},
+			},
+		},
+	}
+}
+
+// LLODecodeResult is the output of the llo decode command.
+type LLODecodeResult struct {
+	ConfigDigest string                 `json:"configDigest,omitempty"`
+	SeqNr        uint64                 `json:"seqNr,omitempty"`
+	Report       *decoder.DecodedReport `json:"report"`
+	Verification *decoder.Verification  `json:"verification,omitempty"`
+}
+
+type lloReport struct {
+	digest ocrtypes.ConfigDigest
+	seqNr  uint64
+	report ocr3types.ReportWithInfo[llotypes.ReportInfo]
+	sigs   []ocrtypes.AttributedOnchainSignature
+}
+
+// DecodeLLOReport decodes LLO reports and verifies their signatures, without connecting to a node.
+func (s *Shell) DecodeLLOReport(c *cli.Context) error {
+	var reports []lloReport
+	var err error
+	switch {
+	case c.IsSet("record-file") && c.IsSet("report"):
+		return s.errorOut(errors.New("--record-file and --report are mutually exclusive"))
+	case c.IsSet("record-file"):
+		reports, err = readLLORecords(c.String("record-file"))
+	case c.IsSet("report"):
+		var r lloReport
+		r, err = parseLLOReport(c)
+		reports = append(reports, r)
+	default:
+		return s.errorOut(errors.New("must set either --report or --record-file"))
+	}
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	var cds llotypes.ChannelDefinitions
+	if file := c.String("channel-definitions-file"); file != "" {
+		b, err2 := os.ReadFile(file)
+		if err2 != nil {
+			return s.errorOut(errors.Wrap(err2, "failed to read channel definitions file"))
+		}
+		if err2 = json.Unmarshal(b, &cds); err2 != nil {
+			return s.errorOut(errors.Wrap(err2, "failed to parse channel definitions file"))
+		}
+	}
+
+	var signers [][]byte
+	for _, signer := range c.StringSlice("signer") {
+		key, err2 := hex.DecodeString(strings.TrimPrefix(signer, "0x"))
+		if err2 != nil {
+			return s.errorOut(errors.Wrapf(err2, "invalid --signer %s", signer))
+		}
+		signers = append(signers, key)
+	}
+	if c.Uint("f") > math.MaxUint8 {
+		return s.errorOut(errors.Errorf("invalid --f %d", c.Uint("f")))
+	}
+	f := uint8(c.Uint("f"))           //nolint:gosec // G115
+	donID := uint32(c.Uint("don-id")) //nolint:gosec // G115
+
+	for _, r := range reports {
+		var cd *llotypes.ChannelDefinition
+		if cds != nil {
+			if c.IsSet("channel-id") {
+				cd, err = lloChannelDefinition(cds, llotypes.ChannelID(c.Uint("channel-id")))
+			} else if d, err2 := decoder.Decode(r.report.Info.ReportFormat, r.report.Report, nil); err2 == nil && d.ChannelID != nil {
+				cd, err = lloChannelDefinition(cds, *d.ChannelID)
+			}
+			if err != nil {
+				return s.errorOut(err)
+			}
+		}
+
+		res := LLODecodeResult{SeqNr: r.seqNr}
+		if r.digest != (ocrtypes.ConfigDigest{}) {
+			res.ConfigDigest = r.digest.Hex()
+		}
+		if res.Report, err = decoder.Decode(r.report.Info.ReportFormat, r.report.Report, cd); err != nil {
+			return s.errorOut(err)
+		}
+		if len(signers) > 0 {
+			config := retirement.Config{Digest: r.digest, Signers: signers, F: f}
+			if res.Verification, err = decoder.Verify(config, donID, r.digest, r.seqNr, r.report, r.sigs); err != nil {
+				return s.errorOut(err)
+			}
+		}
+
+		b, err := json.MarshalIndent(res, "", "  ")
+		if err != nil {
+			return s.errorOut(errors.Wrap(err, "failed to marshal decoded report"))
+		}
+		fmt.Println(string(b))
+	}
+	return nil
+}
+
+func lloChannelDefinition(cds llotypes.ChannelDefinitions, channelID llotypes.ChannelID) (*llotypes.ChannelDefinition, error) {
+	cd, ok := cds[channelID]
+	if !ok {
+		return nil, errors.Errorf("channel %d not found in the channel definitions", channelID)
+	}
+	return &cd, nil
+}
+
+func parseLLOReport(c *cli.Context) (r lloReport, err error) {
+	r.report.Report, err = hex.DecodeString(strings.TrimPrefix(c.String("report"), "0x"))
+	if err != nil {
+		return r, errors.Wrap(err, "invalid --report")
+	}
+	if !c.IsSet("format") {
+		return r, errors.New("must set --format with --report")
+	}
+	if r.report.Info.ReportFormat, err = llotypes.ReportFormatFromString(c.String("format")); err != nil {
+		return r, errors.Wrap(err, "invalid --format")
+	}
+	if digest := c.String("config-digest"); digest != "" {
+		b, err2 := hex.DecodeString(strings.TrimPrefix(digest, "0x"))
+		if err2 != nil {
+			return r, errors.Wrap(err2, "invalid --config-digest")
+		}
+		if r.digest, err2 = ocrtypes.BytesToConfigDigest(b); err2 != nil {
+			return r, errors.Wrap(err2, "invalid --config-digest")
+		}
+	}
+	r.seqNr = c.Uint64("seq-nr")
+	for _, sig := range c.StringSlice("signature") {
+		signer, sigHex, ok := strings.Cut(sig, ":")
+		if !ok {
+			return r, errors.Errorf("invalid --signature %s: expected <signer index>:<hex signature>", sig)
+		}
+		index, err2 := strconv.ParseUint(signer, 10, 8)
+		if err2 != nil {
+			return r, errors.Wrapf(err2, "invalid signer index of --signature %s", sig)
+		}
+		b, err2 := hex.DecodeString(strings.TrimPrefix(sigHex, "0x"))
+		if err2 != nil {
+			return r, errors.Wrapf(err2, "invalid --signature %s", sig)
+		}
+		r.sigs = append(r.sigs, ocrtypes.AttributedOnchainSignature{Signer: commontypes.OracleID(index), Signature: b})
+	}
+	return r, nil
+}
+
+func readLLORecords(file string) ([]lloReport, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open record file")
+	}
+	defer f.Close()
+
+	var reports []lloReport
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(strings.TrimSpace(scanner.Text())) == 0 {
+			continue
+		}
+		var record export.Record
+		if err = json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, errors.Wrapf(err, "invalid record on line %d", line)
+		}
+		r := lloReport{seqNr: record.SeqNr}
+		r.report.Report = record.Report
+		if r.report.Info.ReportFormat, err = llotypes.ReportFormatFromString(record.ReportFormat); err != nil {
+			return nil, errors.Wrapf(err, "invalid report format on line %d", line)
+		}
+		r.report.Info.LifeCycleStage = llotypes.LifeCycleStage(record.LifeCycleStage)
+		var digest []byte
+		if digest, err = hex.DecodeString(strings.TrimPrefix(record.ConfigDigest, "0x")); err != nil {
+			return nil, errors.Wrapf(err, "invalid config digest on line %d", line)
+		}
+		if r.digest, err = ocrtypes.BytesToConfigDigest(digest); err != nil {
+			return nil, errors.Wrapf(err, "invalid config digest on line %d", line)
+		}
+		for _, sig := range record.Signatures {
+			r.sigs = append(r.sigs, ocrtypes.AttributedOnchainSignature{Signer: commontypes.OracleID(sig.Signer), Signature: sig.Signature})
+		}
+		reports = append(reports, r)
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to read record file")
+	}
+	return reports, nil
+}
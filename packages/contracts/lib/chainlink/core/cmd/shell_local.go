@@ -1,12 +1,17 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"math"
 	"math/big"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -15,6 +20,7 @@ import (
 
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/fatih/color"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
@@ -31,9 +37,13 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/periodicbackup"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/shutdown"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
@@ -213,6 +223,51 @@ func initLocalSubCmds(s *Shell, safe bool) []cli.Command {
 					Before: s.validateDB,
 					Flags:  []cli.Flag{},
 				},
+				{
+					Name:      "restore",
+					Usage:     "Restore the database from a backup taken by the node, or list the available backups if none is specified. WARNING: This will REPLACE ALL DATA of the specified database, referred to by CL_DATABASE_URL env variable or by the Database.URL field in a secrets TOML config. The node must be stopped.",
+					ArgsUsage: "[backup file]",
+					Action:    s.RestoreDatabase,
+					Before:    s.validateDB,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "password, p",
+							Usage: "text file holding the keystore password, to restore encrypted backups",
+						},
+						cli.BoolFlag{
+							Name:  "allow-missing-checksum",
+							Usage: "restore a backup without checksum file, like the ones taken by older versions",
+						},
+						cli.BoolFlag{
+							Name:  "yes, y",
+							Usage: "skip the confirmation prompt",
+						},
+					},
+				},
+				{
+					Name:   "import-runs",
+					Usage:  "Import pipeline runs archived when JobPipeline.Archive is enabled back into the database, for forensic analysis. Imported runs are pruned again like any other run, so the retention of their job must be raised for them to be kept.",
+					Action: s.ImportRuns,
+					Before: s.validateDB,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "dir",
+							Usage: "directory holding the archive files, defaults to JobPipeline.Archive.Dir",
+						},
+						cli.StringFlag{
+							Name:  "from",
+							Usage: "only import runs finished at or after this RFC3339 time",
+						},
+						cli.StringFlag{
+							Name:  "to",
+							Usage: "only import runs finished before this RFC3339 time",
+						},
+						cli.Int64Flag{
+							Name:  "job-id",
+							Usage: "only import the runs of the job with this ID",
+						},
+					},
+				},
 				{
 					Name:   "create-migration",
 					Usage:  "Create a new migration.",
@@ -268,6 +323,67 @@ func initLocalSubCmds(s *Shell, safe bool) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:  "gateway-audit",
+			Usage: "Commands for inspecting the requests recorded by the gateway audit log, when AuditLogConfig.Enabled is set in the gateway job.",
+			Subcommands: []cli.Command{
+				{
+					Name:   "list",
+					Usage:  "List the requests recorded by the gateway audit log, the most recent first.",
+					Action: s.ListGatewayAuditRecords,
+					Before: s.validateDB,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "sender",
+							Usage: "only list the requests of this sender address",
+						},
+						cli.StringFlag{
+							Name:  "method",
+							Usage: "only list the requests of this method",
+						},
+						cli.StringFlag{
+							Name:  "don-id",
+							Usage: "only list the requests to this DON",
+						},
+						cli.IntFlag{
+							Name:  "limit",
+							Usage: "maximum number of requests listed",
+							Value: 100,
+						},
+					},
+				},
+				{
+					Name:   "replay",
+					Usage:  "Replay a request recorded by the gateway audit log against a gateway, for debugging its handlers. Redacted or overridden payloads invalidate the signature of the user, so the request must be re-signed for the gateway to accept it. Requests which are not re-signed are only sent to a loopback address, unless --force is set.",
+					Action: s.ReplayGatewayAuditRecord,
+					Before: s.validateDB,
+					Flags: []cli.Flag{
+						cli.Int64Flag{
+							Name:     "id",
+							Usage:    "ID of the recorded request",
+							Required: true,
+						},
+						cli.StringFlag{
+							Name:     "url",
+							Usage:    "URL of the user endpoint of the gateway, e.g. http://localhost:5002/user",
+							Required: true,
+						},
+						cli.StringFlag{
+							Name:  "payload-file",
+							Usage: "file holding a JSON payload replacing the recorded one",
+						},
+						cli.StringFlag{
+							Name:  "private-key-file",
+							Usage: "file holding the hex-encoded private key re-signing the request",
+						},
+						cli.BoolFlag{
+							Name:  "force",
+							Usage: "send the request signed by its sender to a --url which is not a loopback address, without re-signing it",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -759,6 +875,59 @@ func (p *HealthCheckPresenter) ToRow() []string {
 	}
 }
 
+// RotateKeystorePassword re-encrypts all keys of the key store with a new password.
+// It must be run locally, while the node is stopped.
+func (s *Shell) RotateKeystorePassword(c *cli.Context) (err error) {
+	ctx := s.ctx()
+	if err = s.configExitErr(s.Config.ValidateDB); err != nil {
+		return err
+	}
+
+	cfg := s.Config
+	lggr := logger.Sugared(s.Logger.Named("RotateKeystorePassword"))
+	ldb := pg.NewLockedDB(cfg.AppID(), cfg.Database(), cfg.Database().Lock(), lggr)
+	// Keys must not be re-encrypted under a running node, so fail instead of waiting for the lease it holds. A lease left
+	// by a stopped node expires within LeaseDuration.
+	openCtx, cancel := context.WithTimeout(ctx, 2*cfg.Database().Lock().LeaseDuration())
+	defer cancel()
+	if err = ldb.Open(openCtx); err != nil {
+		return s.errorOut(errors.Wrap(err, "opening db, is the node stopped?"))
+	}
+	defer lggr.ErrorIfFn(ldb.Close, "Error closing db")
+
+	keyStore := keystore.New(ldb.DB(), utils.GetScryptParams(cfg), lggr)
+	isEmpty, err := keyStore.IsEmpty(ctx)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "error determining if keystore is empty"))
+	}
+	if isEmpty {
+		return s.errorOut(errors.New("key store is empty, there is no password to rotate"))
+	}
+	if err = s.KeyStoreAuthenticator.Authenticate(ctx, keyStore, s.Config.Password()); err != nil {
+		return s.errorOut(errors.Wrap(err, "error authenticating keystore"))
+	}
+
+	var newPassword string
+	if c.IsSet("new-password") {
+		newPassword, err = utils.PasswordFromFile(c.String("new-password"))
+		if err != nil {
+			return s.errorOut(errors.Wrap(err, "error reading new password"))
+		}
+		err = s.KeyStoreAuthenticator.validatePasswordStrength(newPassword)
+	} else {
+		newPassword, err = s.KeyStoreAuthenticator.promptNewPassword()
+	}
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	if err = keyStore.RotatePassword(ctx, newPassword, utils.GetScryptParams(s.Config)); err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to rotate key store password"))
+	}
+	fmt.Println("Key store password rotated. Set Password.Keystore to the new password before starting the node.")
+	return nil
+}
+
 type HealthCheckPresenters []HealthCheckPresenter
 
 // RenderTable implements TableRenderer
@@ -886,6 +1055,70 @@ func (s *Shell) RollbackDatabase(c *cli.Context) error {
 	return nil
 }
 
+// RestoreDatabase restores the database from a backup taken by the node, after validating it. The available backups
+// are listed if none is specified.
+func (s *Shell) RestoreDatabase(c *cli.Context) error {
+	ctx := s.ctx()
+	cfg := s.Config.Database()
+	u := cfg.URL()
+	if u.String() == "" {
+		return s.errorOut(errDBURLMissing)
+	}
+
+	if !c.Args().Present() {
+		dir, err := periodicbackup.BackupDir(s.Config.RootDir(), cfg.Backup())
+		if err != nil {
+			return s.errorOut(err)
+		}
+		backups, err := periodicbackup.ListBackups(dir)
+		if err != nil {
+			return s.errorOut(err)
+		}
+		if len(backups) == 0 {
+			return s.errorOut(errors.Errorf("no backups found in %s", dir))
+		}
+		fmt.Printf("Backups in %s, newest first:\n", dir)
+		for _, b := range backups {
+			fmt.Printf("  %s  %s  %d bytes", filepath.Base(b.Path), b.ModTime.Format(time.RFC3339), b.Size)
+			if b.Encrypted {
+				fmt.Print("  encrypted")
+			}
+			fmt.Println()
+		}
+		return s.errorOut(errors.New("You must specify the backup file to restore"))
+	}
+
+	password := s.Config.Password().Keystore()
+	if passwordFile := c.String("password"); passwordFile != "" {
+		p, err := utils.PasswordFromFile(passwordFile)
+		if err != nil {
+			return s.errorOut(errors.Wrap(err, "error reading password from file"))
+		}
+		password = p
+	}
+
+	fmt.Printf("Restoring %s into database %s will erase all of its data.\n", c.Args().First(), u.Redacted())
+	if !confirmAction(c) {
+		return nil
+	}
+
+	lggr := logger.Sugared(s.Logger.Named("RestoreDatabase"))
+	ldb := pg.NewLockedDB(s.Config.AppID(), cfg, cfg.Lock(), lggr)
+	// The database must not be restored under a running node, so fail instead of waiting for the lease it holds. A lease
+	// left by a stopped node expires within LeaseDuration.
+	openCtx, cancel := context.WithTimeout(ctx, 2*cfg.Lock().LeaseDuration())
+	defer cancel()
+	if err := ldb.Open(openCtx); err != nil {
+		return s.errorOut(errors.Wrap(err, "opening db, is the node stopped?"))
+	}
+	defer lggr.ErrorIfFn(ldb.Close, "Error closing db")
+
+	if err := periodicbackup.Restore(ctx, u, c.Args().First(), password, c.Bool("allow-missing-checksum"), s.Logger); err != nil {
+		return s.errorOut(err)
+	}
+	return nil
+}
+
 // VersionDatabase displays the current database version.
 func (s *Shell) VersionDatabase(_ *cli.Context) error {
 	ctx := s.ctx()
@@ -917,6 +1150,199 @@ func (s *Shell) StatusDatabase(_ *cli.Context) error {
 	return nil
 }
 
+// ImportRuns imports archived pipeline runs back into the database.
+func (s *Shell) ImportRuns(c *cli.Context) error {
+	ctx := s.ctx()
+	dir := c.String("dir")
+	if dir == "" {
+		dir = s.Config.JobPipeline().ArchiveDir()
+	}
+	if dir == "" {
+		return s.errorOut(errors.New("You must specify the archive directory with --dir, or JobPipeline.Archive.Dir"))
+	}
+
+	var filter pipeline.ImportRunsFilter
+	for name, t := range map[string]*time.Time{"from": &filter.From, "to": &filter.To} {
+		if v := c.String(name); v != "" {
+			parsed, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return s.errorOut(errors.Wrapf(err, "invalid --%s", name))
+			}
+			*t = parsed
+		}
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return s.errorOut(errors.New("--from must be before --to"))
+	}
+	jobID := c.Int64("job-id")
+	if jobID < 0 || jobID > math.MaxInt32 {
+		return s.errorOut(errors.Errorf("invalid --job-id %d", jobID))
+	}
+	filter.JobID = int32(jobID)
+
+	db, err := store.NewConnection(ctx, s.Config.Database())
+	if err != nil {
+		return fmt.Errorf("failed to initialize orm: %w", err)
+	}
+	defer db.Close()
+
+	result, err := pipeline.ImportRuns(ctx, db, dir, filter)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	fmt.Printf("Imported %d runs from %s, skipped %d runs already in the database and %d runs of deleted jobs.\n",
+		result.Imported, dir, result.Existing, result.Orphaned)
+	return nil
+}
+
+// ListGatewayAuditRecords lists the requests recorded by the gateway audit log.
+func (s *Shell) ListGatewayAuditRecords(c *cli.Context) error {
+	ctx := s.ctx()
+	limit := c.Int("limit")
+	if limit <= 0 {
+		return s.errorOut(errors.Errorf("invalid --limit %d", limit))
+	}
+	sender := c.String("sender")
+	if sender != "" && !gethCommon.IsHexAddress(sender) {
+		return s.errorOut(errors.Errorf("invalid --sender %s", sender))
+	}
+
+	db, err := store.NewConnection(ctx, s.Config.Database())
+	if err != nil {
+		return fmt.Errorf("failed to initialize orm: %w", err)
+	}
+	defer db.Close()
+
+	records, err := gateway.FindAuditRecords(ctx, db, gateway.AuditFilter{
+		Sender: sender,
+		Method: c.String("method"),
+		DonID:  c.String("don-id"),
+		Limit:  limit,
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+	if len(records) == 0 {
+		fmt.Println("No requests found.")
+		return nil
+	}
+	var rows [][]string
+	for _, r := range records {
+		result := r.ErrorCode.String()
+		if r.ErrorMessage != "" {
+			result += ": " + r.ErrorMessage
+		}
+		rows = append(rows, []string{
+			strconv.FormatInt(r.ID, 10),
+			r.ReceivedAt.Format(time.RFC3339Nano),
+			r.DonID,
+			r.Method,
+			r.MessageID,
+			r.Sender,
+			strconv.Itoa(len(r.NodeResponses)),
+			result,
+			r.Duration().String(),
+		})
+	}
+	renderList([]string{"ID", "Received At", "DON ID", "Method", "Message ID", "Sender", "Node Responses", "Result", "Duration"}, rows, os.Stdout)
+	return nil
+}
+
+// ReplayGatewayAuditRecord sends a request recorded by the gateway audit log to a gateway, and prints its response.
+// Unless the request is re-signed, it is only sent to a loopback address, the gateway which recorded it, as anyone
+// receiving the signature of its sender could replay it to other gateways.
+func (s *Shell) ReplayGatewayAuditRecord(c *cli.Context) error {
+	ctx := s.ctx()
+	target, err := url.Parse(c.String("url"))
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "invalid --url"))
+	}
+	if c.String("private-key-file") == "" && !c.Bool("force") && !isLoopbackHost(target.Hostname()) {
+		return s.errorOut(errors.Errorf("--url host %s is not a loopback address: use --private-key-file to re-sign the request, or --force to send it with the signature of its sender", target.Hostname()))
+	}
+
+	db, err := store.NewConnection(ctx, s.Config.Database())
+	if err != nil {
+		return fmt.Errorf("failed to initialize orm: %w", err)
+	}
+	defer db.Close()
+
+	record, err := gateway.FindAuditRecord(ctx, db, c.Int64("id"))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	msg := record.Request.Message
+	if msg == nil {
+		return s.errorOut(errors.Errorf("audit record %d has no request", record.ID))
+	}
+	modified := strings.Contains(string(msg.Body.Payload), strconv.Quote(gateway.RedactedValue))
+	if payloadFile := c.String("payload-file"); payloadFile != "" {
+		payload, err2 := os.ReadFile(payloadFile)
+		if err2 != nil {
+			return s.errorOut(errors.Wrap(err2, "failed to read payload file"))
+		}
+		if !json.Valid(payload) {
+			return s.errorOut(errors.Errorf("payload file %s does not hold valid JSON", payloadFile))
+		}
+		msg.Body.Payload = payload
+		modified = true
+	}
+	if keyFile := c.String("private-key-file"); keyFile != "" {
+		keyHex, err2 := os.ReadFile(keyFile)
+		if err2 != nil {
+			return s.errorOut(errors.Wrap(err2, "failed to read private key file"))
+		}
+		key, err2 := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(string(keyHex)), "0x"))
+		if err2 != nil {
+			return s.errorOut(errors.Wrap(err2, "invalid private key"))
+		}
+		if err2 = msg.Sign(key); err2 != nil {
+			return s.errorOut(errors.Wrap(err2, "failed to sign request"))
+		}
+		if !strings.EqualFold(msg.Body.Sender, record.Sender) {
+			fmt.Printf("Re-signed the request as %s instead of %s.\n", msg.Body.Sender, record.Sender)
+		}
+	} else if modified {
+		fmt.Println("WARNING: the payload of the request was redacted or replaced, so its signature is invalid. Use --private-key-file to re-sign it.")
+	}
+
+	codec := api.JsonRPCCodec{}
+	body, err := codec.EncodeRequest(msg)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to encode request"))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target.String(), bytes.NewReader(body))
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "invalid --url"))
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to send request"))
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to read response"))
+	}
+
+	fmt.Printf("Replayed request %s of audit record %d: %s\n", msg.Body.MessageId, record.ID, resp.Status)
+	var indented bytes.Buffer
+	if json.Indent(&indented, respBody, "", "  ") == nil {
+		respBody = indented.Bytes()
+	}
+	fmt.Println(string(respBody))
+	return nil
+}
+
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
 // CreateMigration displays the database migration status
 func (s *Shell) CreateMigration(c *cli.Context) error {
 	ctx := s.ctx()
@@ -562,3 +562,18 @@ func TestShell_RemoveBlocks(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestShell_ReplayGatewayAuditRecord_RefusesRemoteURL(t *testing.T) {
+	shell := cmd.Shell{
+		Config: configtest.NewGeneralConfig(t, nil),
+		Logger: logger.TestLogger(t),
+	}
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(shell.ReplayGatewayAuditRecord, set, "")
+	require.NoError(t, set.Set("id", "1"))
+	require.NoError(t, set.Set("url", "http://gateway.example.com/user"))
+	c := cli.NewContext(nil, set, nil)
+	err := shell.ReplayGatewayAuditRecord(c)
+	require.ErrorContains(t, err, "not a loopback address")
+}
@@ -61,6 +61,7 @@ type AppConfig interface {
 	Telemetry() Telemetry
 	CRE() CRE
 	Billing() Billing
+	RemoteSigner() RemoteSigner
 }
 
 type DatabaseBackupMode string
@@ -10,6 +10,8 @@ type Backup interface {
 	Frequency() time.Duration
 	Mode() DatabaseBackupMode
 	OnVersionUpgrade() bool
+	Retention() uint32
+	Encrypt() bool
 	URL() *url.URL
 }
 
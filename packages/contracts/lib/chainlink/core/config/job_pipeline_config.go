@@ -16,4 +16,7 @@ type JobPipeline interface {
 	ResultWriteQueueDepth() uint64
 	ExternalInitiatorsEnabled() bool
 	VerboseLogging() bool
+	// ArchiveEnabled is true if pruned runs are exported to ArchiveDir before they are deleted.
+	ArchiveEnabled() bool
+	ArchiveDir() string
 }
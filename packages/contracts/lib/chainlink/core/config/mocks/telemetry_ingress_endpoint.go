@@ -5,6 +5,8 @@ package mocks
 import (
 	url "net/url"
 
+	config "github.com/smartcontractkit/chainlink/v2/core/config"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -66,6 +68,53 @@ func (_c *TelemetryIngressEndpoint_ChainID_Call) RunAndReturn(run func() string)
 	return _c
 }
 
+// Local provides a mock function with no fields
+func (_m *TelemetryIngressEndpoint) Local() config.TelemetryLocalSink {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Local")
+	}
+
+	var r0 config.TelemetryLocalSink
+	if rf, ok := ret.Get(0).(func() config.TelemetryLocalSink); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.TelemetryLocalSink)
+		}
+	}
+
+	return r0
+}
+
+// TelemetryIngressEndpoint_Local_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Local'
+type TelemetryIngressEndpoint_Local_Call struct {
+	*mock.Call
+}
+
+// Local is a helper method to define mock.On call
+func (_e *TelemetryIngressEndpoint_Expecter) Local() *TelemetryIngressEndpoint_Local_Call {
+	return &TelemetryIngressEndpoint_Local_Call{Call: _e.mock.On("Local")}
+}
+
+func (_c *TelemetryIngressEndpoint_Local_Call) Run(run func()) *TelemetryIngressEndpoint_Local_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TelemetryIngressEndpoint_Local_Call) Return(_a0 config.TelemetryLocalSink) *TelemetryIngressEndpoint_Local_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TelemetryIngressEndpoint_Local_Call) RunAndReturn(run func() config.TelemetryLocalSink) *TelemetryIngressEndpoint_Local_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Network provides a mock function with no fields
 func (_m *TelemetryIngressEndpoint) Network() string {
 	ret := _m.Called()
@@ -0,0 +1,16 @@
+package config
+
+import (
+	"net/url"
+	"time"
+)
+
+type RemoteSigner interface {
+	Enabled() bool
+	URL() *url.URL
+	Timeout() time.Duration
+	AllowInsecureLoopback() bool
+	TLSCertPath() string
+	TLSKeyPath() string
+	TLSCACertPath() string
+}
@@ -3,6 +3,8 @@ package config
 import (
 	"net/url"
 	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 type TelemetryIngress interface {
@@ -21,4 +23,20 @@ type TelemetryIngressEndpoint interface {
 	ChainID() string
 	ServerPubKey() string
 	URL() *url.URL
+	// Local returns the local sink telemetry is written to instead of being sent to URL, or nil.
+	Local() TelemetryLocalSink
+}
+
+type TelemetryLocalSink interface {
+	// Dir is the directory of the rotating JSONL files, or empty if telemetry is not written to files.
+	Dir() string
+	MaxFileSize() utils.FileSize
+	MaxFiles() int64
+	// ListenAddr is the address telemetry is streamed on over gRPC, or empty if it is not streamed.
+	ListenAddr() string
+	// TLSCertPath, TLSKeyPath and TLSClientCACertPath serve the stream over mutual TLS, or are empty if the stream is
+	// served in plaintext on a loopback address.
+	TLSCertPath() string
+	TLSKeyPath() string
+	TLSClientCACertPath() string
 }
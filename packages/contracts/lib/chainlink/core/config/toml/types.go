@@ -63,6 +63,7 @@ type Core struct {
 	Workflows        Workflows        `toml:",omitempty"`
 	CRE              CreConfig        `toml:",omitempty"`
 	Billing          Billing          `toml:",omitempty"`
+	RemoteSigner     RemoteSigner     `toml:",omitempty"`
 }
 
 // SetFrom updates c with any non-nil values from f. (currently TOML field only!)
@@ -106,6 +107,7 @@ func (c *Core) SetFrom(f *Core) {
 	c.Telemetry.setFrom(&f.Telemetry)
 	c.CRE.setFrom(&f.CRE)
 	c.Billing.setFrom(&f.Billing)
+	c.RemoteSigner.setFrom(&f.RemoteSigner)
 }
 
 func (c *Core) ValidateConfig() (err error) {
@@ -575,6 +577,8 @@ type DatabaseBackup struct {
 	Frequency        *commonconfig.Duration
 	Mode             *config.DatabaseBackupMode
 	OnVersionUpgrade *bool
+	Retention        *uint32
+	Encrypt          *bool
 }
 
 func (d *DatabaseBackup) setFrom(f *DatabaseBackup) {
@@ -590,6 +594,19 @@ func (d *DatabaseBackup) setFrom(f *DatabaseBackup) {
 	if v := f.OnVersionUpgrade; v != nil {
 		d.OnVersionUpgrade = v
 	}
+	if v := f.Retention; v != nil {
+		d.Retention = v
+	}
+	if v := f.Encrypt; v != nil {
+		d.Encrypt = v
+	}
+}
+
+func (d *DatabaseBackup) ValidateConfig() (err error) {
+	if d.Retention != nil && *d.Retention == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Retention", Value: *d.Retention, Msg: "must keep at least one backup"})
+	}
+	return
 }
 
 type TelemetryIngress struct {
@@ -608,6 +625,60 @@ type TelemetryIngressEndpoint struct {
 	ChainID      *string
 	URL          *commonconfig.URL
 	ServerPubKey *string
+	Sink         *string
+	Dir          *string
+	MaxFileSize  *utils.FileSize
+	MaxFiles     *int64
+	ListenAddr   *string
+	// TLSCertPath, TLSKeyPath and TLSClientCACertPath serve the stream on ListenAddr over mutual TLS. They are required
+	// unless ListenAddr is a loopback address.
+	TLSCertPath         *string
+	TLSKeyPath          *string
+	TLSClientCACertPath *string
+}
+
+const (
+	TelemetrySinkIngress = "ingress"
+	TelemetrySinkLocal   = "local"
+)
+
+func (t *TelemetryIngressEndpoint) ValidateConfig() (err error) {
+	if t.Sink == nil || *t.Sink == TelemetrySinkIngress {
+		return
+	}
+	if *t.Sink != TelemetrySinkLocal {
+		return configutils.ErrInvalid{Name: "Sink", Value: *t.Sink, Msg: fmt.Sprintf("must be %q or %q", TelemetrySinkIngress, TelemetrySinkLocal)}
+	}
+	if (t.Dir == nil || *t.Dir == "") && (t.ListenAddr == nil || *t.ListenAddr == "") {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "Dir", Msg: "a local sink requires Dir and/or ListenAddr"})
+	}
+	if t.MaxFiles != nil && *t.MaxFiles < 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "MaxFiles", Value: *t.MaxFiles, Msg: "must not be negative"})
+	}
+	if t.ListenAddr != nil && *t.ListenAddr != "" {
+		tlsPaths := []struct {
+			name  string
+			value *string
+		}{
+			{"TLSCertPath", t.TLSCertPath},
+			{"TLSKeyPath", t.TLSKeyPath},
+			{"TLSClientCACertPath", t.TLSClientCACertPath},
+		}
+		var anyTLS bool
+		for _, p := range tlsPaths {
+			anyTLS = anyTLS || (p.value != nil && *p.value != "")
+		}
+		if anyTLS {
+			for _, p := range tlsPaths {
+				if p.value == nil || *p.value == "" {
+					err = multierr.Append(err, configutils.ErrMissing{Name: p.name, Msg: "must be set to stream telemetry over mutual TLS"})
+				}
+			}
+		} else if host, _, splitErr := net.SplitHostPort(*t.ListenAddr); splitErr != nil || !isLoopbackHost(host) {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: "ListenAddr", Value: *t.ListenAddr, Msg: "must be a loopback address, unless TLSCertPath, TLSKeyPath and TLSClientCACertPath are set"})
+		}
+	}
+	return
 }
 
 func (t *TelemetryIngress) setFrom(f *TelemetryIngress) {
@@ -748,10 +819,12 @@ type WebServer struct {
 	StartTimeout            *commonconfig.Duration
 	ListenIP                *net.IP
 
-	LDAP      WebServerLDAP      `toml:",omitempty"`
-	MFA       WebServerMFA       `toml:",omitempty"`
-	RateLimit WebServerRateLimit `toml:",omitempty"`
-	TLS       WebServerTLS       `toml:",omitempty"`
+	LDAP            WebServerLDAP            `toml:",omitempty"`
+	MFA             WebServerMFA             `toml:",omitempty"`
+	RateLimit       WebServerRateLimit       `toml:",omitempty"`
+	TLS             WebServerTLS             `toml:",omitempty"`
+	BridgeHealth    WebServerBridgeHealth    `toml:",omitempty"`
+	BridgeClientTLS WebServerBridgeClientTLS `toml:",omitempty"`
 }
 
 func (w *WebServer) setFrom(f *WebServer) {
@@ -796,6 +869,8 @@ func (w *WebServer) setFrom(f *WebServer) {
 	w.MFA.setFrom(&f.MFA)
 	w.RateLimit.setFrom(&f.RateLimit)
 	w.TLS.setFrom(&f.TLS)
+	w.BridgeHealth.setFrom(&f.BridgeHealth)
+	w.BridgeClientTLS.setFrom(&f.BridgeClientTLS)
 }
 
 func (w *WebServer) ValidateConfig() (err error) {
@@ -898,6 +973,69 @@ func (w *WebServerTLS) setFrom(f *WebServerTLS) {
 	}
 }
 
+type WebServerBridgeHealth struct {
+	ProbeInterval    *commonconfig.Duration
+	ProbeTimeout     *commonconfig.Duration
+	FailureThreshold *uint32
+	OpenTimeout      *commonconfig.Duration
+}
+
+func (w *WebServerBridgeHealth) setFrom(f *WebServerBridgeHealth) {
+	if v := f.ProbeInterval; v != nil {
+		w.ProbeInterval = v
+	}
+	if v := f.ProbeTimeout; v != nil {
+		w.ProbeTimeout = v
+	}
+	if v := f.FailureThreshold; v != nil {
+		w.FailureThreshold = v
+	}
+	if v := f.OpenTimeout; v != nil {
+		w.OpenTimeout = v
+	}
+}
+
+func (w *WebServerBridgeHealth) ValidateConfig() (err error) {
+	if w.ProbeInterval != nil && w.ProbeInterval.Duration() > 0 && w.ProbeTimeout != nil && w.ProbeTimeout.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "ProbeTimeout", Value: w.ProbeTimeout.Duration(), Msg: "must be positive when ProbeInterval is set"})
+	}
+	if w.FailureThreshold != nil && *w.FailureThreshold > 0 && w.OpenTimeout != nil && w.OpenTimeout.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "OpenTimeout", Value: w.OpenTimeout.Duration(), Msg: "must be positive when FailureThreshold is set"})
+	}
+	return
+}
+
+type WebServerBridgeClientTLS struct {
+	CertPath *string
+	KeyPath  *string
+}
+
+func (w *WebServerBridgeClientTLS) setFrom(f *WebServerBridgeClientTLS) {
+	if v := f.CertPath; v != nil {
+		w.CertPath = v
+	}
+	if v := f.KeyPath; v != nil {
+		w.KeyPath = v
+	}
+}
+
+func (w *WebServerBridgeClientTLS) ValidateConfig() (err error) {
+	var certPath, keyPath string
+	if w.CertPath != nil {
+		certPath = *w.CertPath
+	}
+	if w.KeyPath != nil {
+		keyPath = *w.KeyPath
+	}
+	if certPath != "" && keyPath == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "KeyPath", Msg: "required with CertPath"})
+	}
+	if keyPath != "" && certPath == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "CertPath", Msg: "required with KeyPath"})
+	}
+	return
+}
+
 type WebServerLDAP struct {
 	ServerTLS                   *bool
 	SessionTimeout              *commonconfig.Duration
@@ -1012,6 +1150,7 @@ type JobPipeline struct {
 	VerboseLogging            *bool
 
 	HTTPRequest JobPipelineHTTPRequest `toml:",omitempty"`
+	Archive     JobPipelineArchive     `toml:",omitempty"`
 }
 
 func (j *JobPipeline) setFrom(f *JobPipeline) {
@@ -1037,6 +1176,7 @@ func (j *JobPipeline) setFrom(f *JobPipeline) {
 		j.VerboseLogging = v
 	}
 	j.HTTPRequest.setFrom(&f.HTTPRequest)
+	j.Archive.setFrom(&f.Archive)
 }
 
 type JobPipelineHTTPRequest struct {
@@ -1053,6 +1193,30 @@ func (j *JobPipelineHTTPRequest) setFrom(f *JobPipelineHTTPRequest) {
 	}
 }
 
+type JobPipelineArchive struct {
+	Enabled *bool
+	Dir     *string
+}
+
+func (j *JobPipelineArchive) setFrom(f *JobPipelineArchive) {
+	if v := f.Enabled; v != nil {
+		j.Enabled = v
+	}
+	if v := f.Dir; v != nil {
+		j.Dir = v
+	}
+}
+
+func (j *JobPipelineArchive) ValidateConfig() (err error) {
+	if j.Enabled == nil || !*j.Enabled {
+		return
+	}
+	if j.Dir == nil || *j.Dir == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "Dir", Msg: "required when Enabled"})
+	}
+	return
+}
+
 type FluxMonitor struct {
 	DefaultTransactionQueueDepth *uint32
 	SimulateTransactions         *bool
@@ -2100,3 +2264,88 @@ func (b *Billing) ValidateConfig() error {
 
 	return nil
 }
+
+type RemoteSigner struct {
+	Enabled *bool
+	URL     *commonconfig.URL
+	Timeout *commonconfig.Duration
+	// AllowInsecureLoopback allows an http URL, without authentication, for a signer on the loopback interface
+	AllowInsecureLoopback *bool
+	TLS                   RemoteSignerTLS `toml:",omitempty"`
+}
+
+func (r *RemoteSigner) setFrom(f *RemoteSigner) {
+	if v := f.Enabled; v != nil {
+		r.Enabled = v
+	}
+	if v := f.URL; v != nil {
+		r.URL = v
+	}
+	if v := f.Timeout; v != nil {
+		r.Timeout = v
+	}
+	if v := f.AllowInsecureLoopback; v != nil {
+		r.AllowInsecureLoopback = v
+	}
+	r.TLS.setFrom(&f.TLS)
+}
+
+func (r *RemoteSigner) ValidateConfig() (err error) {
+	if r.Enabled == nil || !*r.Enabled {
+		return
+	}
+	if r.URL == nil || r.URL.URL() == nil || r.URL.URL().String() == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "URL", Msg: "must be set when remote signer is enabled"})
+	} else {
+		switch u := r.URL.URL(); u.Scheme {
+		case "https":
+			for _, f := range []struct {
+				name string
+				v    *string
+			}{{"TLS.CertPath", r.TLS.CertPath}, {"TLS.KeyPath", r.TLS.KeyPath}, {"TLS.CACertPath", r.TLS.CACertPath}} {
+				if f.v == nil || *f.v == "" {
+					err = multierr.Append(err, configutils.ErrMissing{Name: f.name, Msg: "must be set for mutual TLS with the remote signer"})
+				}
+			}
+		case "http":
+			if r.AllowInsecureLoopback == nil || !*r.AllowInsecureLoopback || !isLoopbackHost(u.Hostname()) {
+				err = multierr.Append(err, configutils.ErrInvalid{Name: "URL", Value: r.URL.String(), Msg: "must be an https URL, http is only allowed for a loopback address with AllowInsecureLoopback"})
+			}
+		default:
+			err = multierr.Append(err, configutils.ErrInvalid{Name: "URL", Value: r.URL.String(), Msg: "must be an http or https URL"})
+		}
+	}
+	if r.Timeout == nil || r.Timeout.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Timeout", Value: r.Timeout, Msg: "must be greater than 0"})
+	}
+	return
+}
+
+// RemoteSignerTLS authenticates the node and the remote signer to each other.
+type RemoteSignerTLS struct {
+	// CertPath and KeyPath are the client certificate of the node
+	CertPath *string
+	KeyPath  *string
+	// CACertPath is the CA which issued the certificate of the signer
+	CACertPath *string
+}
+
+func (r *RemoteSignerTLS) setFrom(f *RemoteSignerTLS) {
+	if v := f.CertPath; v != nil {
+		r.CertPath = v
+	}
+	if v := f.KeyPath; v != nil {
+		r.KeyPath = v
+	}
+	if v := f.CACertPath; v != nil {
+		r.CACertPath = v
+	}
+}
+
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pelletier/go-toml/v2"
 	"github.com/stretchr/testify/assert"
@@ -535,6 +536,177 @@ func TestTracing_ValidateMode(t *testing.T) {
 	}
 }
 
+func TestTelemetryIngressEndpoint_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint TelemetryIngressEndpoint
+		errMsg   string
+	}{
+		{
+			name:     "ingress sink",
+			endpoint: TelemetryIngressEndpoint{Sink: ptr(TelemetrySinkIngress)},
+		},
+		{
+			name:     "local sink with dir",
+			endpoint: TelemetryIngressEndpoint{Sink: ptr(TelemetrySinkLocal), Dir: ptr("telemetry")},
+		},
+		{
+			name:     "local sink with listen address",
+			endpoint: TelemetryIngressEndpoint{Sink: ptr(TelemetrySinkLocal), ListenAddr: ptr("127.0.0.1:6699")},
+		},
+		{
+			name:     "local sink with listen address on all interfaces",
+			endpoint: TelemetryIngressEndpoint{Sink: ptr(TelemetrySinkLocal), ListenAddr: ptr(":6699")},
+			errMsg:   "ListenAddr: invalid value (:6699): must be a loopback address, unless TLSCertPath, TLSKeyPath and TLSClientCACertPath are set",
+		},
+		{
+			name: "local sink with listen address and TLS",
+			endpoint: TelemetryIngressEndpoint{Sink: ptr(TelemetrySinkLocal), ListenAddr: ptr("0.0.0.0:6699"),
+				TLSCertPath: ptr("telemetry.crt"), TLSKeyPath: ptr("telemetry.key"), TLSClientCACertPath: ptr("clients-ca.crt")},
+		},
+		{
+			name:     "local sink with partial TLS",
+			endpoint: TelemetryIngressEndpoint{Sink: ptr(TelemetrySinkLocal), ListenAddr: ptr("0.0.0.0:6699"), TLSCertPath: ptr("telemetry.crt")},
+			errMsg:   "TLSKeyPath: missing: must be set to stream telemetry over mutual TLS; TLSClientCACertPath: missing: must be set to stream telemetry over mutual TLS",
+		},
+		{
+			name:     "local sink without output",
+			endpoint: TelemetryIngressEndpoint{Sink: ptr(TelemetrySinkLocal)},
+			errMsg:   "Dir: missing: a local sink requires Dir and/or ListenAddr",
+		},
+		{
+			name:     "negative max files",
+			endpoint: TelemetryIngressEndpoint{Sink: ptr(TelemetrySinkLocal), Dir: ptr("telemetry"), MaxFiles: ptr[int64](-1)},
+			errMsg:   "MaxFiles: invalid value (-1): must not be negative",
+		},
+		{
+			name:     "invalid sink",
+			endpoint: TelemetryIngressEndpoint{Sink: ptr("unknown")},
+			errMsg:   `Sink: invalid value (unknown): must be "ingress" or "local"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.endpoint.ValidateConfig()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestWebServerBridgeHealth_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		health WebServerBridgeHealth
+		errMsg string
+	}{
+		{
+			name:   "disabled",
+			health: WebServerBridgeHealth{ProbeInterval: commonconfig.MustNewDuration(0), ProbeTimeout: commonconfig.MustNewDuration(0), FailureThreshold: ptr[uint32](0), OpenTimeout: commonconfig.MustNewDuration(0)},
+		},
+		{
+			name:   "enabled",
+			health: WebServerBridgeHealth{ProbeInterval: commonconfig.MustNewDuration(time.Minute), ProbeTimeout: commonconfig.MustNewDuration(time.Second), FailureThreshold: ptr[uint32](5), OpenTimeout: commonconfig.MustNewDuration(time.Minute)},
+		},
+		{
+			name:   "probing without timeout",
+			health: WebServerBridgeHealth{ProbeInterval: commonconfig.MustNewDuration(time.Minute), ProbeTimeout: commonconfig.MustNewDuration(0)},
+			errMsg: "ProbeTimeout: invalid value (0s): must be positive when ProbeInterval is set",
+		},
+		{
+			name:   "circuit breaker without open timeout",
+			health: WebServerBridgeHealth{FailureThreshold: ptr[uint32](5), OpenTimeout: commonconfig.MustNewDuration(0)},
+			errMsg: "OpenTimeout: invalid value (0s): must be positive when FailureThreshold is set",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.health.ValidateConfig()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestWebServerBridgeClientTLS_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		tls    WebServerBridgeClientTLS
+		errMsg string
+	}{
+		{
+			name: "disabled",
+			tls:  WebServerBridgeClientTLS{CertPath: ptr(""), KeyPath: ptr("")},
+		},
+		{
+			name: "enabled",
+			tls:  WebServerBridgeClientTLS{CertPath: ptr("/tls/bridge.crt"), KeyPath: ptr("/tls/bridge.key")},
+		},
+		{
+			name:   "missing key",
+			tls:    WebServerBridgeClientTLS{CertPath: ptr("/tls/bridge.crt")},
+			errMsg: "KeyPath: missing: required with CertPath",
+		},
+		{
+			name:   "missing cert",
+			tls:    WebServerBridgeClientTLS{CertPath: ptr(""), KeyPath: ptr("/tls/bridge.key")},
+			errMsg: "CertPath: missing: required with KeyPath",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.tls.ValidateConfig()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestJobPipelineArchive_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		archive JobPipelineArchive
+		errMsg  string
+	}{
+		{
+			name:    "disabled",
+			archive: JobPipelineArchive{Enabled: ptr(false), Dir: ptr("")},
+		},
+		{
+			name:    "enabled",
+			archive: JobPipelineArchive{Enabled: ptr(true), Dir: ptr("/chainlink/archive")},
+		},
+		{
+			name:    "missing dir",
+			archive: JobPipelineArchive{Enabled: ptr(true), Dir: ptr("")},
+			errMsg:  "Dir: missing: required when Enabled",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.archive.ValidateConfig()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errMsg)
+			}
+		})
+	}
+}
+
 func TestMercuryTLS_ValidateTLSCertPath(t *testing.T) {
 	tests := []struct {
 		name        string
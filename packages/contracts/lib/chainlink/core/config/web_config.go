@@ -27,6 +27,24 @@ type RateLimit interface {
 	UnauthenticatedPeriod() time.Duration
 }
 
+type BridgeHealth interface {
+	// ProbeInterval is the interval bridges are probed at, or zero if they are not probed.
+	ProbeInterval() time.Duration
+	ProbeTimeout() time.Duration
+	// FailureThreshold is the number of consecutive failures opening the circuit breaker of a bridge URL, or zero
+	// if the circuit breaker is disabled.
+	FailureThreshold() uint32
+	// OpenTimeout is how long an open circuit breaker fails requests before letting a trial request through.
+	OpenTimeout() time.Duration
+}
+
+// BridgeClientTLS is the client certificate presented to external adapters.
+type BridgeClientTLS interface {
+	// CertPath is the path of the client certificate, or empty if none is presented.
+	CertPath() string
+	KeyPath() string
+}
+
 type MFA interface {
 	RPID() string
 	RPOrigin() string
@@ -74,4 +92,6 @@ type WebServer interface {
 	RateLimit() RateLimit
 	MFA() MFA
 	LDAP() LDAP
+	BridgeHealth() BridgeHealth
+	BridgeClientTLS() BridgeClientTLS
 }
@@ -18,6 +18,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/bridges/signing"
 
 	"github.com/smartcontractkit/chainlink-evm/pkg/heads"
 	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
@@ -55,8 +56,10 @@ func NewPeerID() (id ragep2ptypes.PeerID) {
 }
 
 type BridgeOpts struct {
-	Name string
-	URL  string
+	Name          string
+	URL           string
+	FallbackURLs  []string
+	SigningScheme signing.Scheme
 }
 
 // NewBridgeType create new bridge type given info slice
@@ -77,6 +80,10 @@ func NewBridgeType(t testing.TB, opts BridgeOpts) (*bridges.BridgeTypeAuthentica
 	} else {
 		btr.URL = WebURL(t, "https://bridge.example.com/api?"+rnd)
 	}
+	for _, u := range opts.FallbackURLs {
+		btr.FallbackURLs = append(btr.FallbackURLs, WebURL(t, u))
+	}
+	btr.SigningScheme = opts.SigningScheme
 
 	bta, bt, err := bridges.NewBridgeType(btr)
 	require.NoError(t, err)
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	admin "github.com/smartcontractkit/chainlink/v2/core/services/workflows/admin"
+
 	big "math/big"
 
 	audit "github.com/smartcontractkit/chainlink/v2/core/logger/audit"
@@ -202,6 +204,53 @@ func (_c *Application_BasicAdminUsersORM_Call) RunAndReturn(run func() sessions.
 	return _c
 }
 
+// BridgeHealth provides a mock function with no fields
+func (_m *Application) BridgeHealth() *bridges.HealthMonitor {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BridgeHealth")
+	}
+
+	var r0 *bridges.HealthMonitor
+	if rf, ok := ret.Get(0).(func() *bridges.HealthMonitor); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*bridges.HealthMonitor)
+		}
+	}
+
+	return r0
+}
+
+// Application_BridgeHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BridgeHealth'
+type Application_BridgeHealth_Call struct {
+	*mock.Call
+}
+
+// BridgeHealth is a helper method to define mock.On call
+func (_e *Application_Expecter) BridgeHealth() *Application_BridgeHealth_Call {
+	return &Application_BridgeHealth_Call{Call: _e.mock.On("BridgeHealth")}
+}
+
+func (_c *Application_BridgeHealth_Call) Run(run func()) *Application_BridgeHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_BridgeHealth_Call) Return(_a0 *bridges.HealthMonitor) *Application_BridgeHealth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_BridgeHealth_Call) RunAndReturn(run func() *bridges.HealthMonitor) *Application_BridgeHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BridgeORM provides a mock function with no fields
 func (_m *Application) BridgeORM() bridges.ORM {
 	ret := _m.Called()
@@ -1012,6 +1061,53 @@ func (_c *Application_GetWebAuthnConfiguration_Call) RunAndReturn(run func() ses
 	return _c
 }
 
+// GetWorkflowsService provides a mock function with no fields
+func (_m *Application) GetWorkflowsService() admin.Service {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkflowsService")
+	}
+
+	var r0 admin.Service
+	if rf, ok := ret.Get(0).(func() admin.Service); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(admin.Service)
+		}
+	}
+
+	return r0
+}
+
+// Application_GetWorkflowsService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkflowsService'
+type Application_GetWorkflowsService_Call struct {
+	*mock.Call
+}
+
+// GetWorkflowsService is a helper method to define mock.On call
+func (_e *Application_Expecter) GetWorkflowsService() *Application_GetWorkflowsService_Call {
+	return &Application_GetWorkflowsService_Call{Call: _e.mock.On("GetWorkflowsService")}
+}
+
+func (_c *Application_GetWorkflowsService_Call) Run(run func()) *Application_GetWorkflowsService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_GetWorkflowsService_Call) Return(_a0 admin.Service) *Application_GetWorkflowsService_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_GetWorkflowsService_Call) RunAndReturn(run func() admin.Service) *Application_GetWorkflowsService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ID provides a mock function with no fields
 func (_m *Application) ID() uuid.UUID {
 	ret := _m.Called()
@@ -1508,6 +1604,74 @@ func (_c *Application_SetLogLevel_Call) RunAndReturn(run func(zapcore.Level) err
 	return _c
 }
 
+// SimulatePipelineRun provides a mock function with given fields: ctx, spec, vars, stubs
+func (_m *Application) SimulatePipelineRun(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, stubs map[string]pipeline.TaskStub) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	ret := _m.Called(ctx, spec, vars, stubs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulatePipelineRun")
+	}
+
+	var r0 *pipeline.Run
+	var r1 pipeline.TaskRunResults
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Vars, map[string]pipeline.TaskStub) (*pipeline.Run, pipeline.TaskRunResults, error)); ok {
+		return rf(ctx, spec, vars, stubs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Vars, map[string]pipeline.TaskStub) *pipeline.Run); ok {
+		r0 = rf(ctx, spec, vars, stubs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.Spec, pipeline.Vars, map[string]pipeline.TaskStub) pipeline.TaskRunResults); ok {
+		r1 = rf(ctx, spec, vars, stubs)
+	} else {
+		r1 = ret.Get(1).(pipeline.TaskRunResults)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, pipeline.Spec, pipeline.Vars, map[string]pipeline.TaskStub) error); ok {
+		r2 = rf(ctx, spec, vars, stubs)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Application_SimulatePipelineRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulatePipelineRun'
+type Application_SimulatePipelineRun_Call struct {
+	*mock.Call
+}
+
+// SimulatePipelineRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec pipeline.Spec
+//   - vars pipeline.Vars
+//   - stubs map[string]pipeline.TaskStub
+func (_e *Application_Expecter) SimulatePipelineRun(ctx interface{}, spec interface{}, vars interface{}, stubs interface{}) *Application_SimulatePipelineRun_Call {
+	return &Application_SimulatePipelineRun_Call{Call: _e.mock.On("SimulatePipelineRun", ctx, spec, vars, stubs)}
+}
+
+func (_c *Application_SimulatePipelineRun_Call) Run(run func(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, stubs map[string]pipeline.TaskStub)) *Application_SimulatePipelineRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pipeline.Spec), args[2].(pipeline.Vars), args[3].(map[string]pipeline.TaskStub))
+	})
+	return _c
+}
+
+func (_c *Application_SimulatePipelineRun_Call) Return(_a0 *pipeline.Run, _a1 pipeline.TaskRunResults, _a2 error) *Application_SimulatePipelineRun_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Application_SimulatePipelineRun_Call) RunAndReturn(run func(context.Context, pipeline.Spec, pipeline.Vars, map[string]pipeline.TaskStub) (*pipeline.Run, pipeline.TaskRunResults, error)) *Application_SimulatePipelineRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Application) Start(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -1599,6 +1763,66 @@ func (_c *Application_Stop_Call) RunAndReturn(run func() error) *Application_Sto
 	return _c
 }
 
+// SubscribePipelineRunEvents provides a mock function with given fields: jobID
+func (_m *Application) SubscribePipelineRunEvents(jobID int32) (<-chan pipeline.RunEvent, func()) {
+	ret := _m.Called(jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribePipelineRunEvents")
+	}
+
+	var r0 <-chan pipeline.RunEvent
+	var r1 func()
+	if rf, ok := ret.Get(0).(func(int32) (<-chan pipeline.RunEvent, func())); ok {
+		return rf(jobID)
+	}
+	if rf, ok := ret.Get(0).(func(int32) <-chan pipeline.RunEvent); ok {
+		r0 = rf(jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan pipeline.RunEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int32) func()); ok {
+		r1 = rf(jobID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
+
+// Application_SubscribePipelineRunEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribePipelineRunEvents'
+type Application_SubscribePipelineRunEvents_Call struct {
+	*mock.Call
+}
+
+// SubscribePipelineRunEvents is a helper method to define mock.On call
+//   - jobID int32
+func (_e *Application_Expecter) SubscribePipelineRunEvents(jobID interface{}) *Application_SubscribePipelineRunEvents_Call {
+	return &Application_SubscribePipelineRunEvents_Call{Call: _e.mock.On("SubscribePipelineRunEvents", jobID)}
+}
+
+func (_c *Application_SubscribePipelineRunEvents_Call) Run(run func(jobID int32)) *Application_SubscribePipelineRunEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int32))
+	})
+	return _c
+}
+
+func (_c *Application_SubscribePipelineRunEvents_Call) Return(events <-chan pipeline.RunEvent, unsubscribe func()) *Application_SubscribePipelineRunEvents_Call {
+	_c.Call.Return(events, unsubscribe)
+	return _c
+}
+
+func (_c *Application_SubscribePipelineRunEvents_Call) RunAndReturn(run func(int32) (<-chan pipeline.RunEvent, func())) *Application_SubscribePipelineRunEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TxmStorageService provides a mock function with no fields
 func (_m *Application) TxmStorageService() txmgr.EvmTxStore {
 	ret := _m.Called()
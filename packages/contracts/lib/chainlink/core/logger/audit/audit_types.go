@@ -57,6 +57,8 @@ const (
 
 	JobCreated EventID = "JOB_CREATED"
 	JobDeleted EventID = "JOB_DELETED"
+	JobPaused  EventID = "JOB_PAUSED"
+	JobResumed EventID = "JOB_RESUMED"
 
 	ChainAdded       EventID = "CHAIN_ADDED"
 	ChainSpecUpdated EventID = "CHAIN_SPEC_UPDATED"
@@ -69,12 +71,16 @@ const (
 	BridgeUpdated EventID = "BRIDGE_UPDATED"
 	BridgeDeleted EventID = "BRIDGE_DELETED"
 
+	PipelineFragmentCreated EventID = "PIPELINE_FRAGMENT_CREATED"
+
 	ForwarderCreated EventID = "FORWARDER_CREATED"
 	ForwarderDeleted EventID = "FORWARDER_DELETED"
 
 	ExternalInitiatorCreated EventID = "EXTERNAL_INITIATOR_CREATED"
 	ExternalInitiatorDeleted EventID = "EXTERNAL_INITIATOR_DELETED"
 
+	ExternalInitiatorDeliveriesReplayed EventID = "EXTERNAL_INITIATOR_DELIVERIES_REPLAYED"
+
 	JobProposalSpecApproved EventID = "JOB_PROPOSAL_SPEC_APPROVED"
 	JobProposalSpecUpdated  EventID = "JOB_PROPOSAL_SPEC_UPDATED"
 	JobProposalSpecCanceled EventID = "JOB_PROPOSAL_SPEC_CANCELED"
@@ -85,6 +91,9 @@ const (
 	ConfigSqlLoggingDisabled EventID = "CONFIG_SQL_LOGGING_DISABLED"
 	GlobalLogLevelSet        EventID = "GLOBAL_LOG_LEVEL_SET"
 
+	WorkflowEnginePaused  EventID = "WORKFLOW_ENGINE_PAUSED"
+	WorkflowEngineResumed EventID = "WORKFLOW_ENGINE_RESUMED"
+
 	JobErrorDismissed EventID = "JOB_ERROR_DISMISSED"
 	JobRunSet         EventID = "JOB_RUN_SET"
 
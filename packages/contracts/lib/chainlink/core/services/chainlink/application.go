@@ -84,6 +84,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	workflowsadmin "github.com/smartcontractkit/chainlink/v2/core/services/workflows/admin"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/artifacts"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/ratelimiter"
 	workflowstore "github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
@@ -93,6 +94,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/sessions/ldapauth"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions/localauth"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
+	clutils "github.com/smartcontractkit/chainlink/v2/core/utils"
 	"github.com/smartcontractkit/chainlink/v2/plugins"
 )
 
@@ -123,6 +125,7 @@ type Application interface {
 	EVMORM() evmtypes.Configs
 	PipelineORM() pipeline.ORM
 	BridgeORM() bridges.ORM
+	BridgeHealth() *bridges.HealthMonitor
 	BasicAdminUsersORM() sessions.BasicAdminUsersORM
 	AuthenticationProvider() sessions.AuthenticationProvider
 	TxmStorageService() txmgr.EvmTxStore
@@ -132,10 +135,15 @@ type Application interface {
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
+	SimulatePipelineRun(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, stubs map[string]pipeline.TaskStub) (*pipeline.Run, pipeline.TaskRunResults, error)
+	SubscribePipelineRunEvents(jobID int32) (events <-chan pipeline.RunEvent, unsubscribe func())
 
 	// Feeds
 	GetFeedsService() feeds.Service
 
+	// Workflows
+	GetWorkflowsService() workflowsadmin.Service
+
 	// ReplayFromBlock replays logs from on or after the given block number. If forceBroadcast (evm only)
 	// is set to true, consumers will reprocess data even if it has already been processed.
 	ReplayFromBlock(ctx context.Context, chainFamily string, chainID string, number uint64, forceBroadcast bool) error
@@ -165,6 +173,7 @@ type ChainlinkApplication struct {
 	authenticationProvider   sessions.AuthenticationProvider
 	txmStorageService        txmgr.EvmTxStore
 	FeedsService             feeds.Service
+	workflowsService         workflowsadmin.Service
 	webhookJobRunner         webhook.JobRunner
 	Config                   GeneralConfig
 	KeyStore                 keystore.Master
@@ -405,7 +414,10 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 	if backupCfg.Mode() != config.DatabaseBackupModeNone && backupCfg.Frequency() > 0 {
 		globalLogger.Infow("DatabaseBackup: periodic database backups are enabled", "frequency", backupCfg.Frequency())
 
-		databaseBackup, err := periodicbackup.NewDatabaseBackup(cfg.Database().URL(), cfg.RootDir(), backupCfg, globalLogger)
+		databaseBackup, err := periodicbackup.NewDatabaseBackup(cfg.Database().URL(), cfg.RootDir(), backupCfg, periodicbackup.Encryption{
+			Password:     cfg.Password().Keystore(),
+			ScryptParams: clutils.GetScryptParams(cfg),
+		}, globalLogger)
 		if err != nil {
 			return nil, errors.Wrap(err, "NewApplication: failed to initialize database backup")
 		}
@@ -424,6 +436,10 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 	if opts.LLOTransmissionReaper != nil {
 		srvcs = append(srvcs, opts.LLOTransmissionReaper)
 	}
+	// the external initiator manager delivers the notifications of webhook jobs to external initiators
+	if eim, ok := externalInitiatorManager.(services.ServiceCtx); ok {
+		srvcs = append(srvcs, eim)
+	}
 
 	// EVM chains are used all over the place. This will need to change for fully EVM extraction
 	// TODO: BCF-2510, BCF-2511
@@ -469,13 +485,15 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		pipelineORM    = pipeline.NewORM(opts.DS, globalLogger, cfg.JobPipeline().MaxSuccessfulRuns())
 		bridgeORM      = bridges.NewORM(opts.DS)
 		mercuryORM     = mercury.NewORM(opts.DS)
-		pipelineRunner = pipeline.NewRunner(pipelineORM, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), legacyEVMChains, keyStore.Eth(), keyStore.VRF(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
+		pipelineRunner = pipeline.NewRunner(pipelineORM, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), legacyEVMChains, keyStore.Eth(), keyStore.VRF(), keyStore.CSA(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
 		jobORM         = job.NewORM(opts.DS, pipelineORM, bridgeORM, keyStore, globalLogger)
 		txmORM         = txmgr.NewTxStore(opts.DS, globalLogger)
 		streamRegistry = streams.NewRegistry(globalLogger, pipelineRunner)
-		workflowORM    = workflowstore.NewInMemoryStore(globalLogger, clockwork.NewRealClock())
+		workflowORM    = creServices.workflowStore
 	)
-	srvcs = append(srvcs, workflowORM)
+	if cfg.JobPipeline().ArchiveEnabled() {
+		pipelineORM.SetArchiver(pipeline.NewRunArchiver(cfg.JobPipeline().ArchiveDir()))
+	}
 
 	promReporter := headreporter.NewLegacyEVMPrometheusReporter(opts.DS, legacyEVMChains)
 	evmChainIDs := make([]*big.Int, legacyEVMChains.Len())
@@ -526,6 +544,7 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 				globalLogger),
 			job.Cron: cron.NewDelegate(
 				pipelineRunner,
+				jobORM,
 				globalLogger),
 			job.BlockhashStore: blockhashstore.NewDelegate(
 				cfg,
@@ -682,6 +701,7 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		lbs = append(lbs, c.LogBroadcaster())
 	}
 	jobSpawner := job.NewSpawner(jobORM, cfg.Database(), healthChecker, delegates, globalLogger, lbs)
+	jobSpawner.OnJobStopped(pipelineRunner.ForgetJob)
 	srvcs = append(srvcs, jobSpawner, pipelineRunner)
 
 	// We start the log poller after the job spawner
@@ -716,6 +736,14 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		feedsService = &feeds.NullService{}
 	}
 
+	workflowsService := workflowsadmin.NewService(
+		artifacts.NewWorkflowRegistryDS(opts.DS, globalLogger),
+		creServices.workflowStore,
+		opts.CapabilitiesRegistry,
+		creServices.engineRegistry,
+		creServices.engineController,
+	)
+
 	for _, s := range srvcs {
 		if s == nil {
 			panic("service unexpectedly nil")
@@ -736,6 +764,7 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		authenticationProvider:   authenticationProvider,
 		txmStorageService:        txmORM,
 		FeedsService:             feedsService,
+		workflowsService:         workflowsService,
 		Config:                   cfg,
 		webhookJobRunner:         webhookJobRunner,
 		KeyStore:                 keyStore,
@@ -798,6 +827,16 @@ type CREServices struct {
 	// gatewayConnectorWrapper is the wrapper for the gateway connector
 	// it is exposed because there are contingent services in the application
 	gatewayConnectorWrapper *gatewayconnector.ServiceWrapper
+
+	// workflowStore is the durable store for workflow executions
+	// it is shared by the workflow job delegate and the workflow registry syncer
+	workflowStore *workflowstore.DBStore
+
+	// engineRegistry and engineController expose the engines of the workflow registry syncer to operators
+	// they are nil unless the workflow registry syncer is enabled
+	engineRegistry   *syncer.EngineRegistry
+	engineController syncer.EngineController
+
 	// srvs are all the services that are created, including those that are explicitly exposed
 	srvs []services.ServiceCtx
 }
@@ -814,6 +853,12 @@ func newCREServices(
 	billingClient workflows.BillingClient,
 ) (*CREServices, error) {
 	var srvcs []services.ServiceCtx
+	workflowStore := workflowstore.NewDBStore(ds, globalLogger, clockwork.NewRealClock())
+	srvcs = append(srvcs, workflowStore)
+
+	var engineRegistry *syncer.EngineRegistry
+	var engineController syncer.EngineController
+
 	workflowRateLimiter, err := ratelimiter.NewRateLimiter(ratelimiter.Config{
 		GlobalRPS:      capCfg.RateLimit().GlobalRPS(),
 		GlobalBurst:    capCfg.RateLimit().GlobalBurst(),
@@ -938,11 +983,11 @@ func newCREServices(
 						},
 					))
 
-				engineRegistry := syncer.NewEngineRegistry()
+				engineRegistry = syncer.NewEngineRegistry()
 
 				eventHandler, err := syncer.NewEventHandler(
 					lggr,
-					workflowstore.NewInMemoryStore(lggr, clockwork.NewRealClock()),
+					workflowStore,
 					opts.CapabilitiesRegistry,
 					engineRegistry,
 					custmsg.NewLabeler(),
@@ -954,6 +999,7 @@ func newCREServices(
 				if err != nil {
 					return nil, fmt.Errorf("unable to create workflow registry event handler: %w", err)
 				}
+				engineController = eventHandler
 
 				globalLogger.Debugw("Creating WorkflowRegistrySyncer")
 				wfRegRid := capCfg.WorkflowRegistry().RelayID()
@@ -990,6 +1036,9 @@ func newCREServices(
 		workflowRateLimiter:     workflowRateLimiter,
 		workflowLimits:          workflowLimits,
 		gatewayConnectorWrapper: gatewayConnectorWrapper,
+		workflowStore:           workflowStore,
+		engineRegistry:          engineRegistry,
+		engineController:        engineController,
 		srvs:                    srvcs,
 	}, nil
 }
@@ -1150,6 +1199,10 @@ func (app *ChainlinkApplication) BridgeORM() bridges.ORM {
 	return app.bridgeORM
 }
 
+func (app *ChainlinkApplication) BridgeHealth() *bridges.HealthMonitor {
+	return app.pipelineRunner.BridgeHealth()
+}
+
 func (app *ChainlinkApplication) BasicAdminUsersORM() sessions.BasicAdminUsersORM {
 	return app.localAdminUsersORM
 }
@@ -1271,6 +1324,21 @@ func (app *ChainlinkApplication) RunJobV2(
 	return runID, err
 }
 
+// SimulatePipelineRun executes the pipeline without persisting anything or executing tasks with side effects.
+func (app *ChainlinkApplication) SimulatePipelineRun(
+	ctx context.Context,
+	spec pipeline.Spec,
+	vars pipeline.Vars,
+	stubs map[string]pipeline.TaskStub,
+) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	return app.pipelineRunner.SimulateRun(ctx, spec, vars, stubs)
+}
+
+// SubscribePipelineRunEvents streams the lifecycle events of the runs of a job, or of all jobs if jobID is 0.
+func (app *ChainlinkApplication) SubscribePipelineRunEvents(jobID int32) (<-chan pipeline.RunEvent, func()) {
+	return app.pipelineRunner.SubscribeRunEvents(jobID)
+}
+
 func (app *ChainlinkApplication) ResumeJobV2(
 	ctx context.Context,
 	taskID uuid.UUID,
@@ -1283,6 +1351,10 @@ func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
 
+func (app *ChainlinkApplication) GetWorkflowsService() workflowsadmin.Service {
+	return app.workflowsService
+}
+
 // ReplayFromBlock implements the Application interface.
 func (app *ChainlinkApplication) ReplayFromBlock(ctx context.Context, chainFamily string, chainID string, number uint64, forceBroadcast bool) error {
 	switch chainFamily {
@@ -29,6 +29,14 @@ func (b *backupConfig) OnVersionUpgrade() bool {
 	return *b.c.OnVersionUpgrade
 }
 
+func (b *backupConfig) Retention() uint32 {
+	return *b.c.Retention
+}
+
+func (b *backupConfig) Encrypt() bool {
+	return *b.c.Encrypt
+}
+
 func (b *backupConfig) URL() *url.URL {
 	return b.s.BackupURL.URL()
 }
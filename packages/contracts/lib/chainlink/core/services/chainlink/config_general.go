@@ -562,4 +562,8 @@ func (g *generalConfig) Billing() coreconfig.Billing {
 	return &billingConfig{t: g.c.Billing}
 }
 
+func (g *generalConfig) RemoteSigner() coreconfig.RemoteSigner {
+	return &remoteSignerConfig{t: g.c.RemoteSigner}
+}
+
 var zeroSha256Hash = models.Sha256Hash{}
@@ -49,3 +49,11 @@ func (j *jobPipelineConfig) ExternalInitiatorsEnabled() bool {
 func (j *jobPipelineConfig) VerboseLogging() bool {
 	return *j.c.VerboseLogging
 }
+
+func (j *jobPipelineConfig) ArchiveEnabled() bool {
+	return *j.c.Archive.Enabled
+}
+
+func (j *jobPipelineConfig) ArchiveDir() string {
+	return *j.c.Archive.Dir
+}
@@ -30,4 +30,6 @@ func TestJobPipelineConfigTest(t *testing.T) {
 	assert.Equal(t, 168*time.Hour, jp.ReaperThreshold())
 	assert.Equal(t, uint64(10), jp.ResultWriteQueueDepth())
 	assert.True(t, jp.ExternalInitiatorsEnabled())
+	assert.True(t, jp.ArchiveEnabled())
+	assert.Equal(t, "pipeline/archive", jp.ArchiveDir())
 }
@@ -0,0 +1,52 @@
+package chainlink
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+var _ config.RemoteSigner = (*remoteSignerConfig)(nil)
+
+type remoteSignerConfig struct {
+	t toml.RemoteSigner
+}
+
+func (r *remoteSignerConfig) Enabled() bool {
+	return *r.t.Enabled
+}
+
+func (r *remoteSignerConfig) URL() *url.URL {
+	return r.t.URL.URL()
+}
+
+func (r *remoteSignerConfig) Timeout() time.Duration {
+	return r.t.Timeout.Duration()
+}
+
+func (r *remoteSignerConfig) AllowInsecureLoopback() bool {
+	return r.t.AllowInsecureLoopback != nil && *r.t.AllowInsecureLoopback
+}
+
+func (r *remoteSignerConfig) TLSCertPath() string {
+	if r.t.TLS.CertPath == nil {
+		return ""
+	}
+	return *r.t.TLS.CertPath
+}
+
+func (r *remoteSignerConfig) TLSKeyPath() string {
+	if r.t.TLS.KeyPath == nil {
+		return ""
+	}
+	return *r.t.TLS.KeyPath
+}
+
+func (r *remoteSignerConfig) TLSCACertPath() string {
+	if r.t.TLS.CACertPath == nil {
+		return ""
+	}
+	return *r.t.TLS.CACertPath
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 var _ config.TelemetryIngress = (*telemetryIngressConfig)(nil)
@@ -74,3 +75,70 @@ func (t *telemetryIngressEndpointConfig) URL() *url.URL {
 func (t *telemetryIngressEndpointConfig) ServerPubKey() string {
 	return *t.c.ServerPubKey
 }
+
+func (t *telemetryIngressEndpointConfig) Local() config.TelemetryLocalSink {
+	if t.c.Sink == nil || *t.c.Sink != toml.TelemetrySinkLocal {
+		return nil
+	}
+	return &telemetryLocalSinkConfig{c: t.c}
+}
+
+var _ config.TelemetryLocalSink = (*telemetryLocalSinkConfig)(nil)
+
+type telemetryLocalSinkConfig struct {
+	c toml.TelemetryIngressEndpoint
+}
+
+const (
+	defaultTelemetryLocalMaxFileSize = 100 * utils.MB
+	defaultTelemetryLocalMaxFiles    = 10
+)
+
+func (t *telemetryLocalSinkConfig) Dir() string {
+	if t.c.Dir == nil {
+		return ""
+	}
+	return *t.c.Dir
+}
+
+func (t *telemetryLocalSinkConfig) MaxFileSize() utils.FileSize {
+	if t.c.MaxFileSize == nil {
+		return defaultTelemetryLocalMaxFileSize
+	}
+	return *t.c.MaxFileSize
+}
+
+func (t *telemetryLocalSinkConfig) MaxFiles() int64 {
+	if t.c.MaxFiles == nil {
+		return defaultTelemetryLocalMaxFiles
+	}
+	return *t.c.MaxFiles
+}
+
+func (t *telemetryLocalSinkConfig) ListenAddr() string {
+	if t.c.ListenAddr == nil {
+		return ""
+	}
+	return *t.c.ListenAddr
+}
+
+func (t *telemetryLocalSinkConfig) TLSCertPath() string {
+	if t.c.TLSCertPath == nil {
+		return ""
+	}
+	return *t.c.TLSCertPath
+}
+
+func (t *telemetryLocalSinkConfig) TLSKeyPath() string {
+	if t.c.TLSKeyPath == nil {
+		return ""
+	}
+	return *t.c.TLSKeyPath
+}
+
+func (t *telemetryLocalSinkConfig) TLSClientCACertPath() string {
+	if t.c.TLSClientCACertPath == nil {
+		return ""
+	}
+	return *t.c.TLSClientCACertPath
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func TestTelemetryIngressConfig(t *testing.T) {
@@ -26,9 +28,22 @@ func TestTelemetryIngressConfig(t *testing.T) {
 
 	tec := cfg.TelemetryIngress().Endpoints()
 
-	assert.Len(t, tec, 1)
+	assert.Len(t, tec, 2)
 	assert.Equal(t, "EVM", tec[0].Network())
 	assert.Equal(t, "1", tec[0].ChainID())
 	assert.Equal(t, "prom.test", tec[0].URL().String())
 	assert.Equal(t, "test-pub-key", tec[0].ServerPubKey())
+	assert.Nil(t, tec[0].Local())
+
+	assert.Equal(t, "EVM", tec[1].Network())
+	assert.Equal(t, "2", tec[1].ChainID())
+	local := tec[1].Local()
+	require.NotNil(t, local)
+	assert.Equal(t, "telemetry", local.Dir())
+	assert.Equal(t, 100*utils.MB, int(local.MaxFileSize()))
+	assert.Equal(t, int64(5), local.MaxFiles())
+	assert.Equal(t, "127.0.0.1:6699", local.ListenAddr())
+	assert.Equal(t, "telemetry.crt", local.TLSCertPath())
+	assert.Equal(t, "telemetry.key", local.TLSKeyPath())
+	assert.Equal(t, "telemetry-clients-ca.crt", local.TLSClientCACertPath())
 }
@@ -308,6 +308,8 @@ func TestConfig_Marshal(t *testing.T) {
 			Frequency:        &hour,
 			Mode:             &config.DatabaseBackupModeFull,
 			OnVersionUpgrade: ptr(true),
+			Retention:        ptr[uint32](5),
+			Encrypt:          ptr(true),
 		},
 	}
 	full.TelemetryIngress = toml.TelemetryIngress{
@@ -322,8 +324,19 @@ func TestConfig_Marshal(t *testing.T) {
 			Network:      ptr("EVM"),
 			ChainID:      ptr("1"),
 			ServerPubKey: ptr("test-pub-key"),
-			URL:          mustURL("prom.test")},
-		},
+			URL:          mustURL("prom.test"),
+		}, {
+			Network:             ptr("EVM"),
+			ChainID:             ptr("2"),
+			Sink:                ptr(toml.TelemetrySinkLocal),
+			Dir:                 ptr("telemetry"),
+			MaxFileSize:         ptr[utils.FileSize](100 * utils.MB),
+			MaxFiles:            ptr[int64](5),
+			ListenAddr:          ptr("127.0.0.1:6699"),
+			TLSCertPath:         ptr("telemetry.crt"),
+			TLSKeyPath:          ptr("telemetry.key"),
+			TLSClientCACertPath: ptr("telemetry-clients-ca.crt"),
+		}},
 	}
 
 	full.Log = toml.Log{
@@ -387,6 +400,16 @@ func TestConfig_Marshal(t *testing.T) {
 			ForceRedirect: ptr(true),
 			ListenIP:      mustIP("192.158.1.38"),
 		},
+		BridgeHealth: toml.WebServerBridgeHealth{
+			ProbeInterval:    commoncfg.MustNewDuration(30 * time.Second),
+			ProbeTimeout:     commoncfg.MustNewDuration(3 * time.Second),
+			FailureThreshold: ptr[uint32](5),
+			OpenTimeout:      commoncfg.MustNewDuration(time.Minute),
+		},
+		BridgeClientTLS: toml.WebServerBridgeClientTLS{
+			CertPath: ptr("tls/bridge/cert/path"),
+			KeyPath:  ptr("tls/bridge/key/path"),
+		},
 	}
 	full.JobPipeline = toml.JobPipeline{
 		ExternalInitiatorsEnabled: ptr(true),
@@ -400,6 +423,10 @@ func TestConfig_Marshal(t *testing.T) {
 			MaxSize:        ptr[utils.FileSize](100 * utils.MB),
 			DefaultTimeout: commoncfg.MustNewDuration(time.Minute),
 		},
+		Archive: toml.JobPipelineArchive{
+			Enabled: ptr(true),
+			Dir:     ptr("pipeline/archive"),
+		},
 	}
 	full.FluxMonitor = toml.FluxMonitor{
 		DefaultTransactionQueueDepth: ptr[uint32](100),
@@ -578,6 +605,18 @@ func TestConfig_Marshal(t *testing.T) {
 	full.Billing = toml.Billing{
 		URL: ptr("localhost:4319"),
 	}
+	full.RemoteSigner = toml.RemoteSigner{
+		Enabled: ptr(true),
+		URL:     mustURL("https://signer.example:8443"),
+		Timeout: commoncfg.MustNewDuration(3 * time.Second),
+
+		AllowInsecureLoopback: ptr(false),
+		TLS: toml.RemoteSignerTLS{
+			CertPath:   ptr("/etc/chainlink/signer-client.crt"),
+			KeyPath:    ptr("/etc/chainlink/signer-client.key"),
+			CACertPath: ptr("/etc/chainlink/signer-ca.crt"),
+		},
+	}
 	full.EVM = []*evmcfg.EVMConfig{
 		{
 			ChainID: ubig.NewI(1),
@@ -887,6 +926,8 @@ Dir = 'test/backup/dir'
 Frequency = '1h0m0s'
 Mode = 'full'
 OnVersionUpgrade = true
+Retention = 5
+Encrypt = true
 
 [Database.Listener]
 MaxReconnectDuration = '1m0s'
@@ -912,6 +953,18 @@ Network = 'EVM'
 ChainID = '1'
 URL = 'prom.test'
 ServerPubKey = 'test-pub-key'
+
+[[TelemetryIngress.Endpoints]]
+Network = 'EVM'
+ChainID = '2'
+Sink = 'local'
+Dir = 'telemetry'
+MaxFileSize = '100.00mb'
+MaxFiles = 5
+ListenAddr = '127.0.0.1:6699'
+TLSCertPath = 'telemetry.crt'
+TLSKeyPath = 'telemetry.key'
+TLSClientCACertPath = 'telemetry-clients-ca.crt'
 `},
 
 		{"Log", Config{Core: toml.Core{Log: full.Log}}, `[Log]
@@ -975,6 +1028,16 @@ Host = 'tls-host'
 HTTPSPort = 6789
 KeyPath = 'tls/key/path'
 ListenIP = '192.158.1.38'
+
+[WebServer.BridgeHealth]
+ProbeInterval = '30s'
+ProbeTimeout = '3s'
+FailureThreshold = 5
+OpenTimeout = '1m0s'
+
+[WebServer.BridgeClientTLS]
+CertPath = 'tls/bridge/cert/path'
+KeyPath = 'tls/bridge/key/path'
 `},
 		{"FluxMonitor", Config{Core: toml.Core{FluxMonitor: full.FluxMonitor}}, `[FluxMonitor]
 DefaultTransactionQueueDepth = 100
@@ -992,6 +1055,10 @@ VerboseLogging = false
 [JobPipeline.HTTPRequest]
 DefaultTimeout = '1m0s'
 MaxSize = '100.00mb'
+
+[JobPipeline.Archive]
+Enabled = true
+Dir = 'pipeline/archive'
 `},
 		{"OCR", Config{Core: toml.Core{OCR: full.OCR}}, `[OCR]
 Enabled = true
@@ -96,6 +96,38 @@ func (m *mfaConfig) RPOrigin() string {
 	return *m.c.RPOrigin
 }
 
+type bridgeHealthConfig struct {
+	c toml.WebServerBridgeHealth
+}
+
+func (b *bridgeHealthConfig) ProbeInterval() time.Duration {
+	return b.c.ProbeInterval.Duration()
+}
+
+func (b *bridgeHealthConfig) ProbeTimeout() time.Duration {
+	return b.c.ProbeTimeout.Duration()
+}
+
+func (b *bridgeHealthConfig) FailureThreshold() uint32 {
+	return *b.c.FailureThreshold
+}
+
+func (b *bridgeHealthConfig) OpenTimeout() time.Duration {
+	return b.c.OpenTimeout.Duration()
+}
+
+type bridgeClientTLSConfig struct {
+	c toml.WebServerBridgeClientTLS
+}
+
+func (b *bridgeClientTLSConfig) CertPath() string {
+	return *b.c.CertPath
+}
+
+func (b *bridgeClientTLSConfig) KeyPath() string {
+	return *b.c.KeyPath
+}
+
 type webServerConfig struct {
 	c       toml.WebServer
 	s       toml.WebServerSecrets
@@ -118,6 +150,14 @@ func (w *webServerConfig) LDAP() config.LDAP {
 	return &ldapConfig{c: w.c.LDAP, s: w.s.LDAP}
 }
 
+func (w *webServerConfig) BridgeHealth() config.BridgeHealth {
+	return &bridgeHealthConfig{c: w.c.BridgeHealth}
+}
+
+func (w *webServerConfig) BridgeClientTLS() config.BridgeClientTLS {
+	return &bridgeClientTLSConfig{c: w.c.BridgeClientTLS}
+}
+
 func (w *webServerConfig) AuthenticationMethod() string {
 	return *w.c.AuthenticationMethod
 }
@@ -45,4 +45,14 @@ func TestWebServerConfig(t *testing.T) {
 	mf := ws.MFA()
 	assert.Equal(t, "test-rpid", mf.RPID())
 	assert.Equal(t, "test-rp-origin", mf.RPOrigin())
+
+	bh := ws.BridgeHealth()
+	assert.Equal(t, 30*time.Second, bh.ProbeInterval())
+	assert.Equal(t, 3*time.Second, bh.ProbeTimeout())
+	assert.Equal(t, uint32(5), bh.FailureThreshold())
+	assert.Equal(t, time.Minute, bh.OpenTimeout())
+
+	bt := ws.BridgeClientTLS()
+	assert.Equal(t, "tls/bridge/cert/path", bt.CertPath())
+	assert.Equal(t, "tls/bridge/key/path", bt.KeyPath())
 }
@@ -3,7 +3,11 @@ package cron
 import (
 	"context"
 	"fmt"
+	"math/rand/v2"
+	"sync"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/robfig/cron/v3"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
@@ -13,19 +17,33 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
 
+// MaxCatchUpRuns bounds the number of missed ticks a job catches up on, so that a frequent schedule cannot flood the
+// pipeline runner after a long downtime. The latest ticks are kept.
+const MaxCatchUpRuns = 100
+
 // Cron runs a cron jobSpec from a CronSpec
 type Cron struct {
 	cronRunner     *cron.Cron
+	schedule       cron.Schedule
+	entryID        cron.EntryID
 	logger         logger.Logger
 	jobSpec        job.Job
 	pipelineRunner pipeline.Runner
+	jobORM         job.ORM
 	chStop         services.StopChan
+	wgCatchUp      sync.WaitGroup
+
+	mu     sync.Mutex
+	runSeq uint64
+	// runs holds the cancel functions of the executing runs
+	runs map[uint64]context.CancelFunc
 }
 
 // NewCronFromJobSpec instantiates a job that executes on a predefined schedule.
 func NewCronFromJobSpec(
 	jobSpec job.Job,
 	pipelineRunner pipeline.Runner,
+	jobORM job.ORM,
 	logger logger.Logger,
 ) (*Cron, error) {
 	cronLogger := logger.Named("Cron").With(
@@ -36,12 +54,20 @@ func NewCronFromJobSpec(
 		cronLogger = logger.With("evmChainID", id)
 	}
 
+	schedule, err := cronParser().Parse(jobSpec.CronSpec.CronSchedule)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid schedule for cron job %d", jobSpec.ID)
+	}
+
 	return &Cron{
 		cronRunner:     cronRunner(),
+		schedule:       schedule,
 		logger:         cronLogger,
 		jobSpec:        jobSpec,
 		pipelineRunner: pipelineRunner,
+		jobORM:         jobORM,
 		chStop:         make(chan struct{}),
+		runs:           map[uint64]context.CancelFunc{},
 	}, nil
 }
 
@@ -49,27 +75,162 @@ func NewCronFromJobSpec(
 func (cr *Cron) Start(context.Context) error {
 	cr.logger.Debug("Starting")
 
-	_, err := cr.cronRunner.AddFunc(cr.jobSpec.CronSpec.CronSchedule, cr.runPipeline)
-	if err != nil {
-		cr.logger.Errorw(fmt.Sprintf("Error running cron job %d", cr.jobSpec.ID), "err", err)
-		return err
-	}
+	missed := cr.missedTicks(time.Now())
+
+	cr.entryID = cr.cronRunner.Schedule(cr.schedule, cron.FuncJob(cr.runScheduled))
 	cr.cronRunner.Start()
+
+	if len(missed) > 0 {
+		cr.logger.Infow("Catching up on missed ticks", "policy", cr.jobSpec.CronSpec.CatchUpPolicy, "ticks", len(missed), "lastFiredAt", cr.jobSpec.CronSpec.LastFiredAt.Time)
+		cr.wgCatchUp.Add(1)
+		go func() {
+			defer cr.wgCatchUp.Done()
+			for _, tick := range missed {
+				select {
+				case <-cr.chStop:
+					return
+				default:
+				}
+				cr.fire(tick, true)
+			}
+		}()
+	}
 	return nil
 }
 
 // Close implements the job.Service interface. It stops this job from
-// running and cleans up resources.
+// running, cancels the executing runs and cleans up resources.
 func (cr *Cron) Close() error {
 	cr.logger.Debug("Closing")
-	cr.cronRunner.Stop()
+	close(cr.chStop)
+	<-cr.cronRunner.Stop().Done()
+	cr.wgCatchUp.Wait()
 	return nil
 }
 
-func (cr *Cron) runPipeline() {
+// missedTicks returns the ticks of the schedule between the last fired tick and now, or the time the job was paused
+// if it is being resumed, according to the catch-up policy of the job.
+func (cr *Cron) missedTicks(now time.Time) []time.Time {
+	spec := cr.jobSpec.CronSpec
+	cr.mu.Lock()
+	lastFiredAt := spec.LastFiredAt
+	cr.mu.Unlock()
+
+	switch spec.CatchUpPolicy {
+	case job.CronCatchUpRunOnce, job.CronCatchUpRunAllMissed:
+	default:
+		return nil
+	}
+	if !lastFiredAt.Valid {
+		// The job never fired, there is nothing to catch up on
+		return nil
+	}
+
+	from := lastFiredAt.Time
+	if window := spec.CatchUpWindow.Duration(); window > 0 && from.Before(now.Add(-window)) {
+		from = now.Add(-window)
+	}
+	until := now
+	if pausedAt := cr.jobSpec.PausedAt; pausedAt.Valid && pausedAt.Time.Before(now) {
+		// The job is being resumed, the ticks missed while it was paused are skipped
+		until = pausedAt.Time
+	}
+
+	var ticks []time.Time
+	for tick := cr.schedule.Next(from); !tick.IsZero() && !tick.After(until); tick = cr.schedule.Next(tick) {
+		ticks = append(ticks, tick)
+		if len(ticks) > MaxCatchUpRuns {
+			ticks = ticks[1:]
+		}
+	}
+	if spec.CatchUpPolicy == job.CronCatchUpRunOnce && len(ticks) > 1 {
+		ticks = ticks[len(ticks)-1:]
+	}
+	return ticks
+}
+
+// runScheduled is called by the cron runner on each tick of the schedule.
+func (cr *Cron) runScheduled() {
+	// The runner sets Prev to the tick before running the job
+	tick := cr.cronRunner.Entry(cr.entryID).Prev
+	if tick.IsZero() {
+		tick = time.Now()
+	}
+	cr.fire(tick, false)
+}
+
+// fire records tick as fired, and runs the pipeline after a random jitter according to the concurrency policy of
+// the job.
+func (cr *Cron) fire(tick time.Time, catchUp bool) {
 	ctx, cancel := cr.chStop.NewCtx()
 	defer cancel()
 
+	cr.recordFired(ctx, tick)
+
+	if maxJitter := cr.jobSpec.CronSpec.Jitter.Duration(); maxJitter > 0 {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(rand.N(maxJitter)):
+		}
+	}
+
+	runCtx, done, ok := cr.startRun(ctx, tick)
+	if !ok {
+		return
+	}
+	defer done()
+
+	cr.runPipeline(runCtx, tick, catchUp)
+}
+
+func (cr *Cron) recordFired(ctx context.Context, tick time.Time) {
+	spec := cr.jobSpec.CronSpec
+	cr.mu.Lock()
+	if !spec.LastFiredAt.Valid || tick.After(spec.LastFiredAt.Time) {
+		spec.LastFiredAt.SetValid(tick)
+	}
+	cr.mu.Unlock()
+
+	if err := cr.jobORM.UpdateCronSpecLastFiredAt(ctx, spec.ID, tick); err != nil {
+		cr.logger.Errorw("Failed to record fired tick", "tick", tick, "err", err)
+	}
+}
+
+// startRun applies the concurrency policy of the job. It returns the context of the new run and a function to call
+// once it finished, or false if the run must be skipped.
+func (cr *Cron) startRun(ctx context.Context, tick time.Time) (context.Context, func(), bool) {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+
+	switch cr.jobSpec.CronSpec.ConcurrencyPolicy {
+	case job.CronConcurrencyForbid:
+		if len(cr.runs) > 0 {
+			cr.logger.Warnw("Skipping tick, the previous run is still executing", "tick", tick)
+			return nil, nil, false
+		}
+	case job.CronConcurrencyReplace:
+		if len(cr.runs) > 0 {
+			cr.logger.Warnw("Cancelling the previous run, which is still executing", "tick", tick)
+		}
+		for _, cancel := range cr.runs {
+			cancel()
+		}
+	}
+
+	cr.runSeq++
+	id := cr.runSeq
+	runCtx, cancel := context.WithCancel(ctx)
+	cr.runs[id] = cancel
+	return runCtx, func() {
+		cancel()
+		cr.mu.Lock()
+		delete(cr.runs, id)
+		cr.mu.Unlock()
+	}, true
+}
+
+func (cr *Cron) runPipeline(ctx context.Context, tick time.Time, catchUp bool) {
 	jobSpec := map[string]interface{}{
 		"databaseID":    cr.jobSpec.ID,
 		"externalJobID": cr.jobSpec.ExternalJobID,
@@ -82,7 +243,11 @@ func (cr *Cron) runPipeline() {
 	vars := pipeline.NewVarsFrom(map[string]interface{}{
 		"jobSpec": jobSpec,
 		"jobRun": map[string]interface{}{
-			"meta": map[string]interface{}{},
+			"meta": map[string]interface{}{
+				"scheduledAt":     tick.UTC().Format(time.RFC3339),
+				"scheduledAtUnix": tick.Unix(),
+				"catchUp":         catchUp,
+			},
 		},
 	})
 
@@ -90,10 +255,15 @@ func (cr *Cron) runPipeline() {
 
 	_, err := cr.pipelineRunner.Run(ctx, run, false, nil)
 	if err != nil {
-		cr.logger.Errorf("Error executing new run for jobSpec ID %v", cr.jobSpec.ID)
+		cr.logger.Errorw(fmt.Sprintf("Error executing new run for jobSpec ID %v", cr.jobSpec.ID), "tick", tick, "err", err)
 	}
 }
 
 func cronRunner() *cron.Cron {
 	return cron.New(cron.WithSeconds())
 }
+
+// cronParser parses schedules like the runner returned by cronRunner.
+func cronParser() cron.Parser {
+	return cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+}
@@ -1,12 +1,17 @@
 package cron_test
 
 import (
+	"context"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
@@ -16,8 +21,10 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/cron"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	jobmocks "github.com/smartcontractkit/chainlink/v2/core/services/job/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	pipelinemocks "github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
 func TestCronV2Pipeline(t *testing.T) {
@@ -38,7 +45,7 @@ func TestCronV2Pipeline(t *testing.T) {
 		PipelineSpec:  &pipeline.Spec{},
 		ExternalJobID: uuid.New(),
 	}
-	delegate := cron.NewDelegate(runner, lggr)
+	delegate := cron.NewDelegate(runner, jobORM, lggr)
 
 	require.NoError(t, jobORM.CreateJob(testutils.Context(t), jb))
 	serviceArray, err := delegate.ServicesForSpec(testutils.Context(t), *jb)
@@ -61,13 +68,15 @@ func TestCronV2Schedule(t *testing.T) {
 		PipelineSpec:  &pipeline.Spec{},
 	}
 	runner := pipelinemocks.NewRunner(t)
+	jobORM := jobmocks.NewORM(t)
+	jobORM.On("UpdateCronSpecLastFiredAt", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 	awaiter := cltest.NewAwaiter()
 	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
 		Run(func(args mock.Arguments) { awaiter.ItHappened() }).
 		Return(false, nil).
 		Once()
 
-	service, err := cron.NewCronFromJobSpec(spec, runner, logger.TestLogger(t))
+	service, err := cron.NewCronFromJobSpec(spec, runner, jobORM, logger.TestLogger(t))
 	require.NoError(t, err)
 	err = service.Start(testutils.Context(t))
 	require.NoError(t, err)
@@ -75,3 +84,201 @@ func TestCronV2Schedule(t *testing.T) {
 
 	awaiter.AwaitOrFail(t)
 }
+
+func runMeta(t *testing.T, run *pipeline.Run) map[string]interface{} {
+	vars, ok := run.Inputs.Val.(map[string]interface{})
+	require.True(t, ok)
+	jobRun, ok := vars["jobRun"].(map[string]interface{})
+	require.True(t, ok)
+	meta, ok := jobRun["meta"].(map[string]interface{})
+	require.True(t, ok)
+	return meta
+}
+
+func TestCron_CatchUp(t *testing.T) {
+	t.Parallel()
+
+	lastFiredAt := time.Now().Add(-3*time.Hour - 30*time.Minute).Truncate(time.Second)
+
+	pausedAt := lastFiredAt.Add(2*time.Hour + 30*time.Minute)
+
+	tests := []struct {
+		name     string
+		policy   job.CronCatchUpPolicy
+		window   time.Duration
+		pausedAt null.Time
+		ticks    []time.Time
+	}{
+		{"skip", job.CronCatchUpSkip, 0, null.Time{}, nil},
+		{"run once", job.CronCatchUpRunOnce, 0, null.Time{}, []time.Time{lastFiredAt.Add(3 * time.Hour)}},
+		{"run all missed", job.CronCatchUpRunAllMissed, 0, null.Time{}, []time.Time{lastFiredAt.Add(time.Hour), lastFiredAt.Add(2 * time.Hour), lastFiredAt.Add(3 * time.Hour)}},
+		{"run all missed within window", job.CronCatchUpRunAllMissed, 90 * time.Minute, null.Time{}, []time.Time{lastFiredAt.Add(3 * time.Hour)}},
+		{"run once before the pause", job.CronCatchUpRunOnce, 0, null.TimeFrom(pausedAt), []time.Time{lastFiredAt.Add(2 * time.Hour)}},
+		{"run all missed before the pause", job.CronCatchUpRunAllMissed, 0, null.TimeFrom(pausedAt), []time.Time{lastFiredAt.Add(time.Hour), lastFiredAt.Add(2 * time.Hour)}},
+		{"run all missed within window before the pause", job.CronCatchUpRunAllMissed, 90 * time.Minute, null.TimeFrom(pausedAt), nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := job.Job{
+				Type:          job.Cron,
+				SchemaVersion: 1,
+				CronSpec: &job.CronSpec{
+					ID:            1,
+					CronSchedule:  "@every 1h",
+					CatchUpPolicy: tc.policy,
+					CatchUpWindow: models.Interval(tc.window),
+					LastFiredAt:   null.TimeFrom(lastFiredAt),
+				},
+				PipelineSpec: &pipeline.Spec{},
+				PausedAt:     tc.pausedAt,
+			}
+
+			var mu sync.Mutex
+			var scheduled []string
+			runner := pipelinemocks.NewRunner(t)
+			jobORM := jobmocks.NewORM(t)
+			for _, tick := range tc.ticks {
+				jobORM.On("UpdateCronSpecLastFiredAt", mock.Anything, int32(1), mock.MatchedBy(tick.Equal)).Return(nil).Once()
+				runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), false, mock.Anything).
+					Run(func(args mock.Arguments) {
+						meta := runMeta(t, args.Get(1).(*pipeline.Run))
+						assert.Equal(t, true, meta["catchUp"])
+						mu.Lock()
+						scheduled = append(scheduled, meta["scheduledAt"].(string))
+						mu.Unlock()
+					}).
+					Return(false, nil).
+					Once()
+			}
+
+			service, err := cron.NewCronFromJobSpec(spec, runner, jobORM, logger.TestLogger(t))
+			require.NoError(t, err)
+			require.NoError(t, service.Start(testutils.Context(t)))
+
+			var expected []string
+			for _, tick := range tc.ticks {
+				expected = append(expected, tick.UTC().Format(time.RFC3339))
+			}
+			require.Eventually(t, func() bool {
+				mu.Lock()
+				defer mu.Unlock()
+				return len(scheduled) == len(expected)
+			}, testutils.WaitTimeout(t), 10*time.Millisecond)
+			require.NoError(t, service.Close())
+
+			assert.Equal(t, expected, scheduled)
+			if len(tc.ticks) > 0 {
+				assert.True(t, tc.ticks[len(tc.ticks)-1].Equal(spec.CronSpec.LastFiredAt.Time))
+			}
+		})
+	}
+}
+
+func TestCron_ConcurrencyPolicy(t *testing.T) {
+	t.Parallel()
+
+	newSpec := func(policy job.CronConcurrencyPolicy) job.Job {
+		return job.Job{
+			Type:          job.Cron,
+			SchemaVersion: 1,
+			CronSpec:      &job.CronSpec{CronSchedule: "@every 1s", ConcurrencyPolicy: policy},
+			PipelineSpec:  &pipeline.Spec{},
+		}
+	}
+
+	t.Run("forbid skips ticks while a run is executing", func(t *testing.T) {
+		t.Parallel()
+
+		var fired atomic.Int32
+		jobORM := jobmocks.NewORM(t)
+		jobORM.On("UpdateCronSpecLastFiredAt", mock.Anything, mock.Anything, mock.Anything).
+			Run(func(mock.Arguments) { fired.Add(1) }).
+			Return(nil)
+		runner := pipelinemocks.NewRunner(t)
+		started := make(chan struct{}, 10)
+		release := make(chan struct{})
+		runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), false, mock.Anything).
+			Run(func(args mock.Arguments) {
+				started <- struct{}{}
+				<-release
+			}).
+			Return(false, nil)
+
+		service, err := cron.NewCronFromJobSpec(newSpec(job.CronConcurrencyForbid), runner, jobORM, logger.TestLogger(t))
+		require.NoError(t, err)
+		require.NoError(t, service.Start(testutils.Context(t)))
+
+		<-started
+		// Let a few more ticks fire while the first run is executing
+		require.Eventually(t, func() bool {
+			return fired.Load() >= 3
+		}, testutils.WaitTimeout(t), 10*time.Millisecond)
+		assert.Empty(t, started)
+
+		close(release)
+		require.NoError(t, service.Close())
+	})
+
+	t.Run("replace cancels the executing run", func(t *testing.T) {
+		t.Parallel()
+
+		jobORM := jobmocks.NewORM(t)
+		jobORM.On("UpdateCronSpecLastFiredAt", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		runner := pipelinemocks.NewRunner(t)
+		cancelled := make(chan struct{})
+		var once sync.Once
+		runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), false, mock.Anything).
+			Run(func(args mock.Arguments) {
+				<-args.Get(0).(context.Context).Done()
+				once.Do(func() { close(cancelled) })
+			}).
+			Return(false, nil)
+
+		service, err := cron.NewCronFromJobSpec(newSpec(job.CronConcurrencyReplace), runner, jobORM, logger.TestLogger(t))
+		require.NoError(t, err)
+		require.NoError(t, service.Start(testutils.Context(t)))
+
+		select {
+		case <-cancelled:
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("the executing run was not cancelled")
+		}
+		require.NoError(t, service.Close())
+	})
+}
+
+func TestCron_Jitter(t *testing.T) {
+	t.Parallel()
+
+	spec := job.Job{
+		Type:          job.Cron,
+		SchemaVersion: 1,
+		CronSpec:      &job.CronSpec{CronSchedule: "@every 1s", Jitter: models.Interval(500 * time.Millisecond)},
+		PipelineSpec:  &pipeline.Spec{},
+	}
+	jobORM := jobmocks.NewORM(t)
+	jobORM.On("UpdateCronSpecLastFiredAt", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	runner := pipelinemocks.NewRunner(t)
+	awaiter := cltest.NewAwaiter()
+	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), false, mock.Anything).
+		Run(func(args mock.Arguments) {
+			meta := runMeta(t, args.Get(1).(*pipeline.Run))
+			scheduledAt, err := time.Parse(time.RFC3339, meta["scheduledAt"].(string))
+			if assert.NoError(t, err) {
+				assert.Equal(t, scheduledAt.Unix(), meta["scheduledAtUnix"])
+				assert.WithinDuration(t, scheduledAt, time.Now(), 2*time.Second)
+			}
+			assert.Equal(t, false, meta["catchUp"])
+			awaiter.ItHappened()
+		}).
+		Return(false, nil).
+		Once()
+
+	service, err := cron.NewCronFromJobSpec(spec, runner, jobORM, logger.TestLogger(t))
+	require.NoError(t, err)
+	require.NoError(t, service.Start(testutils.Context(t)))
+	awaiter.AwaitOrFail(t)
+	require.NoError(t, service.Close())
+}
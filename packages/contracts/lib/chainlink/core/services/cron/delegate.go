@@ -12,14 +12,16 @@ import (
 
 type Delegate struct {
 	pipelineRunner pipeline.Runner
+	jobORM         job.ORM
 	lggr           logger.Logger
 }
 
 var _ job.Delegate = (*Delegate)(nil)
 
-func NewDelegate(pipelineRunner pipeline.Runner, lggr logger.Logger) *Delegate {
+func NewDelegate(pipelineRunner pipeline.Runner, jobORM job.ORM, lggr logger.Logger) *Delegate {
 	return &Delegate{
 		pipelineRunner: pipelineRunner,
+		jobORM:         jobORM,
 		lggr:           lggr,
 	}
 }
@@ -39,7 +41,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) (services
 		return nil, errors.Errorf("services.Delegate expects a *jobSpec.CronSpec to be present, got %v", spec)
 	}
 
-	cron, err := NewCronFromJobSpec(spec, d.pipelineRunner, d.lggr)
+	cron, err := NewCronFromJobSpec(spec, d.pipelineRunner, d.jobORM, d.lggr)
 	if err != nil {
 		return nil, err
 	}
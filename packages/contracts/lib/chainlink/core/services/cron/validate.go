@@ -38,5 +38,31 @@ func ValidatedCronSpec(tomlString string) (job.Job, error) {
 		return jb, errors.Wrapf(err, "while validating cron schedule '%v'", spec.CronSchedule)
 	}
 
+	switch spec.CatchUpPolicy {
+	case "":
+		spec.CatchUpPolicy = job.CronCatchUpSkip
+	case job.CronCatchUpSkip, job.CronCatchUpRunOnce, job.CronCatchUpRunAllMissed:
+	default:
+		return jb, errors.Errorf("invalid catchUpPolicy %q, must be one of %q, %q or %q", spec.CatchUpPolicy, job.CronCatchUpSkip, job.CronCatchUpRunOnce, job.CronCatchUpRunAllMissed)
+	}
+	if spec.CatchUpWindow.Duration() < 0 {
+		return jb, errors.Errorf("catchUpWindow must not be negative, got %s", spec.CatchUpWindow.Duration())
+	}
+	if spec.CatchUpWindow.Duration() > 0 && spec.CatchUpPolicy == job.CronCatchUpSkip {
+		return jb, errors.New("catchUpWindow requires a catchUpPolicy other than \"skip\"")
+	}
+
+	switch spec.ConcurrencyPolicy {
+	case "":
+		spec.ConcurrencyPolicy = job.CronConcurrencyAllow
+	case job.CronConcurrencyAllow, job.CronConcurrencyForbid, job.CronConcurrencyReplace:
+	default:
+		return jb, errors.Errorf("invalid concurrencyPolicy %q, must be one of %q, %q or %q", spec.ConcurrencyPolicy, job.CronConcurrencyAllow, job.CronConcurrencyForbid, job.CronConcurrencyReplace)
+	}
+
+	if spec.Jitter.Duration() < 0 {
+		return jb, errors.Errorf("jitter must not be negative, got %s", spec.Jitter.Duration())
+	}
+
 	return jb, nil
 }
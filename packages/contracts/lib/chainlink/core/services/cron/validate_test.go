@@ -2,6 +2,7 @@ package cron_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/stretchr/testify/assert"
@@ -76,6 +77,95 @@ ds -> ds_parse -> ds_multiply;
 				assert.Contains(t, err.Error(), "invalid cron schedule")
 			},
 		},
+		{
+			name: "catch-up, concurrency and jitter",
+			toml: `
+type              = "cron"
+schemaVersion     = 1
+schedule          = "CRON_TZ=UTC 0 0 * * * *"
+catchUpPolicy     = "runAllMissed"
+catchUpWindow     = "6h"
+concurrencyPolicy = "forbid"
+jitter            = "30s"
+observationSource = """
+ds [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, s.CronSpec)
+				assert.Equal(t, job.CronCatchUpRunAllMissed, s.CronSpec.CatchUpPolicy)
+				assert.Equal(t, 6*time.Hour, s.CronSpec.CatchUpWindow.Duration())
+				assert.Equal(t, job.CronConcurrencyForbid, s.CronSpec.ConcurrencyPolicy)
+				assert.Equal(t, 30*time.Second, s.CronSpec.Jitter.Duration())
+			},
+		},
+		{
+			name: "default policies",
+			toml: `
+type              = "cron"
+schemaVersion     = 1
+schedule          = "CRON_TZ=UTC 0 0 * * * *"
+observationSource = """
+ds [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, s.CronSpec)
+				assert.Equal(t, job.CronCatchUpSkip, s.CronSpec.CatchUpPolicy)
+				assert.Equal(t, job.CronConcurrencyAllow, s.CronSpec.ConcurrencyPolicy)
+				assert.Zero(t, s.CronSpec.Jitter)
+			},
+		},
+		{
+			name: "invalid catch-up policy",
+			toml: `
+type              = "cron"
+schemaVersion     = 1
+schedule          = "CRON_TZ=UTC 0 0 * * * *"
+catchUpPolicy     = "sometimes"
+observationSource = """
+ds [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "invalid catchUpPolicy")
+			},
+		},
+		{
+			name: "catch-up window without catch-up policy",
+			toml: `
+type              = "cron"
+schemaVersion     = 1
+schedule          = "CRON_TZ=UTC 0 0 * * * *"
+catchUpWindow     = "1h"
+observationSource = """
+ds [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "catchUpWindow requires a catchUpPolicy")
+			},
+		},
+		{
+			name: "invalid concurrency policy",
+			toml: `
+type              = "cron"
+schemaVersion     = 1
+schedule          = "CRON_TZ=UTC 0 0 * * * *"
+concurrencyPolicy = "queue"
+observationSource = """
+ds [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "invalid concurrencyPolicy")
+			},
+		},
 	}
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
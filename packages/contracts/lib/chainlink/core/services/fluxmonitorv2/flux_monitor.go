@@ -768,6 +768,7 @@ func (fm *FluxMonitor) respondToNewRoundLog(ctx context.Context, log flux_aggreg
 		newRoundLogger.Errorf("unable to create job run: %v", err)
 		return
 	}
+	fm.runner.PublishFinishedRuns(ctx, []*pipeline.Run{run})
 }
 
 func (fm *FluxMonitor) Transact(ctx context.Context, fn func(sqlutil.DataSource) error) error {
@@ -998,6 +999,7 @@ func (fm *FluxMonitor) pollIfEligible(ctx context.Context, pollReq PollRequestTy
 		l.Errorw("can't create job run", "err", err)
 		return
 	}
+	fm.runner.PublishFinishedRuns(ctx, []*pipeline.Run{run})
 
 	promfm.SetDecimal(promfm.ReportedValue.WithLabelValues(jobID), answer)
 	promfm.SetUint32(promfm.ReportedRound.WithLabelValues(jobID), roundState.RoundId)
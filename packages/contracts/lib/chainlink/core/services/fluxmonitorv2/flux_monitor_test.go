@@ -125,6 +125,7 @@ func setupMocks(t *testing.T) *testMocks {
 
 	tm.flags.On("ContractExists").Maybe().Return(false)
 	tm.logBroadcast.On("String").Maybe().Return("")
+	tm.pipelineRunner.On("PublishFinishedRuns", mock.Anything, mock.Anything).Maybe()
 
 	return tm
 }
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -56,6 +57,11 @@ type functionsConnectorHandler struct {
 
 const HeartbeatCacheSize = 1000
 
+const (
+	defaultSecretsWatchTimeout = 10 * time.Second
+	maxSecretsWatchTimeout     = 30 * time.Second
+)
+
 var (
 	_ connector.Signer                  = &functionsConnectorHandler{}
 	_ connector.GatewayConnectorHandler = &functionsConnectorHandler{}
@@ -148,6 +154,12 @@ func (h *functionsConnectorHandler) HandleGatewayMessage(ctx context.Context, ga
 			return
 		}
 		h.handleSecretsSet(ctx, gatewayId, body, fromAddr)
+	case functions.MethodSecretsDelete:
+		h.handleSecretsDelete(ctx, gatewayId, body, fromAddr)
+	case functions.MethodSecretsWatch:
+		// watching blocks until a change or a timeout, so it must not hold the connector's read loop
+		h.shutdownWaitGroup.Add(1)
+		go h.handleSecretsWatch(gatewayId, body, fromAddr)
 	case functions.MethodHeartbeat:
 		h.handleHeartbeat(ctx, gatewayId, body, fromAddr)
 	default:
@@ -226,6 +238,133 @@ func (h *functionsConnectorHandler) handleSecretsSet(ctx context.Context, gatewa
 	h.sendResponseAndLog(ctx, gatewayId, body, response)
 }
 
+func (h *functionsConnectorHandler) handleSecretsDelete(ctx context.Context, gatewayId string, body *api.MessageBody, fromAddr ethCommon.Address) {
+	var request functions.SecretsDeleteRequest
+	var response functions.SecretsDeleteResponse
+	err := json.Unmarshal(body.Payload, &request)
+	if err == nil {
+		key := s4.Key{
+			Address: fromAddr,
+			SlotId:  request.SlotID,
+			Version: request.Version,
+		}
+		h.lggr.Debugw("handling a secrets_delete request", "address", fromAddr, "slotId", request.SlotID, "payloadVersion", request.Version, "expiration", request.Expiration)
+		err = h.storage.Delete(ctx, &key, request.Expiration, request.Signature)
+		if err == nil {
+			response.Success = true
+			promStorageUserUpdatesCount.WithLabelValues().Inc()
+		} else {
+			response.ErrorMessage = fmt.Sprintf("Failed to delete secret: %v", err)
+		}
+	} else {
+		response.ErrorMessage = fmt.Sprintf("Bad request to delete secret: %v", err)
+	}
+	h.sendResponseAndLog(ctx, gatewayId, body, response)
+}
+
+// handleSecretsWatch responds as soon as the confirmed secrets of the user differ from the ones in the request,
+// or with no changes when the request times out.
+func (h *functionsConnectorHandler) handleSecretsWatch(gatewayId string, body *api.MessageBody, fromAddr ethCommon.Address) {
+	defer h.shutdownWaitGroup.Done()
+	ctx, cancel := h.chStop.NewCtx()
+	defer cancel()
+
+	var request functions.SecretsWatchRequest
+	var response functions.SecretsWatchResponse
+	if err := json.Unmarshal(body.Payload, &request); err != nil {
+		response.ErrorMessage = fmt.Sprintf("Bad request to watch secrets: %v", err)
+		h.sendResponseAndLog(ctx, gatewayId, body, response)
+		return
+	}
+	timeout := time.Duration(request.TimeoutMillis) * time.Millisecond
+	if timeout == 0 {
+		timeout = defaultSecretsWatchTimeout
+	}
+	timeout = min(timeout, maxSecretsWatchTimeout)
+	h.lggr.Debugw("handling a secrets_watch request", "address", fromAddr, "knownRows", len(request.Rows), "timeout", timeout)
+
+	// subscribe before listing, so that no confirmation is missed in between
+	changes, cancelWatch := h.storage.Watch(fromAddr)
+	defer cancelWatch()
+	snapshot, err := h.storage.List(ctx, fromAddr)
+	if err != nil {
+		response.ErrorMessage = fmt.Sprintf("Failed to list secrets: %v", err)
+		h.sendResponseAndLog(ctx, gatewayId, body, response)
+		return
+	}
+	response.Success = true
+	response.Changes = secretsWatchChanges(request.Rows, snapshot)
+
+	if len(response.Changes) == 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		select {
+		case change, ok := <-changes:
+			if !ok {
+				response.Success = false
+				response.ErrorMessage = "Secrets watch was interrupted, please retry"
+				break
+			}
+			response.Changes = append(response.Changes, newSecretsWatchChange(change))
+			// include the changes which were confirmed together
+			for len(changes) > 0 {
+				if change, ok = <-changes; ok {
+					response.Changes = append(response.Changes, newSecretsWatchChange(change))
+				}
+			}
+		case <-timer.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+	h.sendResponseAndLog(ctx, gatewayId, body, response)
+}
+
+// secretsWatchChanges returns the confirmed rows of snapshot which are not known by the user, and the known slots
+// missing from snapshot as deleted. Unconfirmed rows are reported once they are confirmed.
+func secretsWatchChanges(known []functions.SecretsListRow, snapshot []*s4.SnapshotRow) []functions.SecretsWatchChange {
+	knownVersions := make(map[uint]uint64, len(known))
+	for _, row := range known {
+		knownVersions[row.SlotID] = row.Version
+	}
+	var changes []functions.SecretsWatchChange
+	for _, row := range snapshot {
+		version, ok := knownVersions[row.SlotId]
+		delete(knownVersions, row.SlotId)
+		if !row.Confirmed || (ok && version == row.Version) {
+			continue
+		}
+		changes = append(changes, functions.SecretsWatchChange{
+			SlotID:     row.SlotId,
+			Version:    row.Version,
+			Expiration: row.Expiration,
+		})
+	}
+	for slotID, version := range knownVersions {
+		changes = append(changes, functions.SecretsWatchChange{
+			SlotID:  slotID,
+			Version: version,
+			Deleted: true,
+		})
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].SlotID < changes[j].SlotID
+	})
+	return changes
+}
+
+func newSecretsWatchChange(change s4.RecordChange) functions.SecretsWatchChange {
+	result := functions.SecretsWatchChange{
+		SlotID:  change.Key.SlotId,
+		Version: change.Key.Version,
+		Deleted: change.Record == nil,
+	}
+	if change.Record != nil {
+		result.Expiration = change.Record.Expiration
+	}
+	return result
+}
+
 func (h *functionsConnectorHandler) handleHeartbeat(ctx context.Context, gatewayId string, requestBody *api.MessageBody, fromAddr ethCommon.Address) {
 	var request *OffchainRequest
 	err := json.Unmarshal(requestBody.Payload, &request)
@@ -247,6 +247,131 @@ func TestFunctionsConnectorHandler(t *testing.T) {
 			})
 		})
 
+		t.Run("secrets_delete", func(t *testing.T) {
+			ctx := testutils.Context(t)
+			key := s4.Key{
+				Address: addr,
+				SlotId:  3,
+				Version: 5,
+			}
+			signature, err := s4.NewDeleteEnvelope(&key, 6).Sign(privateKey)
+			require.NoError(t, err)
+			signatureB64 := base64.StdEncoding.EncodeToString(signature)
+
+			msg := api.Message{
+				Body: api.MessageBody{
+					DonId:     "fun4",
+					MessageId: "1",
+					Method:    "secrets_delete",
+					Sender:    addr.Hex(),
+					Payload:   json.RawMessage(`{"slot_id":3,"version":5,"expiration":6,"signature":"` + signatureB64 + `"}`),
+				},
+			}
+			require.NoError(t, msg.Sign(privateKey))
+
+			storage.On("Delete", ctx, &key, int64(6), signature).Return(nil).Once()
+			allowlist.On("Allow", addr).Return(true).Once()
+			connector.On("SendToGateway", ctx, "gw1", mock.Anything).Run(func(args mock.Arguments) {
+				msg, ok := args[2].(*api.Message)
+				require.True(t, ok)
+				require.Equal(t, `{"success":true}`, string(msg.Body.Payload))
+			}).Return(nil).Once()
+
+			handler.HandleGatewayMessage(ctx, "gw1", &msg)
+
+			t.Run("orm error", func(t *testing.T) {
+				storage.On("Delete", ctx, mock.Anything, mock.Anything, mock.Anything).Return(s4.ErrVersionTooLow).Once()
+				allowlist.On("Allow", addr).Return(true).Once()
+				connector.On("SendToGateway", ctx, "gw1", mock.Anything).Run(func(args mock.Arguments) {
+					msg, ok := args[2].(*api.Message)
+					require.True(t, ok)
+					require.JSONEq(t, `{"success":false,"error_message":"Failed to delete secret: version too low"}`, string(msg.Body.Payload))
+				}).Return(nil).Once()
+
+				handler.HandleGatewayMessage(ctx, "gw1", &msg)
+			})
+		})
+
+		t.Run("secrets_watch", func(t *testing.T) {
+			ctx := testutils.Context(t)
+			newWatchMessage := func(payload string) api.Message {
+				msg := api.Message{
+					Body: api.MessageBody{
+						DonId:     "fun4",
+						MessageId: "1",
+						Method:    "secrets_watch",
+						Sender:    addr.Hex(),
+						Payload:   json.RawMessage(payload),
+					},
+				}
+				require.NoError(t, msg.Sign(privateKey))
+				return msg
+			}
+			expectResponse := func(expected string) chan struct{} {
+				sent := make(chan struct{})
+				connector.On("SendToGateway", mock.Anything, "gw1", mock.Anything).Run(func(args mock.Arguments) {
+					// responses are sent from the watching goroutine
+					msg, ok := args[2].(*api.Message)
+					if assert.True(t, ok) {
+						assert.JSONEq(t, expected, string(msg.Body.Payload))
+					}
+					close(sent)
+				}).Return(nil).Once()
+				return sent
+			}
+			snapshot := []*s4.SnapshotRow{
+				{SlotId: 1, Version: 2, Expiration: 100, Confirmed: true},
+				{SlotId: 2, Version: 3, Expiration: 200, Confirmed: false},
+			}
+
+			t.Run("changed rows", func(t *testing.T) {
+				storage.On("Watch", addr).Return(make(<-chan s4.RecordChange), func() {}).Once()
+				storage.On("List", mock.Anything, addr).Return(snapshot, nil).Once()
+				allowlist.On("Allow", addr).Return(true).Once()
+				sent := expectResponse(`{"success":true,"changes":[{"slot_id":1,"version":2,"expiration":100},{"slot_id":5,"version":1,"expiration":0,"deleted":true}]}`)
+
+				msg := newWatchMessage(`{"rows":[{"slot_id":1,"version":1,"expiration":50},{"slot_id":5,"version":1,"expiration":300}]}`)
+				handler.HandleGatewayMessage(ctx, "gw1", &msg)
+				testutils.WaitWithTimeout(t, sent, "secrets_watch response was not sent")
+			})
+
+			t.Run("confirmation", func(t *testing.T) {
+				changes := make(chan s4.RecordChange, 1)
+				storage.On("Watch", addr).Return((<-chan s4.RecordChange)(changes), func() {}).Once()
+				storage.On("List", mock.Anything, addr).Return(snapshot, nil).Once()
+				allowlist.On("Allow", addr).Return(true).Once()
+				sent := expectResponse(`{"success":true,"changes":[{"slot_id":2,"version":3,"expiration":200}]}`)
+
+				msg := newWatchMessage(`{"rows":[{"slot_id":1,"version":2,"expiration":100}]}`)
+				handler.HandleGatewayMessage(ctx, "gw1", &msg)
+				changes <- s4.RecordChange{
+					Key:    s4.Key{Address: addr, SlotId: 2, Version: 3},
+					Record: &s4.Record{Expiration: 200},
+				}
+				testutils.WaitWithTimeout(t, sent, "secrets_watch response was not sent")
+			})
+
+			t.Run("timeout", func(t *testing.T) {
+				storage.On("Watch", addr).Return(make(<-chan s4.RecordChange), func() {}).Once()
+				storage.On("List", mock.Anything, addr).Return(snapshot, nil).Once()
+				allowlist.On("Allow", addr).Return(true).Once()
+				sent := expectResponse(`{"success":true}`)
+
+				msg := newWatchMessage(`{"rows":[{"slot_id":1,"version":2,"expiration":100}],"timeout_millis":10}`)
+				handler.HandleGatewayMessage(ctx, "gw1", &msg)
+				testutils.WaitWithTimeout(t, sent, "secrets_watch response was not sent")
+			})
+
+			t.Run("malformed request", func(t *testing.T) {
+				allowlist.On("Allow", addr).Return(true).Once()
+				sent := expectResponse(`{"success":false,"error_message":"Bad request to watch secrets: unexpected end of JSON input"}`)
+
+				msg := newWatchMessage(`{`)
+				handler.HandleGatewayMessage(ctx, "gw1", &msg)
+				testutils.WaitWithTimeout(t, sent, "secrets_watch response was not sent")
+			})
+		})
+
 		t.Run("unsupported method", func(t *testing.T) {
 			msg := api.Message{
 				Body: api.MessageBody{
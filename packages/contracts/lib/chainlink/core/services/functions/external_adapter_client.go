@@ -3,17 +3,21 @@ package functions
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/multierr"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/hex"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
@@ -40,11 +44,116 @@ type ExternalAdapterClient interface {
 	FetchEncryptedSecrets(ctx context.Context, encryptedSecretsUrls []byte, requestId string, jobName string) (encryptedSecrets, userError []byte, err error)
 }
 
+// ExternalAdapterEndpoint is an adapter URL, named for metrics and errors.
+type ExternalAdapterEndpoint struct {
+	Name string
+	URL  url.URL
+}
+
+type ExternalAdapterClientConfig struct {
+	// Endpoints are tried in order. Every endpoint serves the same adapter.
+	Endpoints              []ExternalAdapterEndpoint
+	MaxResponseBytes       int64
+	MaxRetries             int
+	ExponentialBackoffBase time.Duration
+	// HedgeDelay is the latency after which a request is also sent to the
+	// next endpoint; the first successful response is used. If zero, the next
+	// endpoint is only tried after a failure.
+	HedgeDelay time.Duration
+}
+
 type externalAdapterClient struct {
-	adapterURL             url.URL
-	maxResponseBytes       int64
-	maxRetries             int
-	exponentialBackoffBase time.Duration
+	cfg ExternalAdapterClientConfig
+	// inflight deduplicates identical requests, and may be shared by clients
+	inflight *inflightRequests
+}
+
+// inflightRequests deduplicates identical requests. A shared request runs
+// detached from the context of the caller which sent it, until the longest
+// deadline of its callers, or until none of them is waiting anymore.
+type inflightRequests struct {
+	group singleflight.Group
+	mu    sync.Mutex
+	calls map[string]*inflightCall
+}
+
+type inflightCall struct {
+	waiters int
+	// deadline is the longest deadline of the callers, unless one has none
+	deadline  time.Time
+	unbounded bool
+	timer     *time.Timer
+	cancel    context.CancelFunc
+}
+
+// join registers a caller of the request, which must leave once done waiting
+func (r *inflightRequests) join(ctx context.Context, key string) *inflightCall {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.calls == nil {
+		r.calls = make(map[string]*inflightCall)
+	}
+	c, ok := r.calls[key]
+	if !ok {
+		c = &inflightCall{}
+		r.calls[key] = c
+	}
+	c.waiters++
+	deadline, ok := ctx.Deadline()
+	switch {
+	case !ok:
+		c.unbounded = true
+		if c.timer != nil {
+			c.timer.Stop()
+		}
+	case !c.unbounded && deadline.After(c.deadline):
+		c.deadline = deadline
+		if c.timer != nil {
+			c.timer.Reset(time.Until(deadline))
+		}
+	}
+	return c
+}
+
+func (r *inflightRequests) leave(key string, c *inflightCall) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c.waiters--
+	if c.waiters > 0 {
+		return
+	}
+	if r.calls[key] == c {
+		delete(r.calls, key)
+		// a later identical request is sent again
+		r.group.Forget(key)
+	}
+	if c.cancel != nil {
+		c.cancel()
+	}
+}
+
+// start returns the context of the shared request, detached from parent
+func (r *inflightRequests) start(parent context.Context, c *inflightCall) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.WithoutCancel(parent))
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if c.waiters == 0 {
+		// every caller left before the request was sent
+		cancel()
+		return ctx, cancel
+	}
+	c.cancel = cancel
+	if !c.unbounded && !c.deadline.IsZero() {
+		c.timer = time.AfterFunc(time.Until(c.deadline), cancel)
+	}
+	return ctx, func() {
+		r.mu.Lock()
+		if c.timer != nil {
+			c.timer.Stop()
+		}
+		r.mu.Unlock()
+		cancel()
+	}
 }
 
 var _ ExternalAdapterClient = (*externalAdapterClient)(nil)
@@ -55,10 +164,12 @@ type BridgeAccessor interface {
 
 type bridgeAccessor struct {
 	bridgeORM              bridges.ORM
-	bridgeName             string
+	bridgeNames            []string
 	maxResponseBytes       int64
 	maxRetries             int
 	exponentialBackoffBase time.Duration
+	hedgeDelay             time.Duration
+	inflight               inflightRequests
 }
 
 var _ BridgeAccessor = (*bridgeAccessor)(nil)
@@ -99,6 +210,12 @@ type responseData struct {
 	Domains     []string `json:"domains"`
 }
 
+type adapterResult struct {
+	userResult []byte
+	userError  []byte
+	domains    []string
+}
+
 var (
 	promEAClientLatency = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "functions_external_adapter_client_latency",
@@ -112,15 +229,47 @@ var (
 	},
 		[]string{"name"},
 	)
+	promEAClientEndpointLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "functions_external_adapter_client_endpoint_latency_seconds",
+		Help:    "Functions EA client latency in seconds scoped by endpoint and adapter",
+		Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	},
+		[]string{"name", "adapter"},
+	)
+	promEAClientEndpointErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "functions_external_adapter_client_endpoint_errors_total",
+		Help: "Functions EA client error count scoped by endpoint and adapter",
+	},
+		[]string{"name", "adapter"},
+	)
+	promEAClientHedgedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "functions_external_adapter_client_hedged_requests_total",
+		Help: "Functions EA client count of requests sent to another adapter because the previous ones were slow, scoped by request type",
+	},
+		[]string{"name"},
+	)
+	promEAClientDeduplicatedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "functions_external_adapter_client_deduplicated_requests_total",
+		Help: "Functions EA client count of requests which used the response of an identical in-flight request, scoped by request type",
+	},
+		[]string{"name"},
+	)
 )
 
 func NewExternalAdapterClient(adapterURL url.URL, maxResponseBytes int64, maxRetries int, exponentialBackoffBase time.Duration) ExternalAdapterClient {
-	return &externalAdapterClient{
-		adapterURL:             adapterURL,
-		maxResponseBytes:       maxResponseBytes,
-		maxRetries:             maxRetries,
-		exponentialBackoffBase: exponentialBackoffBase,
-	}
+	return NewMultiEndpointExternalAdapterClient(ExternalAdapterClientConfig{
+		Endpoints:              []ExternalAdapterEndpoint{{Name: adapterURL.Host, URL: adapterURL}},
+		MaxResponseBytes:       maxResponseBytes,
+		MaxRetries:             maxRetries,
+		ExponentialBackoffBase: exponentialBackoffBase,
+	})
+}
+
+// NewMultiEndpointExternalAdapterClient returns a client sending every request
+// to the first endpoint, and to the next ones on failure or after
+// cfg.HedgeDelay. Identical concurrent requests are sent once.
+func NewMultiEndpointExternalAdapterClient(cfg ExternalAdapterClientConfig) ExternalAdapterClient {
+	return &externalAdapterClient{cfg: cfg, inflight: new(inflightRequests)}
 }
 
 func (ea *externalAdapterClient) RunComputation(
@@ -187,9 +336,114 @@ func (ea *externalAdapterClient) request(
 		return nil, nil, nil, errors.Wrap(err, "error constructing external adapter request payload")
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", ea.adapterURL.String(), bytes.NewBuffer(jsonPayload))
+	// requests are identical if they have the same ID and payload. Identical
+	// requests wait for the first one, each bound to the context of its caller.
+	key := fmt.Sprintf("%s/%s/%x", label, requestId, sha256.Sum256(jsonPayload))
+	call := ea.inflight.join(ctx, key)
+	defer ea.inflight.leave(key, call)
+	executed := false
+	ch := ea.inflight.group.DoChan(key, func() (interface{}, error) {
+		executed = true
+		callCtx, cancel := ea.inflight.start(ctx, call)
+		defer cancel()
+		return ea.hedgedRequest(callCtx, jsonPayload, label)
+	})
+	select {
+	case res := <-ch:
+		if !executed {
+			promEAClientDeduplicatedRequests.WithLabelValues(label).Inc()
+		}
+		if res.Err != nil {
+			return nil, nil, nil, res.Err
+		}
+		r := res.Val.(*adapterResult)
+		return r.userResult, r.userError, r.domains, nil
+	case <-ctx.Done():
+		return nil, nil, nil, errors.Wrap(ctx.Err(), "error waiting for identical external adapter request")
+	}
+}
+
+// hedgedRequest sends the request to the endpoints in order, until one succeeds
+func (ea *externalAdapterClient) hedgedRequest(ctx context.Context, jsonPayload []byte, label string) (*adapterResult, error) {
+	if len(ea.cfg.Endpoints) == 1 {
+		return ea.endpointRequest(ctx, ea.cfg.Endpoints[0], jsonPayload, label)
+	}
+	if len(ea.cfg.Endpoints) == 0 {
+		return nil, errors.New("no external adapter endpoints configured")
+	}
+
+	// cancels the pending requests once one succeeds
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type attempt struct {
+		endpoint string
+		result   *adapterResult
+		err      error
+	}
+	attempts := make(chan attempt, len(ea.cfg.Endpoints))
+	var next, pending int
+	var hedgeTimer *time.Timer
+	var hedgeC <-chan time.Time
+	defer func() {
+		if hedgeTimer != nil {
+			hedgeTimer.Stop()
+		}
+	}()
+	send := func() {
+		endpoint := ea.cfg.Endpoints[next]
+		next++
+		pending++
+		go func() {
+			result, err := ea.endpointRequest(ctx, endpoint, jsonPayload, label)
+			attempts <- attempt{endpoint.Name, result, err}
+		}()
+
+		if hedgeTimer != nil {
+			hedgeTimer.Stop()
+		}
+		hedgeC = nil
+		if ea.cfg.HedgeDelay > 0 && next < len(ea.cfg.Endpoints) {
+			hedgeTimer = time.NewTimer(ea.cfg.HedgeDelay)
+			hedgeC = hedgeTimer.C
+		}
+	}
+
+	send()
+	var merr error
+	for pending > 0 {
+		select {
+		case a := <-attempts:
+			pending--
+			if a.err == nil {
+				return a.result, nil
+			}
+			merr = multierr.Append(merr, errors.Wrapf(a.err, "external adapter %s", a.endpoint))
+			if next < len(ea.cfg.Endpoints) {
+				send()
+			}
+		case <-hedgeC:
+			promEAClientHedgedRequests.WithLabelValues(label).Inc()
+			send()
+		}
+	}
+	return nil, merr
+}
+
+func (ea *externalAdapterClient) endpointRequest(ctx context.Context, endpoint ExternalAdapterEndpoint, jsonPayload []byte, label string) (*adapterResult, error) {
+	start := time.Now()
+	result, err := ea.send(ctx, endpoint.URL, jsonPayload, label)
+	promEAClientEndpointLatency.WithLabelValues(label, endpoint.Name).Observe(time.Since(start).Seconds())
+	if err != nil {
+		promEAClientEndpointErrors.WithLabelValues(label, endpoint.Name).Inc()
+	}
+	return result, err
+}
+
+func (ea *externalAdapterClient) send(ctx context.Context, adapterURL url.URL, jsonPayload []byte, label string) (*adapterResult, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", adapterURL.String(), bytes.NewBuffer(jsonPayload))
 	if err != nil {
-		return nil, nil, nil, errors.Wrap(err, "error constructing external adapter request")
+		return nil, errors.Wrap(err, "error constructing external adapter request")
 	}
 	req.Header.Set("Content-Type", "application/json")
 
@@ -197,77 +451,94 @@ func (ea *externalAdapterClient) request(
 
 	// retry will only happen on a 5XX error response code (except 501)
 	retryClient := retryablehttp.NewClient()
-	retryClient.RetryMax = ea.maxRetries
-	retryClient.RetryWaitMin = ea.exponentialBackoffBase
+	retryClient.RetryMax = ea.cfg.MaxRetries
+	retryClient.RetryWaitMin = ea.cfg.ExponentialBackoffBase
 
 	client := retryClient.StandardClient()
 	resp, err := client.Do(req)
 	if err != nil {
 		promEAClientErrors.WithLabelValues(label).Inc()
-		return nil, nil, nil, errors.Wrap(err, "error during external adapter request")
+		return nil, errors.Wrap(err, "error during external adapter request")
 	}
 	defer resp.Body.Close()
 
-	source := http.MaxBytesReader(nil, resp.Body, ea.maxResponseBytes)
+	source := http.MaxBytesReader(nil, resp.Body, ea.cfg.MaxResponseBytes)
 	body, err := io.ReadAll(source)
 	elapsed := time.Since(start)
 	promEAClientLatency.WithLabelValues(label).Set(elapsed.Seconds())
 	if err != nil {
 		promEAClientErrors.WithLabelValues(label).Inc()
-		return nil, nil, nil, errors.Wrap(err, "error reading external adapter response")
+		return nil, errors.Wrap(err, "error reading external adapter response")
 	}
 
 	if resp.StatusCode != http.StatusOK {
 		promEAClientErrors.WithLabelValues(label).Inc()
-		return nil, nil, nil, fmt.Errorf("external adapter responded with HTTP %d, body: %s", resp.StatusCode, body)
+		return nil, fmt.Errorf("external adapter responded with HTTP %d, body: %s", resp.StatusCode, body)
 	}
 
 	var eaResp response
 	err = json.Unmarshal(body, &eaResp)
 	if err != nil {
-		return nil, nil, nil, errors.Wrap(err, fmt.Sprintf("error parsing external adapter response %s", body))
+		return nil, errors.Wrap(err, fmt.Sprintf("error parsing external adapter response %s", body))
 	}
 
 	if eaResp.StatusCode != http.StatusOK {
-		return nil, nil, nil, fmt.Errorf("external adapter invalid StatusCode %d", eaResp.StatusCode)
+		return nil, fmt.Errorf("external adapter invalid StatusCode %d", eaResp.StatusCode)
 	}
 
 	if eaResp.Data == nil {
-		return nil, nil, nil, errors.New("external adapter response data was empty")
+		return nil, errors.New("external adapter response data was empty")
 	}
 
 	switch eaResp.Result {
 	case "error":
-		userError, err = hex.DecodeString(eaResp.Data.Error)
+		userError, err := hex.DecodeString(eaResp.Data.Error)
 		if err != nil {
-			return nil, nil, nil, errors.Wrap(err, "error decoding userError hex string")
+			return nil, errors.Wrap(err, "error decoding userError hex string")
 		}
-		return nil, userError, eaResp.Data.Domains, nil
+		return &adapterResult{userError: userError, domains: eaResp.Data.Domains}, nil
 	case "success":
-		userResult, err = hex.DecodeString(eaResp.Data.Result)
+		userResult, err := hex.DecodeString(eaResp.Data.Result)
 		if err != nil {
-			return nil, nil, nil, errors.Wrap(err, "error decoding result hex string")
+			return nil, errors.Wrap(err, "error decoding result hex string")
 		}
-		return userResult, nil, eaResp.Data.Domains, nil
+		return &adapterResult{userResult: userResult, domains: eaResp.Data.Domains}, nil
 	default:
-		return nil, nil, nil, fmt.Errorf("unexpected result in response: '%+v'", eaResp.Result)
+		return nil, fmt.Errorf("unexpected result in response: '%+v'", eaResp.Result)
 	}
 }
 
-func NewBridgeAccessor(bridgeORM bridges.ORM, bridgeName string, maxResponseBytes int64, maxRetries int, exponentialBackoffBase time.Duration) BridgeAccessor {
+// NewBridgeAccessor returns a BridgeAccessor for the adapter served by the
+// given bridges, in order of preference. The clients it creates share the
+// deduplication of in-flight requests.
+func NewBridgeAccessor(bridgeORM bridges.ORM, bridgeNames []string, maxResponseBytes int64, maxRetries int, exponentialBackoffBase time.Duration, hedgeDelay time.Duration) BridgeAccessor {
 	return &bridgeAccessor{
 		bridgeORM:              bridgeORM,
-		bridgeName:             bridgeName,
+		bridgeNames:            bridgeNames,
 		maxResponseBytes:       maxResponseBytes,
 		maxRetries:             maxRetries,
 		exponentialBackoffBase: exponentialBackoffBase,
+		hedgeDelay:             hedgeDelay,
 	}
 }
 
 func (b *bridgeAccessor) NewExternalAdapterClient(ctx context.Context) (ExternalAdapterClient, error) {
-	bridge, err := b.bridgeORM.FindBridge(ctx, bridges.BridgeName(b.bridgeName))
-	if err != nil {
-		return nil, err
+	endpoints := make([]ExternalAdapterEndpoint, len(b.bridgeNames))
+	for i, name := range b.bridgeNames {
+		bridge, err := b.bridgeORM.FindBridge(ctx, bridges.BridgeName(name))
+		if err != nil {
+			return nil, err
+		}
+		endpoints[i] = ExternalAdapterEndpoint{Name: name, URL: url.URL(bridge.URL)}
 	}
-	return NewExternalAdapterClient(url.URL(bridge.URL), b.maxResponseBytes, b.maxRetries, b.exponentialBackoffBase), nil
+	return &externalAdapterClient{
+		cfg: ExternalAdapterClientConfig{
+			Endpoints:              endpoints,
+			MaxResponseBytes:       b.maxResponseBytes,
+			MaxRetries:             b.maxRetries,
+			ExponentialBackoffBase: b.exponentialBackoffBase,
+			HedgeDelay:             b.hedgeDelay,
+		},
+		inflight: &b.inflight,
+	}, nil
 }
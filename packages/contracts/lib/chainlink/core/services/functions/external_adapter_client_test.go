@@ -8,10 +8,13 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/services/functions"
@@ -299,3 +302,177 @@ const runComputationSuccessResponse = `{
 		},
 		"statusCode": 200
 	}`
+
+func newEndpoint(t *testing.T, name string, handler http.HandlerFunc) functions.ExternalAdapterEndpoint {
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+	adapterUrl, err := url.Parse(ts.URL)
+	require.NoError(t, err)
+	return functions.ExternalAdapterEndpoint{Name: name, URL: *adapterUrl}
+}
+
+func TestRunComputation_MultipleEndpoints(t *testing.T) {
+	success := func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, runComputationSuccessResponse)
+	}
+
+	t.Run("hedges slow requests", func(t *testing.T) {
+		done := make(chan struct{})
+		defer close(done)
+		slow := newEndpoint(t, "slow", func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-done:
+			case <-r.Context().Done():
+			}
+		})
+		fast := newEndpoint(t, "fast", success)
+
+		ea := functions.NewMultiEndpointExternalAdapterClient(functions.ExternalAdapterClientConfig{
+			Endpoints:        []functions.ExternalAdapterEndpoint{slow, fast},
+			MaxResponseBytes: 100_000,
+			HedgeDelay:       10 * time.Millisecond,
+		})
+		userResult, _, domains, err := ea.RunComputation(testutils.Context(t), "requestID1234", "TestJob", "SubOwner", 1, functions.RequestFlags{}, "secRETS", &functions.RequestData{})
+		require.NoError(t, err)
+		assert.Equal(t, "abcdef", string(userResult))
+		assert.Equal(t, []string{"domain1", "domain2"}, domains)
+	})
+
+	t.Run("fails over on errors", func(t *testing.T) {
+		var failing atomic.Int32
+		ea := functions.NewMultiEndpointExternalAdapterClient(functions.ExternalAdapterClientConfig{
+			Endpoints: []functions.ExternalAdapterEndpoint{
+				newEndpoint(t, "failing", func(w http.ResponseWriter, r *http.Request) {
+					failing.Add(1)
+					w.WriteHeader(http.StatusBadRequest)
+				}),
+				newEndpoint(t, "ok", success),
+			},
+			MaxResponseBytes: 100_000,
+		})
+		userResult, _, _, err := ea.RunComputation(testutils.Context(t), "requestID1234", "TestJob", "SubOwner", 1, functions.RequestFlags{}, "secRETS", &functions.RequestData{})
+		require.NoError(t, err)
+		assert.Equal(t, "abcdef", string(userResult))
+		assert.Equal(t, int32(1), failing.Load())
+	})
+
+	t.Run("returns the errors of all endpoints", func(t *testing.T) {
+		failing := func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		ea := functions.NewMultiEndpointExternalAdapterClient(functions.ExternalAdapterClientConfig{
+			Endpoints:        []functions.ExternalAdapterEndpoint{newEndpoint(t, "a", failing), newEndpoint(t, "b", failing)},
+			MaxResponseBytes: 100_000,
+			HedgeDelay:       time.Hour,
+		})
+		_, _, _, err := ea.RunComputation(testutils.Context(t), "requestID1234", "TestJob", "SubOwner", 1, functions.RequestFlags{}, "secRETS", &functions.RequestData{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "external adapter a: external adapter responded with HTTP 400")
+		assert.Contains(t, err.Error(), "external adapter b: external adapter responded with HTTP 400")
+	})
+}
+
+func TestRunComputation_DeduplicatesInflightRequests(t *testing.T) {
+	var requests atomic.Int32
+	release := make(chan struct{})
+	adapterUrl := newEndpoint(t, "adapter", func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+		fmt.Fprintln(w, runComputationSuccessResponse)
+	}).URL
+	ea := functions.NewExternalAdapterClient(adapterUrl, 100_000, 0, 0)
+
+	run := func(requestID string) ([]byte, error) {
+		userResult, _, _, err := ea.RunComputation(testutils.Context(t), requestID, "TestJob", "SubOwner", 1, functions.RequestFlags{}, "secRETS", &functions.RequestData{})
+		return userResult, err
+	}
+
+	var wg sync.WaitGroup
+	for _, requestID := range []string{"requestID1", "requestID1", "requestID2"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			userResult, err := run(requestID)
+			assert.NoError(t, err)
+			assert.Equal(t, "abcdef", string(userResult))
+		}()
+	}
+	// requestID1 is only sent once
+	testutils.RequireEventually(t, func() bool { return requests.Load() == 2 })
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(2), requests.Load())
+
+	// completed requests are sent again
+	_, err := run("requestID1")
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), requests.Load())
+}
+
+func TestRunComputation_DeduplicatedRequestsAreDetached(t *testing.T) {
+	type adapter struct {
+		ea        functions.ExternalAdapterClient
+		requests  *atomic.Int32
+		release   chan struct{}
+		cancelled chan struct{}
+	}
+	newAdapter := func(t *testing.T) adapter {
+		a := adapter{requests: new(atomic.Int32), release: make(chan struct{}), cancelled: make(chan struct{})}
+		adapterUrl := newEndpoint(t, "adapter", func(w http.ResponseWriter, r *http.Request) {
+			a.requests.Add(1)
+			select {
+			case <-a.release:
+				fmt.Fprintln(w, runComputationSuccessResponse)
+			case <-r.Context().Done():
+				close(a.cancelled)
+			}
+		}).URL
+		a.ea = functions.NewExternalAdapterClient(adapterUrl, 100_000, 0, 0)
+		return a
+	}
+	run := func(ctx context.Context, a adapter) ([]byte, error) {
+		userResult, _, _, err := a.ea.RunComputation(ctx, "requestID1", "TestJob", "SubOwner", 1, functions.RequestFlags{}, "secRETS", &functions.RequestData{})
+		return userResult, err
+	}
+
+	t.Run("outlives the caller which sent it", func(t *testing.T) {
+		a := newAdapter(t)
+		firstCtx, cancelFirst := context.WithCancel(testutils.Context(t))
+		firstErr := make(chan error, 1)
+		go func() {
+			_, err := run(firstCtx, a)
+			firstErr <- err
+		}()
+		testutils.RequireEventually(t, func() bool { return a.requests.Load() == 1 })
+
+		second := make(chan []byte, 1)
+		go func() {
+			userResult, err := run(testutils.Context(t), a)
+			assert.NoError(t, err)
+			second <- userResult
+		}()
+		time.Sleep(100 * time.Millisecond)
+
+		// the first caller stops waiting, without cancelling the request
+		cancelFirst()
+		require.ErrorIs(t, <-firstErr, context.Canceled)
+		close(a.release)
+		assert.Equal(t, "abcdef", string(<-second))
+		assert.Equal(t, int32(1), a.requests.Load())
+	})
+
+	t.Run("is cancelled once no caller waits", func(t *testing.T) {
+		a := newAdapter(t)
+		defer close(a.release)
+		ctx, cancel := context.WithTimeout(testutils.Context(t), 100*time.Millisecond)
+		defer cancel()
+		_, err := run(ctx, a)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		select {
+		case <-a.cancelled:
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("the adapter request was not cancelled")
+		}
+	})
+}
@@ -0,0 +1,387 @@
+package gateway
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/timeutil"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+)
+
+const (
+	// RedactedValue replaces the redacted fields of the payloads recorded in the audit log.
+	RedactedValue = "[redacted]"
+
+	auditLogBufferSize   = 1000
+	auditLogReapInterval = time.Hour
+)
+
+// AuditMessage is a message recorded in the audit log, with its payload redacted.
+type AuditMessage struct {
+	Message *api.Message
+}
+
+func (m AuditMessage) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Message)
+}
+
+func (m *AuditMessage) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		m.Message = nil
+		return nil
+	}
+	m.Message = new(api.Message)
+	return json.Unmarshal(b, m.Message)
+}
+
+// Value returns m serialized for database storage.
+func (m AuditMessage) Value() (driver.Value, error) {
+	if m.Message == nil {
+		return nil, nil
+	}
+	return json.Marshal(m.Message)
+}
+
+// Scan reads m from the database.
+func (m *AuditMessage) Scan(value interface{}) error {
+	if value == nil {
+		m.Message = nil
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("AuditMessage#Scan received a value of type %T", value)
+	}
+	return m.UnmarshalJSON(b)
+}
+
+// AuditNodeResponse is the response of a node to a request recorded in the audit log.
+type AuditNodeResponse struct {
+	NodeAddress string       `json:"nodeAddress"`
+	ReceivedAt  time.Time    `json:"receivedAt"`
+	Message     AuditMessage `json:"message"`
+}
+
+// AuditNodeResponses are the responses of the nodes to a request, in the order they were received.
+type AuditNodeResponses []AuditNodeResponse
+
+// Value returns r serialized for database storage.
+func (r AuditNodeResponses) Value() (driver.Value, error) {
+	if r == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal([]AuditNodeResponse(r))
+}
+
+// Scan reads r from the database.
+func (r *AuditNodeResponses) Scan(value interface{}) error {
+	if value == nil {
+		*r = nil
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("AuditNodeResponses#Scan received a value of type %T", value)
+	}
+	return json.Unmarshal(b, (*[]AuditNodeResponse)(r))
+}
+
+// AuditRecord is a request of a user recorded in the audit log, with the responses of the nodes and of the gateway.
+type AuditRecord struct {
+	ID        int64
+	DonID     string
+	MessageID string
+	Method    string
+	Sender    string
+	Request   AuditMessage
+	// Response is only set for successful requests.
+	Response      AuditMessage
+	NodeResponses AuditNodeResponses
+	ErrorCode     api.ErrorCode
+	ErrorMessage  string
+	ReceivedAt    time.Time
+	RespondedAt   time.Time
+}
+
+// Duration returns the time the gateway took to respond to the request.
+func (r AuditRecord) Duration() time.Duration {
+	return r.RespondedAt.Sub(r.ReceivedAt)
+}
+
+// AuditFilter selects the records returned by FindAuditRecords. Empty fields match all records.
+type AuditFilter struct {
+	Sender string
+	Method string
+	DonID  string
+	// Limit is the maximum number of records returned, the most recent first.
+	Limit int
+}
+
+// FindAuditRecords returns the records of the audit log matching filter, the most recent first.
+func FindAuditRecords(ctx context.Context, ds sqlutil.DataSource, filter AuditFilter) (records []AuditRecord, err error) {
+	err = ds.SelectContext(ctx, &records, `SELECT * FROM gateway_audit_log
+WHERE ($1 = '' OR sender = lower($1)) AND ($2 = '' OR method = $2) AND ($3 = '' OR don_id = $3)
+ORDER BY received_at DESC, id DESC LIMIT $4`, filter.Sender, filter.Method, filter.DonID, filter.Limit)
+	return records, errors.Wrap(err, "failed to find audit records")
+}
+
+// FindAuditRecord returns the record of the audit log with id.
+func FindAuditRecord(ctx context.Context, ds sqlutil.DataSource, id int64) (record AuditRecord, err error) {
+	err = ds.GetContext(ctx, &record, `SELECT * FROM gateway_audit_log WHERE id = $1`, id)
+	return record, errors.Wrapf(err, "failed to find audit record %d", id)
+}
+
+// AuditLog records the requests of the users, with the responses of the nodes and of the gateway, in the
+// gateway_audit_log table. Records are written in the background, so that the database never delays responses.
+type AuditLog struct {
+	services.Service
+	eng *services.Engine
+
+	ds        sqlutil.DataSource
+	rules     []config.RedactionRule
+	maxAge    time.Duration
+	chRecords chan AuditRecord
+}
+
+// NewAuditLog returns an AuditLog writing to ds, which must be started before recording requests.
+func NewAuditLog(ds sqlutil.DataSource, cfg config.AuditLogConfig, lggr logger.Logger) *AuditLog {
+	a := &AuditLog{
+		ds:        ds,
+		rules:     cfg.RedactionRules,
+		maxAge:    time.Duration(cfg.MaxAgeHours) * time.Hour,
+		chRecords: make(chan AuditRecord, auditLogBufferSize),
+	}
+	a.Service, a.eng = services.Config{
+		Name:  "GatewayAuditLog",
+		Start: a.start,
+	}.NewServiceEngine(lggr)
+	return a
+}
+
+func (a *AuditLog) start(context.Context) error {
+	a.eng.Go(a.runWriter)
+	if a.maxAge > 0 {
+		a.eng.GoTick(timeutil.NewTicker(func() time.Duration { return auditLogReapInterval }), a.reap)
+	}
+	return nil
+}
+
+func (a *AuditLog) runWriter(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case record := <-a.chRecords:
+			if err := a.insert(ctx, record); err != nil && ctx.Err() == nil {
+				a.eng.Errorw("Failed to write gateway audit record", "donID", record.DonID, "messageID", record.MessageID, "err", err)
+			}
+		}
+	}
+}
+
+func (a *AuditLog) insert(ctx context.Context, r AuditRecord) error {
+	_, err := a.ds.ExecContext(ctx, `INSERT INTO gateway_audit_log
+(don_id, message_id, method, sender, request, response, node_responses, error_code, error_message, received_at, responded_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+		r.DonID, r.MessageID, r.Method, strings.ToLower(r.Sender), r.Request, r.Response, r.NodeResponses, int(r.ErrorCode), r.ErrorMessage, r.ReceivedAt, r.RespondedAt)
+	return errors.Wrap(err, "failed to insert audit record")
+}
+
+func (a *AuditLog) reap(ctx context.Context) {
+	if _, err := a.ds.ExecContext(ctx, `DELETE FROM gateway_audit_log WHERE received_at < $1`, time.Now().Add(-a.maxAge)); err != nil && ctx.Err() == nil {
+		a.eng.Errorw("Failed to delete expired gateway audit records", "err", err)
+	}
+}
+
+func (a *AuditLog) record(r AuditRecord) {
+	select {
+	case a.chRecords <- r:
+	default:
+		a.eng.Warnw("Gateway audit log buffer is full, dropping record", "donID", r.DonID, "messageID", r.MessageID, "sender", r.Sender)
+	}
+}
+
+// redact returns a copy of msg, with its payload redacted by the rules matching it.
+func (a *AuditLog) redact(msg *api.Message) AuditMessage {
+	if msg == nil {
+		return AuditMessage{}
+	}
+	redacted := *msg
+	for _, rule := range a.rules {
+		if (rule.DonId != "" && rule.DonId != msg.Body.DonId) || (rule.Method != "" && rule.Method != msg.Body.Method) {
+			continue
+		}
+		redacted.Body.Payload = redactPayload(redacted.Body.Payload, rule.Fields)
+	}
+	return AuditMessage{Message: &redacted}
+}
+
+// redactPayload returns payload with fields replaced by RedactedValue, or RedactedValue if fields is empty or payload
+// is not a JSON object.
+func redactPayload(payload json.RawMessage, fields []string) json.RawMessage {
+	redactedValue, _ := json.Marshal(RedactedValue)
+	if len(payload) == 0 {
+		return payload
+	}
+	if len(fields) == 0 {
+		return redactedValue
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(payload, &obj); err != nil {
+		return redactedValue
+	}
+	for _, field := range fields {
+		redactField(obj, strings.Split(field, "."))
+	}
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return redactedValue
+	}
+	return b
+}
+
+func redactField(obj map[string]interface{}, path []string) {
+	v, ok := obj[path[0]]
+	if !ok {
+		return
+	}
+	if len(path) == 1 {
+		obj[path[0]] = RedactedValue
+		return
+	}
+	if nested, ok := v.(map[string]interface{}); ok {
+		redactField(nested, path[1:])
+	}
+}
+
+type auditedHandlerFactory struct {
+	HandlerFactory
+	log *AuditLog
+}
+
+// NewAuditedHandlerFactory returns a HandlerFactory recording the requests of the handlers created by factory in log.
+func NewAuditedHandlerFactory(factory HandlerFactory, log *AuditLog) HandlerFactory {
+	return &auditedHandlerFactory{HandlerFactory: factory, log: log}
+}
+
+func (f *auditedHandlerFactory) NewHandler(handlerType HandlerType, handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON) (handlers.Handler, error) {
+	handler, err := f.HandlerFactory.NewHandler(handlerType, handlerConfig, donConfig, don)
+	if err != nil {
+		return nil, err
+	}
+	return &auditedHandler{
+		Handler: handler,
+		donID:   donConfig.DonId,
+		log:     f.log,
+		pending: make(map[auditKey]*AuditRecord),
+	}, nil
+}
+
+type auditKey struct {
+	sender    string
+	messageID string
+}
+
+// auditedHandler records the user requests processed by a Handler, along with the responses of the nodes until the
+// user gets a response.
+type auditedHandler struct {
+	handlers.Handler
+	donID string
+	log   *AuditLog
+
+	mu      sync.Mutex
+	pending map[auditKey]*AuditRecord
+}
+
+var _ handlers.Handler = (*auditedHandler)(nil)
+
+func (h *auditedHandler) HandleUserMessage(ctx context.Context, msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload) error {
+	record := &AuditRecord{
+		DonID:      h.donID,
+		MessageID:  msg.Body.MessageId,
+		Method:     msg.Body.Method,
+		Sender:     msg.Body.Sender,
+		Request:    h.log.redact(msg),
+		ReceivedAt: time.Now(),
+	}
+	key := auditKey{sender: strings.ToLower(msg.Body.Sender), messageID: msg.Body.MessageId}
+	h.mu.Lock()
+	if _, ok := h.pending[key]; !ok {
+		// duplicate requests are rejected by the handlers, and recorded without node responses
+		h.pending[key] = record
+	}
+	h.mu.Unlock()
+
+	ch := make(chan handlers.UserCallbackPayload, 1)
+	if err := h.Handler.HandleUserMessage(ctx, msg, ch); err != nil {
+		h.finish(key, record, handlers.UserCallbackPayload{ErrCode: api.HandlerError, ErrMsg: err.Error()})
+		return err
+	}
+	go h.await(ctx, key, record, ch, callbackCh)
+	return nil
+}
+
+// await forwards the response of the handler to callbackCh, and records it, or records the timeout of the request.
+func (h *auditedHandler) await(ctx context.Context, key auditKey, record *AuditRecord, ch <-chan handlers.UserCallbackPayload, callbackCh chan<- handlers.UserCallbackPayload) {
+	forward := func(response handlers.UserCallbackPayload, ok bool) {
+		if !ok {
+			h.finish(key, record, handlers.UserCallbackPayload{ErrCode: api.FatalError, ErrMsg: "handler closed the callback channel"})
+			close(callbackCh)
+			return
+		}
+		h.finish(key, record, response)
+		callbackCh <- response
+	}
+	select {
+	case response, ok := <-ch:
+		forward(response, ok)
+	case <-ctx.Done():
+		// responses sent as the request times out still reach the user
+		select {
+		case response, ok := <-ch:
+			forward(response, ok)
+		default:
+			h.finish(key, record, handlers.UserCallbackPayload{ErrCode: api.RequestTimeoutError, ErrMsg: "handler timeout"})
+		}
+	}
+}
+
+func (h *auditedHandler) finish(key auditKey, record *AuditRecord, response handlers.UserCallbackPayload) {
+	h.mu.Lock()
+	if h.pending[key] == record {
+		delete(h.pending, key)
+	}
+	record.RespondedAt = time.Now()
+	record.ErrorCode = response.ErrCode
+	record.ErrorMessage = response.ErrMsg
+	if response.ErrCode == api.NoError {
+		record.Response = h.log.redact(response.Msg)
+	}
+	r := *record
+	h.mu.Unlock()
+	h.log.record(r)
+}
+
+func (h *auditedHandler) HandleNodeMessage(ctx context.Context, msg *api.Message, nodeAddr string) error {
+	h.mu.Lock()
+	if record, ok := h.pending[auditKey{sender: strings.ToLower(msg.Body.Receiver), messageID: msg.Body.MessageId}]; ok {
+		record.NodeResponses = append(record.NodeResponses, AuditNodeResponse{
+			NodeAddress: nodeAddr,
+			ReceivedAt:  time.Now(),
+			Message:     h.log.redact(msg),
+		})
+	}
+	h.mu.Unlock()
+	return h.Handler.HandleNodeMessage(ctx, msg, nodeAddr)
+}
@@ -0,0 +1,146 @@
+package gateway_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	gc "github.com/smartcontractkit/chainlink/v2/core/services/gateway/common"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	handler_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/mocks"
+)
+
+func newAuditedDummyHandler(t *testing.T, auditLog *gateway.AuditLog, node gc.TestNode) (handlers.Handler, *handler_mocks.DON) {
+	donConfig := &config.DONConfig{DonId: "audited_don", Members: []config.NodeConfig{{Name: "node", Address: node.Address}}}
+	factory := gateway.NewAuditedHandlerFactory(gateway.NewHandlerFactory(nil, nil, nil, logger.TestLogger(t)), auditLog)
+	don := handler_mocks.NewDON(t)
+	handler, err := factory.NewHandler(gateway.DummyHandlerType, nil, donConfig, don)
+	require.NoError(t, err)
+	return handler, don
+}
+
+func newAuditedMessage(t *testing.T, id string, method string, payload string, signer gc.TestNode, receiver string) *api.Message {
+	msg := &api.Message{Body: api.MessageBody{
+		MessageId: id,
+		Method:    method,
+		DonId:     "audited_don",
+		Receiver:  receiver,
+		Payload:   []byte(payload),
+	}}
+	require.NoError(t, msg.Sign(signer.PrivateKey))
+	return msg
+}
+
+func findAuditRecord(t *testing.T, find func() ([]gateway.AuditRecord, error)) gateway.AuditRecord {
+	var records []gateway.AuditRecord
+	require.Eventually(t, func() bool {
+		var err error
+		records, err = find()
+		require.NoError(t, err)
+		return len(records) > 0
+	}, testutils.WaitTimeout(t), 10*time.Millisecond)
+	require.Len(t, records, 1)
+	return records[0]
+}
+
+func TestAuditLog_RecordsRequests(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	ctx := testutils.Context(t)
+	auditLog := gateway.NewAuditLog(db, config.AuditLogConfig{
+		Enabled: true,
+		RedactionRules: []config.RedactionRule{
+			{Method: "secret_method", Fields: []string{"secret.key", "missing"}},
+			{DonId: "audited_don", Method: "hidden_method"},
+		},
+	}, logger.TestLogger(t))
+	servicetest.Run(t, auditLog)
+	nodes := gc.NewTestNodes(t, 2)
+	node, user := nodes[0], nodes[1]
+	find := func(filter gateway.AuditFilter) func() ([]gateway.AuditRecord, error) {
+		return func() ([]gateway.AuditRecord, error) {
+			filter.Sender = user.Address
+			filter.Limit = 10
+			return gateway.FindAuditRecords(ctx, db, filter)
+		}
+	}
+
+	t.Run("successful request", func(t *testing.T) {
+		handler, don := newAuditedDummyHandler(t, auditLog, node)
+		don.On("SendToNode", mock.Anything, node.Address, mock.Anything).Return(nil)
+
+		request := newAuditedMessage(t, "1", "secret_method", `{"secret":{"key":"value","public":1}}`, user, "")
+		callbackCh := make(chan handlers.UserCallbackPayload, 1)
+		require.NoError(t, handler.HandleUserMessage(ctx, request, callbackCh))
+		response := newAuditedMessage(t, "1", "secret_method", `{"result":{"key":"value"}}`, node, user.Address)
+		require.NoError(t, handler.HandleNodeMessage(ctx, response, node.Address))
+		userResponse := <-callbackCh
+		require.Equal(t, api.NoError, userResponse.ErrCode)
+		assert.JSONEq(t, `{"result":{"key":"value"}}`, string(userResponse.Msg.Body.Payload))
+
+		record := findAuditRecord(t, find(gateway.AuditFilter{Method: "secret_method"}))
+		assert.Equal(t, "audited_don", record.DonID)
+		assert.Equal(t, "1", record.MessageID)
+		assert.Equal(t, user.Address, record.Sender)
+		assert.Equal(t, api.NoError, record.ErrorCode)
+		assert.JSONEq(t, `{"secret":{"key":"[redacted]","public":1}}`, string(record.Request.Message.Body.Payload))
+		assert.Equal(t, request.Signature, record.Request.Message.Signature)
+		require.Len(t, record.NodeResponses, 1)
+		assert.Equal(t, node.Address, record.NodeResponses[0].NodeAddress)
+		assert.JSONEq(t, `{"result":{"key":"value"}}`, string(record.NodeResponses[0].Message.Message.Body.Payload))
+		assert.JSONEq(t, `{"result":{"key":"value"}}`, string(record.Response.Message.Body.Payload))
+		assert.False(t, record.RespondedAt.Before(record.ReceivedAt))
+
+		found, err := gateway.FindAuditRecord(ctx, db, record.ID)
+		require.NoError(t, err)
+		assert.Equal(t, record.MessageID, found.MessageID)
+		// the original request is not modified by the redaction
+		assert.JSONEq(t, `{"secret":{"key":"value","public":1}}`, string(request.Body.Payload))
+	})
+
+	t.Run("handler error", func(t *testing.T) {
+		handler, don := newAuditedDummyHandler(t, auditLog, node)
+		don.On("SendToNode", mock.Anything, node.Address, mock.Anything).Return(errors.New("node unreachable"))
+
+		request := newAuditedMessage(t, "2", "hidden_method", `{"key":"value"}`, user, "")
+		require.Error(t, handler.HandleUserMessage(ctx, request, make(chan handlers.UserCallbackPayload, 1)))
+
+		record := findAuditRecord(t, find(gateway.AuditFilter{Method: "hidden_method"}))
+		assert.Equal(t, api.HandlerError, record.ErrorCode)
+		assert.Equal(t, "node unreachable", record.ErrorMessage)
+		assert.JSONEq(t, `"[redacted]"`, string(record.Request.Message.Body.Payload))
+		assert.Nil(t, record.Response.Message)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		handler, don := newAuditedDummyHandler(t, auditLog, node)
+		don.On("SendToNode", mock.Anything, node.Address, mock.Anything).Return(nil)
+
+		requestCtx, cancel := context.WithCancel(ctx)
+		request := newAuditedMessage(t, "3", "slow_method", `{}`, user, "")
+		require.NoError(t, handler.HandleUserMessage(requestCtx, request, make(chan handlers.UserCallbackPayload, 1)))
+		cancel()
+
+		record := findAuditRecord(t, find(gateway.AuditFilter{Method: "slow_method", DonID: "audited_don"}))
+		assert.Equal(t, api.RequestTimeoutError, record.ErrorCode)
+		assert.Equal(t, "handler timeout", record.ErrorMessage)
+		assert.Empty(t, record.NodeResponses)
+	})
+
+	records, err := gateway.FindAuditRecords(ctx, db, gateway.AuditFilter{Sender: node.Address, Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, records)
+}
@@ -12,7 +12,27 @@ type GatewayConfig struct {
 	ConnectionManagerConfig ConnectionManagerConfig
 	// HTTPClientConfig is configuration for outbound HTTP calls to external endpoints
 	HTTPClientConfig gw_net.HTTPClientConfig
-	Dons             []DONConfig
+	// AuditLogConfig enables the audit log of the requests of the users and the responses of the nodes
+	AuditLogConfig AuditLogConfig
+	Dons           []DONConfig
+}
+
+type AuditLogConfig struct {
+	Enabled bool
+	// MaxAgeHours is the age after which records are deleted, they are kept forever if it is 0
+	MaxAgeHours uint32
+	// RedactionRules redact the payloads of the messages recorded in the audit log
+	RedactionRules []RedactionRule
+}
+
+// RedactionRule redacts the payloads of the messages matching DonId and Method, or of all the messages for the fields
+// left empty.
+type RedactionRule struct {
+	DonId  string
+	Method string
+	// Fields are the keys of the payload which are redacted, with dot-separated paths for nested objects. The whole
+	// payload is redacted if Fields is empty.
+	Fields []string
 }
 
 type ConnectionManagerConfig struct {
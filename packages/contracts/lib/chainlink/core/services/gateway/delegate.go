@@ -60,11 +60,19 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) (services
 		return nil, err
 	}
 	handlerFactory := NewHandlerFactory(d.legacyChains, d.ds, httpClient, d.lggr)
+	var auditLog *AuditLog
+	if gatewayConfig.AuditLogConfig.Enabled {
+		auditLog = NewAuditLog(d.ds, gatewayConfig.AuditLogConfig, d.lggr)
+		handlerFactory = NewAuditedHandlerFactory(handlerFactory, auditLog)
+	}
 	gateway, err := NewGatewayFromConfig(&gatewayConfig, handlerFactory, d.lggr)
 	if err != nil {
 		return nil, err
 	}
 
+	if auditLog != nil {
+		return []job.ServiceCtx{auditLog, gateway}, nil
+	}
 	return []job.ServiceCtx{gateway}, nil
 }
 
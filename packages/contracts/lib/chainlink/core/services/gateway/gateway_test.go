@@ -2,6 +2,7 @@ package gateway_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"testing"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pelletier/go-toml/v2"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -125,6 +127,33 @@ Address = "0xnot_an_address"
 	require.Error(t, err)
 }
 
+func TestGateway_RegisterHandlerType(t *testing.T) {
+	t.Parallel()
+
+	assert.Subset(t, gateway.RegisteredHandlerTypes(), []string{"dummy", "functions", "json-rpc-relay", "web-api-capabilities"})
+	require.EqualError(t, gateway.RegisterHandlerType(gateway.DummyHandlerType, nil), "constructor of handler type dummy must not be nil")
+
+	var created *config.DONConfig
+	constructor := func(deps gateway.HandlerDependencies, handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON) (handlers.Handler, error) {
+		created = donConfig
+		return handler_mocks.NewHandler(t), nil
+	}
+	require.NoError(t, gateway.RegisterHandlerType("test-register-handler-type", constructor))
+	require.EqualError(t, gateway.RegisterHandlerType("test-register-handler-type", constructor), "handler type test-register-handler-type is already registered")
+	require.EqualError(t, gateway.RegisterHandlerType(gateway.DummyHandlerType, constructor), "handler type dummy is already registered")
+
+	tomlConfig := buildConfig(`
+[[dons]]
+DonId = "my_don"
+HandlerName = "test-register-handler-type"
+`)
+	lggr := logger.TestLogger(t)
+	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), gateway.NewHandlerFactory(nil, nil, nil, lggr), lggr)
+	require.NoError(t, err)
+	require.NotNil(t, created)
+	assert.Equal(t, "my_don", created.DonId)
+}
+
 func TestGateway_CleanStartAndClose(t *testing.T) {
 	t.Parallel()
 
@@ -3,6 +3,8 @@ package gateway
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
+	"sync"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink-evm/pkg/chains/legacyevm"
@@ -11,6 +13,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/capabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/functions"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/relay"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
 )
 
@@ -18,35 +21,100 @@ const (
 	FunctionsHandlerType   HandlerType = "functions"
 	DummyHandlerType       HandlerType = "dummy"
 	WebAPICapabilitiesType HandlerType = "web-api-capabilities"
+	RelayHandlerType       HandlerType = "json-rpc-relay"
 )
 
+// HandlerDependencies are the node services available to the constructors of handlers.
+type HandlerDependencies struct {
+	LegacyChains legacyevm.LegacyChainContainer
+	DS           sqlutil.DataSource
+	HTTPClient   network.HTTPClient
+	Lggr         logger.Logger
+}
+
+// HandlerConstructor creates the handler of a DON, configured with the HandlerConfig of its DONConfig.
+type HandlerConstructor func(deps HandlerDependencies, handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON) (handlers.Handler, error)
+
+var (
+	handlerTypesMu sync.RWMutex
+	handlerTypes   = map[HandlerType]HandlerConstructor{}
+)
+
+func init() {
+	mustRegisterHandlerType(FunctionsHandlerType, func(deps HandlerDependencies, handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON) (handlers.Handler, error) {
+		return functions.NewFunctionsHandlerFromConfig(handlerConfig, donConfig, don, deps.LegacyChains, deps.DS, deps.Lggr)
+	})
+	mustRegisterHandlerType(DummyHandlerType, func(deps HandlerDependencies, _ json.RawMessage, donConfig *config.DONConfig, don handlers.DON) (handlers.Handler, error) {
+		return handlers.NewDummyHandler(donConfig, don, deps.Lggr)
+	})
+	mustRegisterHandlerType(WebAPICapabilitiesType, func(deps HandlerDependencies, handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON) (handlers.Handler, error) {
+		return capabilities.NewHandler(handlerConfig, donConfig, don, deps.HTTPClient, deps.Lggr)
+	})
+	mustRegisterHandlerType(RelayHandlerType, func(deps HandlerDependencies, handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON) (handlers.Handler, error) {
+		return relay.NewHandlerFromConfig(handlerConfig, donConfig, don, deps.Lggr)
+	})
+}
+
+// RegisterHandlerType makes the handlers created by constructor available to the DONs configured with handlerType,
+// without changing the handler factory. It is meant to be called from the init function of the package implementing
+// the handler, and returns an error if handlerType is already registered.
+func RegisterHandlerType(handlerType HandlerType, constructor HandlerConstructor) error {
+	if handlerType == "" {
+		return fmt.Errorf("handler type must not be empty")
+	}
+	if constructor == nil {
+		return fmt.Errorf("constructor of handler type %s must not be nil", handlerType)
+	}
+	handlerTypesMu.Lock()
+	defer handlerTypesMu.Unlock()
+	if _, ok := handlerTypes[handlerType]; ok {
+		return fmt.Errorf("handler type %s is already registered", handlerType)
+	}
+	handlerTypes[handlerType] = constructor
+	return nil
+}
+
+func mustRegisterHandlerType(handlerType HandlerType, constructor HandlerConstructor) {
+	if err := RegisterHandlerType(handlerType, constructor); err != nil {
+		panic(err)
+	}
+}
+
+// RegisteredHandlerTypes returns the registered handler types, in alphabetical order.
+func RegisteredHandlerTypes() []HandlerType {
+	handlerTypesMu.RLock()
+	defer handlerTypesMu.RUnlock()
+	types := make([]HandlerType, 0, len(handlerTypes))
+	for handlerType := range handlerTypes {
+		types = append(types, handlerType)
+	}
+	sort.Strings(types)
+	return types
+}
+
 type handlerFactory struct {
-	legacyChains legacyevm.LegacyChainContainer
-	ds           sqlutil.DataSource
-	lggr         logger.Logger
-	httpClient   network.HTTPClient
+	deps HandlerDependencies
 }
 
 var _ HandlerFactory = (*handlerFactory)(nil)
 
 func NewHandlerFactory(legacyChains legacyevm.LegacyChainContainer, ds sqlutil.DataSource, httpClient network.HTTPClient, lggr logger.Logger) HandlerFactory {
 	return &handlerFactory{
-		legacyChains,
-		ds,
-		lggr,
-		httpClient,
+		deps: HandlerDependencies{
+			LegacyChains: legacyChains,
+			DS:           ds,
+			HTTPClient:   httpClient,
+			Lggr:         lggr,
+		},
 	}
 }
 
 func (hf *handlerFactory) NewHandler(handlerType HandlerType, handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON) (handlers.Handler, error) {
-	switch handlerType {
-	case FunctionsHandlerType:
-		return functions.NewFunctionsHandlerFromConfig(handlerConfig, donConfig, don, hf.legacyChains, hf.ds, hf.lggr)
-	case DummyHandlerType:
-		return handlers.NewDummyHandler(donConfig, don, hf.lggr)
-	case WebAPICapabilitiesType:
-		return capabilities.NewHandler(handlerConfig, donConfig, don, hf.httpClient, hf.lggr)
-	default:
+	handlerTypesMu.RLock()
+	constructor, ok := handlerTypes[handlerType]
+	handlerTypesMu.RUnlock()
+	if !ok {
 		return nil, fmt.Errorf("unsupported handler type %s", handlerType)
 	}
+	return constructor(hf.deps, handlerConfig, donConfig, don)
 }
@@ -3,9 +3,11 @@ package functions
 import "github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
 
 const (
-	MethodSecretsSet  = "secrets_set"
-	MethodSecretsList = "secrets_list"
-	MethodHeartbeat   = "heartbeat"
+	MethodSecretsSet    = "secrets_set"
+	MethodSecretsList   = "secrets_list"
+	MethodSecretsDelete = "secrets_delete"
+	MethodSecretsWatch  = "secrets_watch"
+	MethodHeartbeat     = "heartbeat"
 )
 
 type SecretsSetRequest struct {
@@ -18,6 +20,24 @@ type SecretsSetRequest struct {
 
 // SecretsListRequest has empty payload
 
+// SecretsDeleteRequest deletes a slot before its expiration. The signature is
+// calculated over s4.NewDeleteEnvelope, and Version must be greater than the
+// version of the deleted secrets.
+type SecretsDeleteRequest struct {
+	SlotID     uint   `json:"slot_id"`
+	Version    uint64 `json:"version"`
+	Expiration int64  `json:"expiration"`
+	Signature  []byte `json:"signature"`
+}
+
+// SecretsWatchRequest waits until the confirmed secrets of the sender differ
+// from Rows, or until TimeoutMillis elapse.
+type SecretsWatchRequest struct {
+	// Rows are the confirmed secrets known by the user, e.g. from a previous secrets_watch response.
+	Rows          []SecretsListRow `json:"rows"`
+	TimeoutMillis uint32           `json:"timeout_millis"`
+}
+
 type ResponseBase struct {
 	Success      bool   `json:"success"`
 	ErrorMessage string `json:"error_message,omitempty"`
@@ -38,6 +58,23 @@ type SecretsListRow struct {
 	Expiration int64  `json:"expiration"`
 }
 
+type SecretsDeleteResponse struct {
+	ResponseBase
+}
+
+// SecretsWatchResponse has no changes if the request timed out.
+type SecretsWatchResponse struct {
+	ResponseBase
+	Changes []SecretsWatchChange `json:"changes,omitempty"`
+}
+
+type SecretsWatchChange struct {
+	SlotID     uint   `json:"slot_id"`
+	Version    uint64 `json:"version"`
+	Expiration int64  `json:"expiration"`
+	Deleted    bool   `json:"deleted,omitempty"`
+}
+
 // Gateway -> User response, which combines responses from several nodes
 type CombinedResponse struct {
 	ResponseBase
@@ -146,6 +146,32 @@ func TestFunctionsHandler_HandleUserMessage_SecretsSet(t *testing.T) {
 	}
 }
 
+func TestFunctionsHandler_HandleUserMessage_SecretsDeleteAndWatch(t *testing.T) {
+	t.Parallel()
+
+	for _, method := range []string{functions.MethodSecretsDelete, functions.MethodSecretsWatch} {
+		t.Run(method, func(t *testing.T) {
+			nodes, user := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 1)[0]
+			// the balance of the sender is not checked
+			handler, don, allowlist, _ := newFunctionsHandlerForATestDON(t, nodes, time.Hour*24, user.Address)
+			userRequestMsg := newSignedMessage(t, "1234", method, "don_id", user.PrivateKey)
+
+			callbackCh := make(chan handlers.UserCallbackPayload, 1)
+			allowlist.On("Allow", common.HexToAddress(user.Address)).Return(true, nil)
+			don.On("SendToNode", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			require.NoError(t, handler.HandleUserMessage(testutils.Context(t), &userRequestMsg, callbackCh))
+			sendNodeReponses(t, handler, userRequestMsg, nodes, []bool{true, false, true})
+
+			response := <-callbackCh
+			require.Equal(t, api.NoError, response.ErrCode)
+			var payload functions.CombinedResponse
+			require.NoError(t, json.Unmarshal(response.Msg.Body.Payload, &payload))
+			require.True(t, payload.Success)
+			require.Len(t, payload.NodeResponses, 2)
+		})
+	}
+}
+
 func TestFunctionsHandler_HandleUserMessage_Heartbeat(t *testing.T) {
 	t.Parallel()
 
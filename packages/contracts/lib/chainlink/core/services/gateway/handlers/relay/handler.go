@@ -0,0 +1,278 @@
+package relay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	hc "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
+)
+
+const (
+	defaultMaxPendingRequests = 1000
+	defaultRequestTimeout     = 30 * time.Second
+)
+
+var (
+	ErrNotAllowlisted    = errors.New("sender not allowlisted")
+	ErrRateLimited       = errors.New("rate-limited")
+	ErrUnsupportedMethod = errors.New("unsupported method")
+
+	promHandlerError = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gateway_relay_handler_error",
+		Help: "Metric to track relay handler errors",
+	}, []string{"don_id", "error"})
+)
+
+// AggregationPolicy selects the responses of the nodes returned to the user.
+type AggregationPolicy string
+
+const (
+	// AggregationFirst returns the first response of a node.
+	AggregationFirst AggregationPolicy = "first"
+	// AggregationQuorum returns the payload responded by F+1 nodes, or an error once no payload can reach F+1.
+	AggregationQuorum AggregationPolicy = "quorum"
+	// AggregationAll returns the responses of all the nodes, once they all responded.
+	AggregationAll AggregationPolicy = "all"
+)
+
+type HandlerConfig struct {
+	// AllowedUsers are the addresses of the users whose requests are relayed.
+	AllowedUsers []string `json:"allowedUsers"`
+	// Methods are the methods of the nodes the users can call, with the aggregation of their responses.
+	Methods []MethodConfig `json:"methods"`
+	// Not specifying UserRateLimiter config disables rate limiting
+	UserRateLimiter      *hc.RateLimiterConfig `json:"userRateLimiter"`
+	MaxPendingRequests   uint32                `json:"maxPendingRequests"`
+	RequestTimeoutMillis int64                 `json:"requestTimeoutMillis"`
+}
+
+type MethodConfig struct {
+	Name string `json:"name"`
+	// Aggregation defaults to AggregationQuorum.
+	Aggregation AggregationPolicy `json:"aggregation"`
+}
+
+// CombinedResponse is the payload of the responses of methods aggregated with AggregationAll.
+type CombinedResponse struct {
+	NodeResponses []*api.Message `json:"node_responses"`
+}
+
+type PendingRequest struct {
+	request     *api.Message
+	aggregation AggregationPolicy
+	// responses holds the response of each node, by address
+	responses map[string]*api.Message
+	// payloads counts the nodes which responded each payload
+	payloads map[string]int
+}
+
+// handler relays the signed requests of allowlisted users to all the nodes of the DON, and aggregates their responses.
+// Requests are relayed unchanged, so that nodes can verify the signature of the user.
+type handler struct {
+	services.StateMachine
+
+	donConfig       *config.DONConfig
+	don             handlers.DON
+	allowedUsers    map[string]struct{}
+	methods         map[string]AggregationPolicy
+	pendingRequests hc.RequestCache[PendingRequest]
+	userRateLimiter *hc.RateLimiter
+	lggr            logger.Logger
+}
+
+var _ handlers.Handler = (*handler)(nil)
+
+func NewHandlerFromConfig(handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON, lggr logger.Logger) (handlers.Handler, error) {
+	var cfg HandlerConfig
+	if err := json.Unmarshal(handlerConfig, &cfg); err != nil {
+		return nil, err
+	}
+	var userRateLimiter *hc.RateLimiter
+	if cfg.UserRateLimiter != nil {
+		var err error
+		userRateLimiter, err = hc.NewRateLimiter(*cfg.UserRateLimiter)
+		if err != nil {
+			return nil, err
+		}
+	}
+	maxPendingRequests := cfg.MaxPendingRequests
+	if maxPendingRequests == 0 {
+		maxPendingRequests = defaultMaxPendingRequests
+	}
+	requestTimeout := time.Duration(cfg.RequestTimeoutMillis) * time.Millisecond
+	if requestTimeout <= 0 {
+		requestTimeout = defaultRequestTimeout
+	}
+	return NewHandler(cfg, donConfig, don, hc.NewRequestCache[PendingRequest](requestTimeout, maxPendingRequests), userRateLimiter, lggr)
+}
+
+func NewHandler(cfg HandlerConfig, donConfig *config.DONConfig, don handlers.DON, pendingRequests hc.RequestCache[PendingRequest], userRateLimiter *hc.RateLimiter, lggr logger.Logger) (handlers.Handler, error) {
+	if len(cfg.AllowedUsers) == 0 {
+		return nil, errors.New("at least one allowed user must be configured")
+	}
+	allowedUsers := make(map[string]struct{})
+	for _, user := range cfg.AllowedUsers {
+		if !common.IsHexAddress(user) {
+			return nil, fmt.Errorf("invalid allowed user address %s", user)
+		}
+		allowedUsers[strings.ToLower(user)] = struct{}{}
+	}
+	if len(cfg.Methods) == 0 {
+		return nil, errors.New("at least one method must be configured")
+	}
+	methods := make(map[string]AggregationPolicy)
+	for _, method := range cfg.Methods {
+		if method.Name == "" {
+			return nil, errors.New("method name must not be empty")
+		}
+		if _, ok := methods[method.Name]; ok {
+			return nil, fmt.Errorf("duplicate method %s", method.Name)
+		}
+		switch method.Aggregation {
+		case "":
+			methods[method.Name] = AggregationQuorum
+		case AggregationFirst, AggregationQuorum, AggregationAll:
+			methods[method.Name] = method.Aggregation
+		default:
+			return nil, fmt.Errorf("unknown aggregation %q of method %s, must be %q, %q or %q", method.Aggregation, method.Name, AggregationFirst, AggregationQuorum, AggregationAll)
+		}
+	}
+	return &handler{
+		donConfig:       donConfig,
+		don:             don,
+		allowedUsers:    allowedUsers,
+		methods:         methods,
+		pendingRequests: pendingRequests,
+		userRateLimiter: userRateLimiter,
+		lggr:            lggr.Named("RelayHandler." + donConfig.DonId),
+	}, nil
+}
+
+func (h *handler) HandleUserMessage(ctx context.Context, msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload) error {
+	if _, ok := h.allowedUsers[strings.ToLower(msg.Body.Sender)]; !ok {
+		h.lggr.Debugw("received a message from a non-allowlisted address", "sender", msg.Body.Sender)
+		promHandlerError.WithLabelValues(h.donConfig.DonId, ErrNotAllowlisted.Error()).Inc()
+		return ErrNotAllowlisted
+	}
+	aggregation, ok := h.methods[msg.Body.Method]
+	if !ok {
+		h.lggr.Debugw("unsupported method", "method", msg.Body.Method)
+		promHandlerError.WithLabelValues(h.donConfig.DonId, ErrUnsupportedMethod.Error()).Inc()
+		return ErrUnsupportedMethod
+	}
+	if h.userRateLimiter != nil && !h.userRateLimiter.Allow(msg.Body.Sender) {
+		h.lggr.Debugw("rate-limited", "sender", msg.Body.Sender)
+		promHandlerError.WithLabelValues(h.donConfig.DonId, ErrRateLimited.Error()).Inc()
+		return ErrRateLimited
+	}
+
+	err := h.pendingRequests.NewRequest(msg, callbackCh, &PendingRequest{
+		request:     msg,
+		aggregation: aggregation,
+		responses:   make(map[string]*api.Message),
+		payloads:    make(map[string]int),
+	})
+	if err != nil {
+		h.lggr.Warnw("error adding new request", "sender", msg.Body.Sender, "err", err)
+		promHandlerError.WithLabelValues(h.donConfig.DonId, err.Error()).Inc()
+		return err
+	}
+	// Send to all nodes.
+	for _, member := range h.donConfig.Members {
+		if err := h.don.SendToNode(ctx, member.Address, msg); err != nil {
+			h.lggr.Debugw("failed to send to a node", "node", member.Address, "err", err)
+		}
+	}
+	return nil
+}
+
+func (h *handler) HandleNodeMessage(ctx context.Context, msg *api.Message, nodeAddr string) error {
+	if _, ok := h.methods[msg.Body.Method]; !ok {
+		h.lggr.Debugw("unsupported method", "method", msg.Body.Method)
+		return ErrUnsupportedMethod
+	}
+	return h.pendingRequests.ProcessResponse(msg, func(response *api.Message, pending *PendingRequest) (*handlers.UserCallbackPayload, *PendingRequest, error) {
+		return h.processResponse(response, strings.ToLower(nodeAddr), pending)
+	})
+}
+
+// Conforms to ResponseProcessor[*PendingRequest]
+func (h *handler) processResponse(response *api.Message, nodeAddr string, pending *PendingRequest) (*handlers.UserCallbackPayload, *PendingRequest, error) {
+	if _, exists := pending.responses[nodeAddr]; exists {
+		return nil, nil, errors.New("duplicate response")
+	}
+	if response.Body.Method != pending.request.Body.Method {
+		return nil, pending, errors.New("invalid method")
+	}
+	pending.responses[nodeAddr] = response
+	payload := string(response.Body.Payload)
+	pending.payloads[payload]++
+
+	switch pending.aggregation {
+	case AggregationFirst:
+		return newUserResponse(pending.request, response.Body.Payload), pending, nil
+	case AggregationQuorum:
+		if pending.payloads[payload] >= h.donConfig.F+1 {
+			return newUserResponse(pending.request, response.Body.Payload), pending, nil
+		}
+		remaining := len(h.donConfig.Members) - len(pending.responses)
+		for _, count := range pending.payloads {
+			if count+remaining >= h.donConfig.F+1 {
+				// not ready to be processed yet
+				return nil, pending, nil
+			}
+		}
+		return &handlers.UserCallbackPayload{Msg: pending.request, ErrCode: api.FatalError, ErrMsg: "nodes did not reach a quorum"}, pending, nil
+	case AggregationAll:
+		if len(pending.responses) < len(h.donConfig.Members) {
+			// not ready to be processed yet
+			return nil, pending, nil
+		}
+		var combined CombinedResponse
+		for _, member := range h.donConfig.Members {
+			if r, ok := pending.responses[strings.ToLower(member.Address)]; ok {
+				combined.NodeResponses = append(combined.NodeResponses, r)
+			}
+		}
+		payloadJson, err := json.Marshal(combined)
+		if err != nil {
+			return &handlers.UserCallbackPayload{Msg: pending.request, ErrCode: api.NodeReponseEncodingError, ErrMsg: err.Error()}, pending, nil
+		}
+		return newUserResponse(pending.request, payloadJson), pending, nil
+	default:
+		return nil, pending, fmt.Errorf("unknown aggregation %q", pending.aggregation)
+	}
+}
+
+func newUserResponse(request *api.Message, payload []byte) *handlers.UserCallbackPayload {
+	userResponse := *request
+	userResponse.Body.Receiver = request.Body.Sender
+	userResponse.Body.Payload = payload
+	return &handlers.UserCallbackPayload{Msg: &userResponse, ErrCode: api.NoError, ErrMsg: ""}
+}
+
+func (h *handler) Start(context.Context) error {
+	return h.StartOnce("RelayHandler", func() error {
+		h.lggr.Info("starting RelayHandler")
+		return nil
+	})
+}
+
+func (h *handler) Close() error {
+	return h.StopOnce("RelayHandler", func() error {
+		return nil
+	})
+}
@@ -0,0 +1,217 @@
+package relay_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	gc "github.com/smartcontractkit/chainlink/v2/core/services/gateway/common"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	hc "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
+	handlers_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/relay"
+)
+
+func newRelayHandlerForATestDON(t *testing.T, nodes []gc.TestNode, user gc.TestNode, userRateLimiter *hc.RateLimiter) (handlers.Handler, *handlers_mocks.DON) {
+	donConfig := &config.DONConfig{DonId: "don_id", F: 1}
+	for id, n := range nodes {
+		donConfig.Members = append(donConfig.Members, config.NodeConfig{
+			Name:    fmt.Sprintf("node_%d", id),
+			Address: n.Address,
+		})
+	}
+	cfg := relay.HandlerConfig{
+		AllowedUsers: []string{user.Address},
+		Methods: []relay.MethodConfig{
+			{Name: "first_method", Aggregation: relay.AggregationFirst},
+			{Name: "quorum_method"},
+			{Name: "all_method", Aggregation: relay.AggregationAll},
+		},
+	}
+	don := handlers_mocks.NewDON(t)
+	handler, err := relay.NewHandler(cfg, donConfig, don, hc.NewRequestCache[relay.PendingRequest](time.Hour, 1000), userRateLimiter, logger.TestLogger(t))
+	require.NoError(t, err)
+	return handler, don
+}
+
+func newSignedMessage(t *testing.T, id string, method string, payload string, node gc.TestNode, receiver string) api.Message {
+	msg := api.Message{
+		Body: api.MessageBody{
+			MessageId: id,
+			Method:    method,
+			DonId:     "don_id",
+			Receiver:  receiver,
+			Payload:   []byte(payload),
+		},
+	}
+	require.NoError(t, msg.Sign(node.PrivateKey))
+	require.NoError(t, msg.Validate())
+	return msg
+}
+
+// sendNodeResponses sends the payloads as responses of the first nodes to request, and returns the response to the
+// user if it is ready.
+func sendNodeResponses(t *testing.T, handler handlers.Handler, request api.Message, nodes []gc.TestNode, callbackCh chan handlers.UserCallbackPayload, payloads ...string) *handlers.UserCallbackPayload {
+	for id, payload := range payloads {
+		response := newSignedMessage(t, request.Body.MessageId, request.Body.Method, payload, nodes[id], request.Body.Sender)
+		_ = handler.HandleNodeMessage(testutils.Context(t), &response, nodes[id].Address)
+	}
+	select {
+	case response := <-callbackCh:
+		return &response
+	default:
+		return nil
+	}
+}
+
+func TestRelayHandler_NewHandlerFromConfig(t *testing.T) {
+	t.Parallel()
+
+	user := gc.NewTestNodes(t, 1)[0]
+	donConfig := &config.DONConfig{DonId: "don_id"}
+	lggr := logger.TestLogger(t)
+
+	handler, err := relay.NewHandlerFromConfig(json.RawMessage(fmt.Sprintf(`{
+		"allowedUsers": [%q],
+		"methods": [{"name": "my_method", "aggregation": "all"}],
+		"userRateLimiter": {"globalRPS": 10, "globalBurst": 10, "perSenderRPS": 1, "perSenderBurst": 1}
+	}`, user.Address)), donConfig, nil, lggr)
+	require.NoError(t, err)
+	servicetest.Run(t, handler)
+
+	for _, tt := range []struct {
+		name   string
+		config string
+		err    string
+	}{
+		{"no users", `{"methods": [{"name": "my_method"}]}`, "at least one allowed user must be configured"},
+		{"invalid user", `{"allowedUsers": ["0xnot_an_address"], "methods": [{"name": "my_method"}]}`, "invalid allowed user address 0xnot_an_address"},
+		{"no methods", fmt.Sprintf(`{"allowedUsers": [%q]}`, user.Address), "at least one method must be configured"},
+		{"duplicate method", fmt.Sprintf(`{"allowedUsers": [%q], "methods": [{"name": "m"}, {"name": "m"}]}`, user.Address), "duplicate method m"},
+		{"unknown aggregation", fmt.Sprintf(`{"allowedUsers": [%q], "methods": [{"name": "m", "aggregation": "median"}]}`, user.Address),
+			`unknown aggregation "median" of method m, must be "first", "quorum" or "all"`},
+		{"invalid rate limiter", fmt.Sprintf(`{"allowedUsers": [%q], "methods": [{"name": "m"}], "userRateLimiter": {}}`, user.Address), "RPS values must be positive"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := relay.NewHandlerFromConfig(json.RawMessage(tt.config), donConfig, nil, lggr)
+			require.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestRelayHandler_HandleUserMessage(t *testing.T) {
+	t.Parallel()
+
+	nodes, users := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 2)
+	user, stranger := users[0], users[1]
+	rateLimiter, err := hc.NewRateLimiter(hc.RateLimiterConfig{GlobalRPS: 100, GlobalBurst: 100, PerSenderRPS: 0.001, PerSenderBurst: 1})
+	require.NoError(t, err)
+	handler, don := newRelayHandlerForATestDON(t, nodes, user, rateLimiter)
+	ctx := testutils.Context(t)
+
+	msg := newSignedMessage(t, "1", "unknown_method", `{}`, user, "")
+	require.ErrorIs(t, handler.HandleUserMessage(ctx, &msg, make(chan handlers.UserCallbackPayload, 1)), relay.ErrUnsupportedMethod)
+
+	msg = newSignedMessage(t, "2", "first_method", `{}`, stranger, "")
+	require.ErrorIs(t, handler.HandleUserMessage(ctx, &msg, make(chan handlers.UserCallbackPayload, 1)), relay.ErrNotAllowlisted)
+
+	don.On("SendToNode", mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(len(nodes))
+	msg = newSignedMessage(t, "3", "first_method", `{}`, user, "")
+	require.NoError(t, handler.HandleUserMessage(ctx, &msg, make(chan handlers.UserCallbackPayload, 1)))
+
+	msg = newSignedMessage(t, "4", "first_method", `{}`, user, "")
+	require.ErrorIs(t, handler.HandleUserMessage(ctx, &msg, make(chan handlers.UserCallbackPayload, 1)), relay.ErrRateLimited)
+}
+
+func TestRelayHandler_Aggregation(t *testing.T) {
+	t.Parallel()
+
+	nodes, user := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 1)[0]
+
+	t.Run("first", func(t *testing.T) {
+		handler, don := newRelayHandlerForATestDON(t, nodes, user, nil)
+		don.On("SendToNode", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		request := newSignedMessage(t, "1", "first_method", `{"key":"value"}`, user, "")
+		callbackCh := make(chan handlers.UserCallbackPayload, 1)
+		require.NoError(t, handler.HandleUserMessage(testutils.Context(t), &request, callbackCh))
+
+		response := sendNodeResponses(t, handler, request, nodes, callbackCh, `{"result":1}`)
+		require.NotNil(t, response)
+		require.Equal(t, api.NoError, response.ErrCode)
+		assert.Equal(t, "1", response.Msg.Body.MessageId)
+		assert.Equal(t, user.Address, response.Msg.Body.Receiver)
+		assert.JSONEq(t, `{"result":1}`, string(response.Msg.Body.Payload))
+	})
+
+	t.Run("quorum", func(t *testing.T) {
+		handler, don := newRelayHandlerForATestDON(t, nodes, user, nil)
+		don.On("SendToNode", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		request := newSignedMessage(t, "2", "quorum_method", `{}`, user, "")
+		callbackCh := make(chan handlers.UserCallbackPayload, 1)
+		require.NoError(t, handler.HandleUserMessage(testutils.Context(t), &request, callbackCh))
+
+		response := sendNodeResponses(t, handler, request, nodes, callbackCh, `{"result":1}`, `{"result":2}`, `{"result":2}`)
+		require.NotNil(t, response)
+		require.Equal(t, api.NoError, response.ErrCode)
+		assert.JSONEq(t, `{"result":2}`, string(response.Msg.Body.Payload))
+	})
+
+	t.Run("no quorum", func(t *testing.T) {
+		handler, don := newRelayHandlerForATestDON(t, nodes, user, nil)
+		don.On("SendToNode", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		request := newSignedMessage(t, "3", "quorum_method", `{}`, user, "")
+		callbackCh := make(chan handlers.UserCallbackPayload, 1)
+		require.NoError(t, handler.HandleUserMessage(testutils.Context(t), &request, callbackCh))
+
+		// no payload was responded by F+1 = 2 nodes
+		response := sendNodeResponses(t, handler, request, nodes, callbackCh, `{"result":1}`, `{"result":2}`, `{"result":3}`, `{"result":4}`)
+		require.NotNil(t, response)
+		assert.Equal(t, api.FatalError, response.ErrCode)
+		assert.Equal(t, "nodes did not reach a quorum", response.ErrMsg)
+	})
+
+	t.Run("all", func(t *testing.T) {
+		handler, don := newRelayHandlerForATestDON(t, nodes, user, nil)
+		don.On("SendToNode", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		request := newSignedMessage(t, "4", "all_method", `{}`, user, "")
+		callbackCh := make(chan handlers.UserCallbackPayload, 1)
+		require.NoError(t, handler.HandleUserMessage(testutils.Context(t), &request, callbackCh))
+
+		require.Nil(t, sendNodeResponses(t, handler, request, nodes[:3], callbackCh, `{"result":1}`, `{"result":2}`, `{"result":3}`))
+		last := newSignedMessage(t, "4", "all_method", `{"result":4}`, nodes[3], user.Address)
+		require.NoError(t, handler.HandleNodeMessage(testutils.Context(t), &last, nodes[3].Address))
+
+		response := <-callbackCh
+		require.Equal(t, api.NoError, response.ErrCode)
+		var payload relay.CombinedResponse
+		require.NoError(t, json.Unmarshal(response.Msg.Body.Payload, &payload))
+		require.Len(t, payload.NodeResponses, 4)
+		for i, nodeResponse := range payload.NodeResponses {
+			assert.Equal(t, nodes[i].Address, nodeResponse.Body.Sender)
+			assert.JSONEq(t, fmt.Sprintf(`{"result":%d}`, i+1), string(nodeResponse.Body.Payload))
+		}
+	})
+
+	t.Run("duplicate response", func(t *testing.T) {
+		handler, don := newRelayHandlerForATestDON(t, nodes, user, nil)
+		don.On("SendToNode", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		request := newSignedMessage(t, "5", "quorum_method", `{}`, user, "")
+		callbackCh := make(chan handlers.UserCallbackPayload, 1)
+		require.NoError(t, handler.HandleUserMessage(testutils.Context(t), &request, callbackCh))
+
+		response := newSignedMessage(t, "5", "quorum_method", `{"result":1}`, nodes[0], user.Address)
+		require.NoError(t, handler.HandleNodeMessage(testutils.Context(t), &response, nodes[0].Address))
+		require.EqualError(t, handler.HandleNodeMessage(testutils.Context(t), &response, nodes[0].Address), "duplicate response")
+		assert.Empty(t, callbackCh)
+	})
+}
@@ -16,6 +16,8 @@ import (
 
 	sqlutil "github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 
+	time "time"
+
 	types "github.com/smartcontractkit/chainlink-evm/pkg/types"
 
 	uuid "github.com/google/uuid"
@@ -831,6 +833,65 @@ func (_c *ORM_FindJobIDsWithBridge_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// FindJobIDsWithFragment provides a mock function with given fields: ctx, name
+func (_m *ORM) FindJobIDsWithFragment(ctx context.Context, name string) ([]int32, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindJobIDsWithFragment")
+	}
+
+	var r0 []int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]int32, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []int32); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int32)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindJobIDsWithFragment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindJobIDsWithFragment'
+type ORM_FindJobIDsWithFragment_Call struct {
+	*mock.Call
+}
+
+// FindJobIDsWithFragment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *ORM_Expecter) FindJobIDsWithFragment(ctx interface{}, name interface{}) *ORM_FindJobIDsWithFragment_Call {
+	return &ORM_FindJobIDsWithFragment_Call{Call: _e.mock.On("FindJobIDsWithFragment", ctx, name)}
+}
+
+func (_c *ORM_FindJobIDsWithFragment_Call) Run(run func(ctx context.Context, name string)) *ORM_FindJobIDsWithFragment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ORM_FindJobIDsWithFragment_Call) Return(_a0 []int32, _a1 error) *ORM_FindJobIDsWithFragment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_FindJobIDsWithFragment_Call) RunAndReturn(run func(context.Context, string) ([]int32, error)) *ORM_FindJobIDsWithFragment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindJobWithoutSpecErrors provides a mock function with given fields: ctx, id
 func (_m *ORM) FindJobWithoutSpecErrors(ctx context.Context, id int32) (job.Job, error) {
 	ret := _m.Called(ctx, id)
@@ -1692,6 +1753,54 @@ func (_c *ORM_RecordError_Call) RunAndReturn(run func(context.Context, int32, st
 	return _c
 }
 
+// SetJobPaused provides a mock function with given fields: ctx, id, paused
+func (_m *ORM) SetJobPaused(ctx context.Context, id int32, paused bool) error {
+	ret := _m.Called(ctx, id, paused)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetJobPaused")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, bool) error); ok {
+		r0 = rf(ctx, id, paused)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_SetJobPaused_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobPaused'
+type ORM_SetJobPaused_Call struct {
+	*mock.Call
+}
+
+// SetJobPaused is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+//   - paused bool
+func (_e *ORM_Expecter) SetJobPaused(ctx interface{}, id interface{}, paused interface{}) *ORM_SetJobPaused_Call {
+	return &ORM_SetJobPaused_Call{Call: _e.mock.On("SetJobPaused", ctx, id, paused)}
+}
+
+func (_c *ORM_SetJobPaused_Call) Run(run func(ctx context.Context, id int32, paused bool)) *ORM_SetJobPaused_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *ORM_SetJobPaused_Call) Return(_a0 error) *ORM_SetJobPaused_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_SetJobPaused_Call) RunAndReturn(run func(context.Context, int32, bool) error) *ORM_SetJobPaused_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TryRecordError provides a mock function with given fields: ctx, jobID, description
 func (_m *ORM) TryRecordError(ctx context.Context, jobID int32, description string) {
 	_m.Called(ctx, jobID, description)
@@ -1727,6 +1836,54 @@ func (_c *ORM_TryRecordError_Call) RunAndReturn(run func(context.Context, int32,
 	return _c
 }
 
+// UpdateCronSpecLastFiredAt provides a mock function with given fields: ctx, id, firedAt
+func (_m *ORM) UpdateCronSpecLastFiredAt(ctx context.Context, id int32, firedAt time.Time) error {
+	ret := _m.Called(ctx, id, firedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCronSpecLastFiredAt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, time.Time) error); ok {
+		r0 = rf(ctx, id, firedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_UpdateCronSpecLastFiredAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCronSpecLastFiredAt'
+type ORM_UpdateCronSpecLastFiredAt_Call struct {
+	*mock.Call
+}
+
+// UpdateCronSpecLastFiredAt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+//   - firedAt time.Time
+func (_e *ORM_Expecter) UpdateCronSpecLastFiredAt(ctx interface{}, id interface{}, firedAt interface{}) *ORM_UpdateCronSpecLastFiredAt_Call {
+	return &ORM_UpdateCronSpecLastFiredAt_Call{Call: _e.mock.On("UpdateCronSpecLastFiredAt", ctx, id, firedAt)}
+}
+
+func (_c *ORM_UpdateCronSpecLastFiredAt_Call) Run(run func(ctx context.Context, id int32, firedAt time.Time)) *ORM_UpdateCronSpecLastFiredAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ORM_UpdateCronSpecLastFiredAt_Call) Return(_a0 error) *ORM_UpdateCronSpecLastFiredAt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_UpdateCronSpecLastFiredAt_Call) RunAndReturn(run func(context.Context, int32, time.Time) error) *ORM_UpdateCronSpecLastFiredAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithDataSource provides a mock function with given fields: source
 func (_m *ORM) WithDataSource(source sqlutil.DataSource) job.ORM {
 	ret := _m.Called(source)
@@ -304,6 +304,53 @@ func (_c *Spawner_Name_Call) RunAndReturn(run func() string) *Spawner_Name_Call
 	return _c
 }
 
+// PauseJob provides a mock function with given fields: ctx, jobID
+func (_m *Spawner) PauseJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PauseJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Spawner_PauseJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseJob'
+type Spawner_PauseJob_Call struct {
+	*mock.Call
+}
+
+// PauseJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *Spawner_Expecter) PauseJob(ctx interface{}, jobID interface{}) *Spawner_PauseJob_Call {
+	return &Spawner_PauseJob_Call{Call: _e.mock.On("PauseJob", ctx, jobID)}
+}
+
+func (_c *Spawner_PauseJob_Call) Run(run func(ctx context.Context, jobID int32)) *Spawner_PauseJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *Spawner_PauseJob_Call) Return(_a0 error) *Spawner_PauseJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Spawner_PauseJob_Call) RunAndReturn(run func(context.Context, int32) error) *Spawner_PauseJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Ready provides a mock function with no fields
 func (_m *Spawner) Ready() error {
 	ret := _m.Called()
@@ -349,6 +396,53 @@ func (_c *Spawner_Ready_Call) RunAndReturn(run func() error) *Spawner_Ready_Call
 	return _c
 }
 
+// ResumeJob provides a mock function with given fields: ctx, jobID
+func (_m *Spawner) ResumeJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Spawner_ResumeJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeJob'
+type Spawner_ResumeJob_Call struct {
+	*mock.Call
+}
+
+// ResumeJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *Spawner_Expecter) ResumeJob(ctx interface{}, jobID interface{}) *Spawner_ResumeJob_Call {
+	return &Spawner_ResumeJob_Call{Call: _e.mock.On("ResumeJob", ctx, jobID)}
+}
+
+func (_c *Spawner_ResumeJob_Call) Run(run func(ctx context.Context, jobID int32)) *Spawner_ResumeJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *Spawner_ResumeJob_Call) Return(_a0 error) *Spawner_ResumeJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Spawner_ResumeJob_Call) RunAndReturn(run func(context.Context, int32) error) *Spawner_ResumeJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Spawner) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
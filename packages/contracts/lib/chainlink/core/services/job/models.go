@@ -192,7 +192,18 @@ type Job struct {
 	Name                          null.String   `toml:"name"`
 	MaxTaskDuration               models.Interval
 	Pipeline                      pipeline.Pipeline `toml:"observationSource"`
-	CreatedAt                     time.Time
+	// Retention overrides the pruning of the pipeline runs of the job
+	Retention pipeline.RunRetention `toml:"retention"`
+	// Limits bounds the concurrency, rate and resources of the pipeline runs of the job
+	Limits    pipeline.RunLimits `toml:"limits"`
+	CreatedAt time.Time
+	// PausedAt is set while the job is paused, its services are not running
+	PausedAt null.Time `toml:"-"`
+}
+
+// IsPaused returns true if the services of the job have been stopped by an operator.
+func (j Job) IsPaused() bool {
+	return j.PausedAt.Valid
 }
 
 func ExternalJobIDEncodeStringToTopic(id uuid.UUID) common.Hash {
@@ -498,12 +509,45 @@ type DirectRequestSpec struct {
 	UpdatedAt                time.Time                `toml:"-"`
 }
 
+// CronCatchUpPolicy defines what a cron job does about the schedule ticks missed while it was not running.
+type CronCatchUpPolicy string
+
+const (
+	// CronCatchUpSkip ignores missed ticks.
+	CronCatchUpSkip CronCatchUpPolicy = "skip"
+	// CronCatchUpRunOnce runs once for the latest missed tick.
+	CronCatchUpRunOnce CronCatchUpPolicy = "runOnce"
+	// CronCatchUpRunAllMissed runs once for each missed tick, oldest first.
+	CronCatchUpRunAllMissed CronCatchUpPolicy = "runAllMissed"
+)
+
+// CronConcurrencyPolicy defines what a cron job does when a tick fires while a previous run is still executing.
+type CronConcurrencyPolicy string
+
+const (
+	// CronConcurrencyAllow runs concurrently with the previous run.
+	CronConcurrencyAllow CronConcurrencyPolicy = "allow"
+	// CronConcurrencyForbid skips the tick.
+	CronConcurrencyForbid CronConcurrencyPolicy = "forbid"
+	// CronConcurrencyReplace cancels the previous run.
+	CronConcurrencyReplace CronConcurrencyPolicy = "replace"
+)
+
 type CronSpec struct {
-	ID           int32     `toml:"-"`
-	CronSchedule string    `toml:"schedule"`
-	EVMChainID   *big.Big  `toml:"evmChainID"`
-	CreatedAt    time.Time `toml:"-"`
-	UpdatedAt    time.Time `toml:"-"`
+	ID           int32    `toml:"-"`
+	CronSchedule string   `toml:"schedule"`
+	EVMChainID   *big.Big `toml:"evmChainID"`
+	// CatchUpPolicy applies to the ticks missed since LastFiredAt, and within CatchUpWindow of the start of the job
+	// if it is set.
+	CatchUpPolicy     CronCatchUpPolicy     `toml:"catchUpPolicy"`
+	CatchUpWindow     models.Interval       `toml:"catchUpWindow"`
+	ConcurrencyPolicy CronConcurrencyPolicy `toml:"concurrencyPolicy"`
+	// Jitter is the maximum random delay of runs after their tick.
+	Jitter models.Interval `toml:"jitter"`
+	// LastFiredAt is the latest tick the job fired for.
+	LastFiredAt null.Time `toml:"-"`
+	CreatedAt   time.Time `toml:"-"`
+	UpdatedAt   time.Time `toml:"-"`
 }
 
 func (s CronSpec) GetID() string {
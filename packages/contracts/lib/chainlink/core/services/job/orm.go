@@ -51,7 +51,13 @@ type ORM interface {
 	FindJobIDByAddress(ctx context.Context, address evmtypes.EIP55Address, evmChainID *big.Big) (int32, error)
 	FindOCR2JobIDByAddress(ctx context.Context, contractID string, feedID *common.Hash) (int32, error)
 	FindJobIDsWithBridge(ctx context.Context, name string) ([]int32, error)
+	// FindJobIDsWithFragment returns the IDs of the jobs invoking any version of the named pipeline fragment.
+	FindJobIDsWithFragment(ctx context.Context, name string) ([]int32, error)
 	DeleteJob(ctx context.Context, id int32, jobType Type) error
+	// SetJobPaused records whether the services of a job should be running, it returns sql.ErrNoRows if the job does not exist.
+	SetJobPaused(ctx context.Context, id int32, paused bool) error
+	// UpdateCronSpecLastFiredAt records the latest schedule tick a cron job fired for, it returns sql.ErrNoRows if the spec does not exist.
+	UpdateCronSpecLastFiredAt(ctx context.Context, id int32, firedAt time.Time) error
 	RecordError(ctx context.Context, jobID int32, description string) error
 	// TryRecordError is a helper which calls RecordError and logs the returned error if present.
 	TryRecordError(ctx context.Context, jobID int32, description string)
@@ -175,6 +181,12 @@ func (o *orm) CreateJob(ctx context.Context, jb *Job) error {
 	if err := o.AssertBridgesExist(ctx, p); err != nil {
 		return err
 	}
+	if err := jb.Retention.Validate(); err != nil {
+		return errors.Wrap(err, "invalid retention")
+	}
+	if err := jb.Limits.Validate(); err != nil {
+		return errors.Wrap(err, "invalid limits")
+	}
 
 	var jobID int32
 	err := o.transact(ctx, false, func(tx *orm) error {
@@ -577,8 +589,8 @@ func (o *orm) insertKeeperSpec(ctx context.Context, spec *KeeperSpec) (specID in
 }
 
 func (o *orm) insertCronSpec(ctx context.Context, spec *CronSpec) (specID int32, err error) {
-	return o.prepareQuerySpecID(ctx, `INSERT INTO cron_specs (cron_schedule, evm_chain_id, created_at, updated_at)
-			VALUES (:cron_schedule, :evm_chain_id, NOW(), NOW())
+	return o.prepareQuerySpecID(ctx, `INSERT INTO cron_specs (cron_schedule, evm_chain_id, catch_up_policy, catch_up_window, concurrency_policy, jitter, created_at, updated_at)
+			VALUES (:cron_schedule, :evm_chain_id, :catch_up_policy, :catch_up_window, :concurrency_policy, :jitter, NOW(), NOW())
 			RETURNING id;`, spec)
 }
 
@@ -731,18 +743,18 @@ func (o *orm) InsertJob(ctx context.Context, job *Job) error {
 		if job.ID == 0 {
 			query = `INSERT INTO jobs (name, stream_id, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
 				keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, block_header_feeder_spec_id, gateway_spec_id,
-                legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, external_job_id, gas_limit, forwarding_allowed, created_at)
+                legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, external_job_id, gas_limit, forwarding_allowed, retention, limits, paused_at, created_at)
 		VALUES (:name, :stream_id, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
 				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :block_header_feeder_spec_id, :gateway_spec_id,
-				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, NOW())
+				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, :retention, :limits, :paused_at, NOW())
 		RETURNING *;`
 		} else {
 			query = `INSERT INTO jobs (id, name, stream_id, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
 			keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, block_header_feeder_spec_id, gateway_spec_id,
-                  legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, external_job_id, gas_limit, forwarding_allowed, created_at)
+                  legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, external_job_id, gas_limit, forwarding_allowed, retention, limits, paused_at, created_at)
 		VALUES (:id, :name, :stream_id, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
 				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :block_header_feeder_spec_id, :gateway_spec_id,
-				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, NOW())
+				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, :retention, :limits, :paused_at, NOW())
 		RETURNING *;`
 		}
 		query, args, err := tx.ds.BindNamed(query, job)
@@ -883,6 +895,40 @@ func (o *orm) FindSpecError(ctx context.Context, id int64) (SpecError, error) {
 	return *specErr, errors.Wrap(err, "FindSpecError failed")
 }
 
+func (o *orm) SetJobPaused(ctx context.Context, id int32, paused bool) error {
+	// Pausing an already paused job keeps the original paused_at
+	stmt := `UPDATE jobs SET paused_at = CASE WHEN $2 THEN COALESCE(paused_at, NOW()) ELSE NULL END WHERE id = $1`
+	res, err := o.ds.ExecContext(ctx, stmt, id, paused)
+	if err != nil {
+		return errors.Wrap(err, "SetJobPaused failed")
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "SetJobPaused failed")
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (o *orm) UpdateCronSpecLastFiredAt(ctx context.Context, id int32, firedAt time.Time) error {
+	// Ticks fired out of order never move last_fired_at backwards
+	stmt := `UPDATE cron_specs SET last_fired_at = GREATEST(last_fired_at, $2), updated_at = NOW() WHERE id = $1`
+	res, err := o.ds.ExecContext(ctx, stmt, id, firedAt)
+	if err != nil {
+		return errors.Wrap(err, "UpdateCronSpecLastFiredAt failed")
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "UpdateCronSpecLastFiredAt failed")
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
 func (o *orm) FindJobs(ctx context.Context, offset, limit int) (jobs []Job, count int, err error) {
 	err = o.transact(ctx, false, func(tx *orm) error {
 		sql := `SELECT count(*) FROM jobs;`
@@ -1146,6 +1192,51 @@ func (o *orm) FindJobIDsWithBridge(ctx context.Context, name string) (jids []int
 	return
 }
 
+func (o *orm) FindJobIDsWithFragment(ctx context.Context, name string) (jids []int32, err error) {
+	query := `SELECT
+			jobs.id, pipeline_specs.dot_dag_source
+		FROM jobs
+		    JOIN job_pipeline_specs ON job_pipeline_specs.job_id = jobs.id
+		    JOIN pipeline_specs ON pipeline_specs.id = job_pipeline_specs.pipeline_spec_id
+		WHERE pipeline_specs.dot_dag_source ILIKE '%' || $1 || '%' ORDER BY id`
+	var rows *sqlx.Rows
+	rows, err = o.ds.QueryxContext(ctx, query, name)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+	var ids []int32
+	var sources []string
+	for rows.Next() {
+		var id int32
+		var source string
+		if err = rows.Scan(&id, &source); err != nil {
+			return
+		}
+		ids = append(ids, id)
+		sources = append(sources, source)
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	for i, id := range ids {
+		var p *pipeline.Pipeline
+		p, err = pipeline.Parse(sources[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse dag for job %d", id)
+		}
+		for _, task := range p.Tasks {
+			if fragment, ok := pipeline.InvokedFragment(task); ok && fragment == name {
+				jids = append(jids, id)
+				break
+			}
+		}
+	}
+
+	return
+}
+
 func (o *orm) FindJobIDByWorkflow(ctx context.Context, spec WorkflowSpec) (jobID int32, err error) {
 	stmt := `
 SELECT jobs.id FROM jobs
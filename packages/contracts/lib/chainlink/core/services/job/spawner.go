@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"slices"
 	"sync"
 
 	pkgerrors "github.com/pkg/errors"
@@ -23,11 +24,16 @@ type (
 	Spawner interface {
 		services.Service
 
-		// CreateJob creates a new job and starts services.
+		// CreateJob creates a new job and starts services, unless the job is paused.
 		// All services must start without errors for the job to be active.
 		CreateJob(ctx context.Context, ds sqlutil.DataSource, jb *Job) (err error)
 		// DeleteJob deletes a job and stops any active services.
 		DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID int32) error
+		// PauseJob stops the services of a job, keeping its spec and run history.
+		// The job remains paused across restarts until ResumeJob is called.
+		PauseJob(ctx context.Context, jobID int32) error
+		// ResumeJob starts the services of a paused job.
+		ResumeJob(ctx context.Context, jobID int32) error
 		// ActiveJobs returns a map of jobs with active services (started without error).
 		ActiveJobs() map[int32]Job
 
@@ -54,6 +60,8 @@ type (
 
 		chStop              services.StopChan
 		lbDependentAwaiters []utils.DependentAwaiter
+		// onJobStopped is called with the ID of each job whose services are stopped
+		onJobStopped []func(jobID int32)
 	}
 
 	// TODO(spook): I can't wait for Go generics
@@ -101,6 +109,12 @@ func NewSpawner(orm ORM, config Config, checker Checker, jobTypeDelegates map[Ty
 }
 
 // Start starts Spawner.
+// OnJobStopped registers fn to be called with the ID of each job once its services are stopped, when it is deleted,
+// paused or the spawner is closed. It must be called before the spawner is started.
+func (js *spawner) OnJobStopped(fn func(jobID int32)) {
+	js.onJobStopped = append(js.onJobStopped, fn)
+}
+
 func (js *spawner) Start(ctx context.Context) error {
 	return js.StartOnce("JobSpawner", func() error {
 		js.startAllServices(ctx)
@@ -134,6 +148,18 @@ func (js *spawner) startAllServices(ctx context.Context) {
 		return
 	}
 
+	var pausedJobIDs []int32
+	jbs = slices.DeleteFunc(jbs, func(jb Job) bool {
+		if jb.IsPaused() {
+			pausedJobIDs = append(pausedJobIDs, jb.ID)
+			return true
+		}
+		return false
+	})
+	if len(pausedJobIDs) > 0 {
+		js.lggr.Infow("Not starting paused jobs", "jobIDs", pausedJobIDs)
+	}
+
 	jobIDs := make([]int32, len(jbs))
 	for i, jb := range jbs {
 		jobIDs[i] = jb.ID
@@ -197,6 +223,9 @@ func (js *spawner) stopService(jobID int32) {
 	}
 
 	delete(js.activeJobs, jobID)
+	for _, fn := range js.onJobStopped {
+		fn(jobID)
+	}
 }
 
 func (js *spawner) StartService(ctx context.Context, jb Job) error {
@@ -219,6 +248,7 @@ func (js *spawner) StartService(ctx context.Context, jb Job) error {
 	jb.PipelineSpec.JobID = jb.ID
 	jb.PipelineSpec.JobType = string(jb.Type)
 	jb.PipelineSpec.ForwardingAllowed = jb.ForwardingAllowed
+	jb.PipelineSpec.Limits = jb.Limits
 	if jb.GasLimit.Valid {
 		jb.PipelineSpec.GasLimit = &jb.GasLimit.Uint32
 	}
@@ -274,11 +304,16 @@ func (js *spawner) CreateJob(ctx context.Context, ds sqlutil.DataSource, jb *Job
 	js.lggr.Infow("Created job", "type", jb.Type, "jobID", jb.ID)
 
 	delegate.BeforeJobCreated(*jb)
-	err = js.StartService(ctx, *jb)
-	if err != nil {
-		js.lggr.Errorw("Error starting job services", "type", jb.Type, "jobID", jb.ID, "err", err)
+	if jb.IsPaused() {
+		// e.g. a paused job which was updated, its services are started by ResumeJob
+		js.lggr.Infow("Not starting paused job", "type", jb.Type, "jobID", jb.ID)
 	} else {
-		js.lggr.Infow("Started job services", "type", jb.Type, "jobID", jb.ID)
+		err = js.StartService(ctx, *jb)
+		if err != nil {
+			js.lggr.Errorw("Error starting job services", "type", jb.Type, "jobID", jb.ID, "err", err)
+		} else {
+			js.lggr.Infow("Started job services", "type", jb.Type, "jobID", jb.ID)
+		}
 	}
 
 	delegate.AfterJobCreated(*jb)
@@ -351,6 +386,60 @@ func (js *spawner) DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID i
 	return err
 }
 
+// Should not get called before Start()
+func (js *spawner) PauseJob(ctx context.Context, jobID int32) error {
+	lggr := js.lggr.With("jobID", jobID)
+
+	if err := js.orm.SetJobPaused(ctx, jobID, true); err != nil {
+		return pkgerrors.Wrapf(err, "failed to pause job %d", jobID)
+	}
+
+	js.activeJobsMu.RLock()
+	_, exists := js.activeJobs[jobID]
+	js.activeJobsMu.RUnlock()
+
+	if exists {
+		js.stopService(jobID)
+	}
+	lggr.Infow("Paused job")
+
+	return nil
+}
+
+// Should not get called before Start()
+func (js *spawner) ResumeJob(ctx context.Context, jobID int32) error {
+	lggr := js.lggr.With("jobID", jobID)
+
+	js.activeJobsMu.RLock()
+	_, exists := js.activeJobs[jobID]
+	js.activeJobsMu.RUnlock()
+
+	if exists {
+		lggr.Debugw("Job services are already running")
+	} else {
+		jb, err := js.orm.FindJob(ctx, jobID)
+		if err != nil {
+			return pkgerrors.Wrapf(err, "failed to load job %d", jobID)
+		}
+		// The job is only recorded as resumed once its services are running, so that it stays paused if they fail
+		if err = js.StartService(ctx, jb); err != nil {
+			// StartService keeps jobs whose services failed to start as active
+			js.stopService(jobID)
+			return err
+		}
+	}
+
+	if err := js.orm.SetJobPaused(ctx, jobID, false); err != nil {
+		if !exists {
+			js.stopService(jobID)
+		}
+		return pkgerrors.Wrapf(err, "failed to resume job %d", jobID)
+	}
+	lggr.Infow("Resumed job")
+
+	return nil
+}
+
 func (js *spawner) ActiveJobs() map[int32]Job {
 	js.activeJobsMu.RLock()
 	defer js.activeJobsMu.RUnlock()
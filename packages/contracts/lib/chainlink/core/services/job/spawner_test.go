@@ -2,6 +2,8 @@ package job_test
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"testing"
 	"time"
 
@@ -10,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/jmoiron/sqlx"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/loop"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
@@ -37,6 +40,9 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	evmrelayer "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+	webhookmocks "github.com/smartcontractkit/chainlink/v2/core/services/webhook/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/v2/plugins"
 )
 
@@ -242,6 +248,8 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, map[job.Type]job.Delegate{
 			jobA.Type: delegateA,
 		}, lggr, nil)
+		stopped := make(chan int32, 1)
+		spawner.OnJobStopped(func(jobID int32) { stopped <- jobID })
 
 		ctx := testutils.Context(t)
 		err := orm.CreateJob(ctx, jobA)
@@ -269,6 +277,7 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		require.NoError(t, err)
 
 		eventuallyClose.AwaitOrFail(t)
+		assert.Equal(t, jobSpecIDA, <-stopped)
 
 		// Wait for the claim lock to be released
 		require.Eventually(t, func() bool {
@@ -280,6 +289,147 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		clearDB(t, db)
 	})
 
+	t.Run("stops and restarts job services on 'PauseJob()' and 'ResumeJob()'", func(t *testing.T) {
+		jobA := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+
+		serviceA1 := mocks.NewServiceCtx(t)
+		serviceA2 := mocks.NewServiceCtx(t)
+		serviceA1.On("Start", mock.Anything).Return(nil).Once()
+		serviceA2.On("Start", mock.Anything).Return(nil).Once()
+
+		lggr := logger.TestLogger(t)
+		orm := NewTestORM(t, db, pipeline.NewORM(db, lggr, config.JobPipeline().MaxSuccessfulRuns()), bridges.NewORM(db), keyStore)
+		mailMon := servicetest.Run(t, mailboxtest.NewMonitor(t))
+		d := ocr.NewDelegate(nil, orm, nil, nil, nil, nil, monitoringEndpoint, legacyChains, logger.TestLogger(t), config, mailMon)
+		delegateA := &delegate{jobA.Type, []job.ServiceCtx{serviceA1, serviceA2}, 0, nil, d}
+		delegates := map[job.Type]job.Delegate{jobA.Type: delegateA}
+		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, delegates, lggr, nil)
+
+		ctx := testutils.Context(t)
+		err := orm.CreateJob(ctx, jobA)
+		require.NoError(t, err)
+		delegateA.jobID = jobA.ID
+
+		require.NoError(t, spawner.Start(ctx))
+		require.Contains(t, spawner.ActiveJobs(), jobA.ID)
+
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once()
+		require.NoError(t, spawner.PauseJob(ctx, jobA.ID))
+		require.NotContains(t, spawner.ActiveJobs(), jobA.ID)
+
+		jb, err := orm.FindJob(ctx, jobA.ID)
+		require.NoError(t, err)
+		assert.True(t, jb.IsPaused())
+
+		// Pausing is idempotent
+		require.NoError(t, spawner.PauseJob(ctx, jobA.ID))
+
+		// Paused jobs are not started with the node
+		require.NoError(t, spawner.Close())
+		spawner = job.NewSpawner(orm, config.Database(), noopChecker{}, delegates, lggr, nil)
+		require.NoError(t, spawner.Start(ctx))
+		defer func() { assert.NoError(t, spawner.Close()) }()
+		require.Empty(t, spawner.ActiveJobs())
+
+		serviceA1.On("Start", mock.Anything).Return(nil).Once()
+		serviceA2.On("Start", mock.Anything).Return(nil).Once()
+		require.NoError(t, spawner.ResumeJob(ctx, jobA.ID))
+		require.Contains(t, spawner.ActiveJobs(), jobA.ID)
+
+		jb, err = orm.FindJob(ctx, jobA.ID)
+		require.NoError(t, err)
+		assert.False(t, jb.IsPaused())
+
+		require.ErrorIs(t, spawner.PauseJob(ctx, jobA.ID+1000), sql.ErrNoRows)
+
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once()
+
+		clearDB(t, db)
+	})
+
+	t.Run("creates paused jobs without starting them and keeps them paused if 'ResumeJob()' fails", func(t *testing.T) {
+		jobA := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+		jobA.PausedAt = null.TimeFrom(time.Now())
+
+		serviceA1 := mocks.NewServiceCtx(t)
+		serviceA2 := mocks.NewServiceCtx(t)
+
+		lggr := logger.TestLogger(t)
+		orm := NewTestORM(t, db, pipeline.NewORM(db, lggr, config.JobPipeline().MaxSuccessfulRuns()), bridges.NewORM(db), keyStore)
+		mailMon := servicetest.Run(t, mailboxtest.NewMonitor(t))
+		d := ocr.NewDelegate(nil, orm, nil, nil, nil, nil, monitoringEndpoint, legacyChains, logger.TestLogger(t), config, mailMon)
+		delegateA := &delegate{jobA.Type, []job.ServiceCtx{serviceA1, serviceA2}, 0, nil, d}
+		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, map[job.Type]job.Delegate{jobA.Type: delegateA}, lggr, nil)
+
+		ctx := testutils.Context(t)
+		require.NoError(t, spawner.Start(ctx))
+		defer func() { assert.NoError(t, spawner.Close()) }()
+
+		require.NoError(t, spawner.CreateJob(ctx, nil, jobA))
+		delegateA.jobID = jobA.ID
+		require.NotContains(t, spawner.ActiveJobs(), jobA.ID)
+		jb, err := orm.FindJob(ctx, jobA.ID)
+		require.NoError(t, err)
+		assert.True(t, jb.IsPaused())
+
+		serviceA1.On("Start", mock.Anything).Return(errors.New("failed to start")).Once()
+		require.ErrorContains(t, spawner.ResumeJob(ctx, jobA.ID), "failed to start")
+		require.NotContains(t, spawner.ActiveJobs(), jobA.ID)
+		jb, err = orm.FindJob(ctx, jobA.ID)
+		require.NoError(t, err)
+		assert.True(t, jb.IsPaused())
+
+		serviceA1.On("Start", mock.Anything).Return(nil).Once()
+		serviceA2.On("Start", mock.Anything).Return(nil).Once()
+		require.NoError(t, spawner.ResumeJob(ctx, jobA.ID))
+		require.Contains(t, spawner.ActiveJobs(), jobA.ID)
+		jb, err = orm.FindJob(ctx, jobA.ID)
+		require.NoError(t, err)
+		assert.False(t, jb.IsPaused())
+
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once()
+
+		clearDB(t, db)
+	})
+
+	t.Run("notifies external initiators of paused webhook jobs which are updated and resumed", func(t *testing.T) {
+		lggr := logger.TestLogger(t)
+		orm := NewTestORM(t, db, pipeline.NewORM(db, lggr, config.JobPipeline().MaxSuccessfulRuns()), bridges.NewORM(db), keyStore)
+		eiManager := webhookmocks.NewExternalInitiatorManager(t)
+		d := webhook.NewDelegate(nil, eiManager, lggr)
+		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, map[job.Type]job.Delegate{job.Webhook: d}, lggr, nil)
+
+		ctx := testutils.Context(t)
+		require.NoError(t, spawner.Start(ctx))
+		defer func() { assert.NoError(t, spawner.Close()) }()
+
+		jb, err := webhook.ValidatedWebhookSpec(ctx, testspecs.GenerateWebhookSpec(testspecs.WebhookSpecParams{}).Toml(), eiManager)
+		require.NoError(t, err)
+		eiManager.On("Notify", mock.Anything, mock.Anything).Return(nil).Once()
+		require.NoError(t, spawner.CreateJob(ctx, nil, &jb))
+		require.NoError(t, spawner.PauseJob(ctx, jb.ID))
+
+		// Updated as by the jobs controller, which deletes and creates the job again
+		eiManager.On("DeleteJob", mock.Anything, *jb.WebhookSpecID).Return(nil).Once()
+		require.NoError(t, spawner.DeleteJob(ctx, nil, jb.ID))
+		updated, err := webhook.ValidatedWebhookSpec(ctx, testspecs.GenerateWebhookSpec(testspecs.WebhookSpecParams{}).Toml(), eiManager)
+		require.NoError(t, err)
+		updated.ID = jb.ID
+		updated.PausedAt = null.TimeFrom(time.Now())
+		eiManager.On("Notify", mock.Anything, mock.Anything).Return(nil).Once()
+		require.NoError(t, spawner.CreateJob(ctx, nil, &updated))
+		require.NotContains(t, spawner.ActiveJobs(), updated.ID)
+
+		require.NoError(t, spawner.ResumeJob(ctx, updated.ID))
+		require.Contains(t, spawner.ActiveJobs(), updated.ID)
+		eiManager.AssertCalled(t, "Notify", mock.Anything, *updated.WebhookSpecID)
+
+		clearDB(t, db)
+	})
+
 	t.Run("Unregisters filters on 'DeleteJob()'", func(t *testing.T) {
 		config = configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
 			c.Feature.LogPoller = func(b bool) *bool { return &b }(true)
@@ -2,8 +2,6 @@ package keystore
 
 import (
 	"context"
-	"crypto"
-	"crypto/rand"
 	"fmt"
 
 	"github.com/pkg/errors"
@@ -52,7 +50,7 @@ func (c CSASigner) Sign(ctx context.Context, account string, data []byte) (signe
 	if data == nil {
 		return nil, nil
 	}
-	return k.Sign(rand.Reader, data, crypto.Hash(0))
+	return k.SignContext(ctx, data)
 }
 
 type csa struct {
@@ -85,6 +83,9 @@ func (ks *csa) GetAll() (keys []csakey.KeyV2, _ error) {
 	for _, key := range ks.keyRing.CSA {
 		keys = append(keys, key)
 	}
+	for _, key := range ks.remote.csa {
+		keys = append(keys, key)
+	}
 	return keys, nil
 }
 
@@ -97,7 +98,7 @@ func (ks *csa) Create(ctx context.Context) (csakey.KeyV2, error) {
 	// Ensure you can only have one CSA at a time. This is a temporary
 	// restriction until we are able to handle multiple CSA keys in the
 	// communication channel
-	if len(ks.keyRing.CSA) > 0 {
+	if len(ks.keyRing.CSA)+len(ks.remote.csa) > 0 {
 		return csakey.KeyV2{}, ErrCSAKeyExists
 	}
 	key, err := csakey.NewV2()
@@ -113,7 +114,7 @@ func (ks *csa) Add(ctx context.Context, key csakey.KeyV2) error {
 	if ks.isLocked() {
 		return ErrLocked
 	}
-	if len(ks.keyRing.CSA) > 0 {
+	if len(ks.keyRing.CSA)+len(ks.remote.csa) > 0 {
 		return ErrCSAKeyExists
 	}
 	return ks.safeAddKey(ctx, key)
@@ -129,6 +130,9 @@ func (ks *csa) Delete(ctx context.Context, id string) (csakey.KeyV2, error) {
 	if err != nil {
 		return csakey.KeyV2{}, err
 	}
+	if key.IsRemote() {
+		return csakey.KeyV2{}, errors.Errorf("key %s is held by the remote signer and cannot be deleted", id)
+	}
 
 	err = ks.safeRemoveKey(ctx, key)
 
@@ -172,7 +176,7 @@ func (ks *csa) EnsureKey(ctx context.Context) error {
 		return ErrLocked
 	}
 
-	if len(ks.keyRing.CSA) > 0 {
+	if len(ks.keyRing.CSA)+len(ks.remote.csa) > 0 {
 		return nil
 	}
 
@@ -188,6 +192,9 @@ func (ks *csa) EnsureKey(ctx context.Context) error {
 
 func (ks *csa) getByID(id string) (csakey.KeyV2, error) {
 	key, found := ks.keyRing.CSA[id]
+	if !found {
+		key, found = ks.remote.csa[id]
+	}
 	if !found {
 		return csakey.KeyV2{}, KeyNotFoundError{ID: id, KeyType: "CSA"}
 	}
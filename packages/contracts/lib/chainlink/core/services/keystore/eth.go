@@ -78,7 +78,7 @@ func (e *EthSigner) Sign(ctx context.Context, account string, data []byte) (sign
 	if data == nil {
 		return nil, nil
 	}
-	return k.Sign(data)
+	return k.SignContext(ctx, data)
 }
 
 type eth struct {
@@ -137,6 +137,9 @@ func (ks *eth) getAll(ctx context.Context) (keys []ethkey.KeyV2) {
 	for _, key := range ks.keyRing.Eth {
 		keys = append(keys, key)
 	}
+	for _, key := range ks.remote.eth {
+		keys = append(keys, key)
+	}
 	sort.Slice(keys, func(i, j int) bool { return keys[i].Cmp(keys[j]) < 0 })
 	return
 }
@@ -175,6 +178,16 @@ func (ks *eth) EnsureKeys(ctx context.Context, chainIDs ...*big.Int) (err error)
 		if len(keys) > 0 {
 			continue
 		}
+		if len(ks.remote.eth) > 0 {
+			// keys held by the remote signer are enabled instead of creating a new key in the node
+			for _, key := range ks.remote.eth {
+				if err = ks.addKey(ctx, nil, key.Address, chainID); err != nil {
+					return fmt.Errorf("failed to add remote key %s for chain %s: %w", key.Address, chainID, err)
+				}
+				ks.logger.Infow("Enabled remote EVM key with ID "+key.Address.Hex(), "address", key.Address.Hex(), "evmChainID", chainID)
+			}
+			continue
+		}
 		newKey, err := ethkey.NewV2()
 		if err != nil {
 			return err
@@ -226,8 +239,7 @@ func (ks *eth) Export(ctx context.Context, id string, password string) ([]byte,
 func (ks *eth) Add(ctx context.Context, address common.Address, chainID *big.Int) error {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
-	_, found := ks.keyRing.Eth[address.Hex()]
-	if !found {
+	if !ks.hasKey(address.Hex()) {
 		return ErrKeyNotFound
 	}
 	return ks.addKey(ctx, nil, address, chainID)
@@ -255,8 +267,7 @@ func (ks *eth) addKey(ctx context.Context, ds sqlutil.DataSource, address common
 func (ks *eth) Enable(ctx context.Context, address common.Address, chainID *big.Int) error {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
-	_, found := ks.keyRing.Eth[address.Hex()]
-	if !found {
+	if !ks.hasKey(address.Hex()) {
 		return ErrKeyNotFound
 	}
 	return ks.enable(ctx, address, chainID)
@@ -283,8 +294,7 @@ func (ks *eth) enable(ctx context.Context, address common.Address, chainID *big.
 func (ks *eth) Disable(ctx context.Context, address common.Address, chainID *big.Int) error {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
-	_, found := ks.keyRing.Eth[address.Hex()]
-	if !found {
+	if !ks.hasKey(address.Hex()) {
 		return errors.Errorf("no key exists with ID %s", address.Hex())
 	}
 	return ks.disable(ctx, address, chainID)
@@ -317,6 +327,9 @@ func (ks *eth) Delete(ctx context.Context, id string) (ethkey.KeyV2, error) {
 	if err != nil {
 		return ethkey.KeyV2{}, err
 	}
+	if key.IsRemote() {
+		return ethkey.KeyV2{}, errors.Errorf("key %s is held by the remote signer and cannot be deleted", id)
+	}
 	err = ks.safeRemoveKey(ctx, key, func(ds sqlutil.DataSource) error {
 		_, err2 := ds.ExecContext(ctx, `DELETE FROM evm.key_states WHERE address = $1`, key.Address)
 		return err2
@@ -399,14 +412,7 @@ func (ks *eth) CheckEnabled(ctx context.Context, address common.Address, chainID
 	if ks.isLocked() {
 		return ErrLocked
 	}
-	var found bool
-	for _, k := range ks.keyRing.Eth {
-		if k.Address == address {
-			found = true
-			break
-		}
-	}
-	if !found {
+	if !ks.hasKey(address.Hex()) {
 		return errors.Errorf("no eth key exists with address %s", address.String())
 	}
 	states := ks.keyStates.KeyIDChainID[address.String()]
@@ -528,12 +534,21 @@ func (ks *eth) XXXTestingOnlyAdd(ctx context.Context, key ethkey.KeyV2) {
 // caller must hold lock!
 func (ks *eth) getByID(id string) (ethkey.KeyV2, error) {
 	key, found := ks.keyRing.Eth[id]
+	if !found {
+		key, found = ks.remote.eth[id]
+	}
 	if !found {
 		return ethkey.KeyV2{}, ErrKeyNotFound
 	}
 	return key, nil
 }
 
+// caller must hold lock!
+func (ks *eth) hasKey(id string) bool {
+	_, err := ks.getByID(id)
+	return err == nil
+}
+
 // caller must hold lock!
 func (ks *eth) enabledKeysForChain(chainID *big.Int) (keys []ethkey.KeyV2) {
 	return ks.keysForChain(chainID, false)
@@ -547,7 +562,10 @@ func (ks *eth) keysForChain(chainID *big.Int, includeDisabled bool) (keys []ethk
 	}
 	for keyID, state := range states {
 		if includeDisabled || !state.Disabled {
-			k := ks.keyRing.Eth[keyID]
+			k, err := ks.getByID(keyID)
+			if err != nil {
+				continue
+			}
 			keys = append(keys, k)
 		}
 	}
@@ -18,8 +18,8 @@ func mustNewEthKey(t *testing.T) *ethkey.KeyV2 {
 	return &key
 }
 
-func ExposedNewMaster(t *testing.T, ds sqlutil.DataSource) *master {
-	return newMaster(ds, utils.FastScryptParams, logger.TestLogger(t))
+func ExposedNewMaster(t *testing.T, ds sqlutil.DataSource, opts ...Option) *master {
+	return newMaster(ds, utils.FastScryptParams, logger.TestLogger(t), opts...)
 }
 
 func (m *master) ExportedSave(ctx context.Context) error {
@@ -1,6 +1,8 @@
 package csakey
 
 import (
+	"errors"
+
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/internal"
@@ -22,6 +24,9 @@ func FromEncryptedJSON(keyJSON []byte, password string) (KeyV2, error) {
 }
 
 func (k KeyV2) ToEncryptedJSON(password string, scryptParams utils.ScryptParams) (export []byte, err error) {
+	if k.IsRemote() {
+		return nil, errors.New("cannot export a key held by a remote signer")
+	}
 	return internal.ToEncryptedJSON(
 		keyTypeIdentifier,
 		k,
@@ -1,6 +1,7 @@
 package csakey
 
 import (
+	"context"
 	"crypto"
 	"crypto/ed25519"
 	cryptorand "crypto/rand"
@@ -24,8 +25,9 @@ func KeyFor(raw internal.Raw) KeyV2 {
 }
 
 type KeyV2 struct {
-	raw    internal.Raw
-	signer crypto.Signer
+	raw        internal.Raw
+	signer     crypto.Signer
+	remoteSign func(ctx context.Context, message []byte) ([]byte, error)
 
 	PublicKey ed25519.PublicKey
 	Version   int
@@ -39,6 +41,15 @@ func (k KeyV2) StaticSizedPublicKey() (sspk credentials.StaticSizedPublicKey) {
 	return sspk
 }
 
+// NewRemoteV2 returns a key held outside of the node, signing messages with sign.
+func NewRemoteV2(publicKey ed25519.PublicKey, sign func(ctx context.Context, message []byte) ([]byte, error)) KeyV2 {
+	return KeyV2{
+		remoteSign: sign,
+		PublicKey:  publicKey,
+		Version:    2,
+	}
+}
+
 func NewV2() (KeyV2, error) {
 	pubKey, privKey, err := ed25519.GenerateKey(cryptorand.Reader)
 	if err != nil {
@@ -72,6 +83,11 @@ func (k KeyV2) PublicKeyString() string {
 	return hex.EncodeToString(k.PublicKey)
 }
 
+// IsRemote returns true if the private key is held by a remote signer, see NewRemoteV2.
+func (k KeyV2) IsRemote() bool {
+	return k.remoteSign != nil
+}
+
 func (k KeyV2) Raw() internal.Raw {
 	return k.raw
 }
@@ -79,5 +95,19 @@ func (k KeyV2) Raw() internal.Raw {
 func (k KeyV2) Public() crypto.PublicKey { return k.PublicKey }
 
 func (k KeyV2) Sign(rand io.Reader, message []byte, opts crypto.SignerOpts) (signature []byte, err error) {
+	if k.remoteSign != nil {
+		if opts.HashFunc() != crypto.Hash(0) {
+			return nil, fmt.Errorf("ed25519 signs unhashed messages, got hash %s", opts.HashFunc())
+		}
+		return k.remoteSign(context.Background(), message)
+	}
 	return k.signer.Sign(rand, message, opts)
 }
+
+// SignContext signs message, with ctx bounding the request to the remote signer of remote keys.
+func (k KeyV2) SignContext(ctx context.Context, message []byte) ([]byte, error) {
+	if k.remoteSign != nil {
+		return k.remoteSign(ctx, message)
+	}
+	return k.signer.Sign(cryptorand.Reader, message, crypto.Hash(0))
+}
@@ -2,6 +2,7 @@ package ethkey
 
 import (
 	"bytes"
+	"context"
 	"crypto/ecdsa"
 	"crypto/rand"
 	"fmt"
@@ -33,6 +34,7 @@ func KeyFor(raw internal.Raw) KeyV2 {
 type KeyV2 struct {
 	raw          internal.Raw
 	getPK        func() *ecdsa.PrivateKey
+	remoteSign   func(ctx context.Context, data []byte) ([]byte, error)
 	Address      common.Address
 	EIP55Address types.EIP55Address
 }
@@ -47,6 +49,15 @@ func newKeyV2(privKey *ecdsa.PrivateKey) KeyV2 {
 	}
 }
 
+// NewRemoteV2 returns a key held outside of the node, signing with sign.
+func NewRemoteV2(address common.Address, sign func(ctx context.Context, data []byte) ([]byte, error)) KeyV2 {
+	return KeyV2{
+		remoteSign:   sign,
+		Address:      address,
+		EIP55Address: types.EIP55AddressFromAddress(address),
+	}
+}
+
 func NewV2() (KeyV2, error) {
 	privateKeyECDSA, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
 	if err != nil {
@@ -67,7 +78,20 @@ func (key KeyV2) ID() string {
 
 func (key KeyV2) Raw() internal.Raw { return key.raw }
 
-func (key KeyV2) Sign(data []byte) ([]byte, error) { return crypto.Sign(data, key.getPK()) }
+// IsRemote returns true if the private key is held by a remote signer, see NewRemoteV2.
+func (key KeyV2) IsRemote() bool { return key.remoteSign != nil }
+
+func (key KeyV2) Sign(data []byte) ([]byte, error) {
+	return key.SignContext(context.Background(), data)
+}
+
+// SignContext is like Sign, with ctx bounding the request to the remote signer of remote keys.
+func (key KeyV2) SignContext(ctx context.Context, data []byte) ([]byte, error) {
+	if key.remoteSign != nil {
+		return key.remoteSign(ctx, data)
+	}
+	return crypto.Sign(data, key.getPK())
+}
 
 // Cmp uses byte-order address comparison to give a stable comparison between two keys
 func (key KeyV2) Cmp(key2 KeyV2) int {
@@ -35,6 +35,8 @@ type (
 		OffchainKeyring []byte
 		Keyring         []byte
 		ID              models.Sha256Hash // tracked to preserve bundle ID in case of migrations
+		// RemoteOnchainKey is set if Keyring only holds the address of an onchain key held by a remote signer
+		RemoteOnchainKey bool `json:",omitempty"`
 
 		// old chain specific format for migrating
 		EVMKeyring    []byte `json:",omitempty"`
@@ -128,11 +130,13 @@ func (kb *keyBundle[K]) Marshal() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	_, remote := any(kb.keyring).(*remoteEVMKeyring)
 	rawKeyData := keyBundleRawData{
-		ChainType:       kb.chainType,
-		OffchainKeyring: offchainKeyringBytes,
-		Keyring:         keyringBytes,
-		ID:              kb.id, // preserve bundle ID
+		ChainType:        kb.chainType,
+		OffchainKeyring:  offchainKeyringBytes,
+		Keyring:          keyringBytes,
+		ID:               kb.id, // preserve bundle ID
+		RemoteOnchainKey: remote,
 	}
 	return json.Marshal(&rawKeyData)
 }
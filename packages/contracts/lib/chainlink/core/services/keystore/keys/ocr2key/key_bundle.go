@@ -108,13 +108,20 @@ func (kb keyBundleBase) ChainType() chaintype.ChainType {
 }
 
 func KeyFor(raw internal.Raw) (kb KeyBundle) {
-	var temp struct{ ChainType chaintype.ChainType }
+	var temp struct {
+		ChainType        chaintype.ChainType
+		RemoteOnchainKey bool
+	}
 	err := json.Unmarshal(internal.Bytes(raw), &temp)
 	if err != nil {
 		panic(err)
 	}
 	switch temp.ChainType {
 	case chaintype.EVM:
+		if temp.RemoteOnchainKey {
+			kb = newKeyBundle(new(remoteEVMKeyring))
+			break
+		}
 		kb = newKeyBundle(new(evmKeyring))
 	case chaintype.Cosmos:
 		kb = newKeyBundle(new(cosmosKeyring))
@@ -0,0 +1,98 @@
+package ocr2key
+
+import (
+	cryptorand "crypto/rand"
+	"crypto/sha256"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+)
+
+var _ keyring = &remoteEVMKeyring{}
+
+var _ KeyBundle = &keyBundle[*remoteEVMKeyring]{}
+
+// remoteEVMKeyring is an EVM onchain keyring whose key is held by a remote signer. Only its address is stored with
+// the key bundle, verification remains local.
+type remoteEVMKeyring struct {
+	evmKeyring
+	address common.Address
+	// signBlob is attached once the key bundle is loaded, see WithRemoteSigner.
+	signBlob func(b []byte) ([]byte, error)
+}
+
+// NewWithRemoteOnchainKey returns an EVM key bundle with new offchain keys, whose onchain key of address is held by a
+// remote signer. The bundle cannot sign onchain until a signer is attached with WithRemoteSigner.
+func NewWithRemoteOnchainKey(address common.Address) (KeyBundle, error) {
+	offchainKeyring, err := newOffchainKeyring(cryptorand.Reader, cryptorand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	k := keyBundle[*remoteEVMKeyring]{
+		keyBundleBase: keyBundleBase{
+			chainType:       chaintype.EVM,
+			offchainKeyring: *offchainKeyring,
+		},
+		keyring: &remoteEVMKeyring{address: address},
+	}
+	marshalled, err := k.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	k.id = sha256.Sum256(marshalled)
+	return &k, nil
+}
+
+// HasRemoteOnchainKey returns true if the onchain key of kb is held by a remote signer, see NewWithRemoteOnchainKey.
+func HasRemoteOnchainKey(kb KeyBundle) bool {
+	_, ok := kb.(*keyBundle[*remoteEVMKeyring])
+	return ok
+}
+
+// WithRemoteSigner returns a copy of kb signing onchain with signBlob, which must return a 65 bytes secp256k1
+// signature of a 32 bytes hash by the onchain key of kb. kb must have a remote onchain key.
+func WithRemoteSigner(kb KeyBundle, signBlob func(b []byte) ([]byte, error)) (KeyBundle, error) {
+	remote, ok := kb.(*keyBundle[*remoteEVMKeyring])
+	if !ok {
+		return nil, errors.Errorf("key bundle %s does not have a remote onchain key", kb.ID())
+	}
+	k := *remote
+	k.keyring = &remoteEVMKeyring{address: remote.keyring.address, signBlob: signBlob}
+	return &k, nil
+}
+
+func (ekr *remoteEVMKeyring) PublicKey() ocrtypes.OnchainPublicKey {
+	return ekr.address.Bytes()
+}
+
+func (ekr *remoteEVMKeyring) Sign(reportCtx ocrtypes.ReportContext, report ocrtypes.Report) ([]byte, error) {
+	return ekr.SignBlob(ReportToSigData(reportCtx, report))
+}
+
+func (ekr *remoteEVMKeyring) Sign3(digest ocrtypes.ConfigDigest, seqNr uint64, r ocrtypes.Report) ([]byte, error) {
+	return ekr.SignBlob(ReportToSigData3(digest, seqNr, r))
+}
+
+func (ekr *remoteEVMKeyring) SignBlob(b []byte) ([]byte, error) {
+	if ekr.signBlob == nil {
+		return nil, errors.Errorf("onchain key %s is held by a remote signer which is not available", ekr.address.Hex())
+	}
+	return ekr.signBlob(b)
+}
+
+// Marshal returns the onchain address, the private key is not known.
+func (ekr *remoteEVMKeyring) Marshal() ([]byte, error) {
+	return ekr.address.Bytes(), nil
+}
+
+func (ekr *remoteEVMKeyring) Unmarshal(in []byte) error {
+	if len(in) != common.AddressLength {
+		return errors.Errorf("remote onchain key must be a %d bytes address, got %d bytes", common.AddressLength, len(in))
+	}
+	ekr.address = common.BytesToAddress(in)
+	return nil
+}
@@ -0,0 +1,51 @@
+package ocr2key_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+)
+
+func TestOCR2KeyBundle_RemoteOnchainKey(t *testing.T) {
+	t.Parallel()
+
+	priv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(priv.PublicKey)
+
+	kb, err := ocr2key.NewWithRemoteOnchainKey(address)
+	require.NoError(t, err)
+	assert.True(t, ocr2key.HasRemoteOnchainKey(kb))
+	assert.Equal(t, chaintype.EVM, kb.ChainType())
+	assert.Equal(t, address[:], []byte(kb.PublicKey()))
+
+	report := []byte("report")
+	_, err = kb.Sign3([32]byte{1}, 2, report)
+	require.ErrorContains(t, err, "is held by a remote signer which is not available")
+
+	// the private key is not stored with the bundle
+	loaded := ocr2key.KeyFor(kb.Raw())
+	assert.Equal(t, kb.ID(), loaded.ID())
+	assert.True(t, ocr2key.HasRemoteOnchainKey(loaded))
+	assert.Equal(t, kb.OffchainPublicKey(), loaded.OffchainPublicKey())
+	assert.Equal(t, kb.PublicKey(), loaded.PublicKey())
+
+	signing, err := ocr2key.WithRemoteSigner(loaded, func(b []byte) ([]byte, error) {
+		return crypto.Sign(b, priv)
+	})
+	require.NoError(t, err)
+	sig, err := signing.Sign3([32]byte{1}, 2, report)
+	require.NoError(t, err)
+	assert.True(t, signing.Verify3(kb.PublicKey(), [32]byte{1}, 2, report, sig))
+
+	local, err := ocr2key.New(chaintype.EVM)
+	require.NoError(t, err)
+	assert.False(t, ocr2key.HasRemoteOnchainKey(local))
+	_, err = ocr2key.WithRemoteSigner(local, nil)
+	require.Error(t, err)
+}
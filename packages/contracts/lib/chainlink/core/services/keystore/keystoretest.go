@@ -45,6 +45,21 @@ func (o *memoryORM) getEncryptedKeyRing(ctx context.Context) (encryptedKeyRing,
 	return *o.keyRing, nil
 }
 
+func (o *memoryORM) rotateEncryptedKeyRing(ctx context.Context, rotate func(current encryptedKeyRing) (*encryptedKeyRing, error)) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var current encryptedKeyRing
+	if o.keyRing != nil {
+		current = *o.keyRing
+	}
+	kr, err := rotate(current)
+	if err != nil {
+		return err
+	}
+	o.keyRing = kr
+	return nil
+}
+
 func newInMemoryORM(ds sqlutil.DataSource) *memoryORM {
 	return &memoryORM{ds: ds}
 }
@@ -61,6 +76,7 @@ func NewInMemory(ds sqlutil.DataSource, scryptParams utils.ScryptParams, lggr lo
 		scryptParams: scryptParams,
 		lock:         &sync.RWMutex{},
 		logger:       logger.Named(lggr, "KeyStore"),
+		remote:       newRemoteKeys(),
 	}
 
 	return &master{
@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"reflect"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -53,6 +54,8 @@ type Master interface {
 	Workflow() Workflow
 	Unlock(ctx context.Context, password string) error
 	IsEmpty(ctx context.Context) (bool, error)
+	// RotatePassword re-encrypts all keys with newPassword and scryptParams. The keystore must be unlocked.
+	RotatePassword(ctx context.Context, newPassword string, scryptParams utils.ScryptParams) error
 }
 type master struct {
 	*keyManager
@@ -71,11 +74,11 @@ type master struct {
 	workflow *workflow
 }
 
-func New(ds sqlutil.DataSource, scryptParams utils.ScryptParams, lggr logger.Logger) Master {
-	return newMaster(ds, scryptParams, lggr)
+func New(ds sqlutil.DataSource, scryptParams utils.ScryptParams, lggr logger.Logger, opts ...Option) Master {
+	return newMaster(ds, scryptParams, lggr, opts...)
 }
 
-func newMaster(ds sqlutil.DataSource, scryptParams utils.ScryptParams, lggr logger.Logger) *master {
+func newMaster(ds sqlutil.DataSource, scryptParams utils.ScryptParams, lggr logger.Logger, opts ...Option) *master {
 	orm := NewORM(ds)
 	km := &keyManager{
 		orm:          orm,
@@ -83,6 +86,10 @@ func newMaster(ds sqlutil.DataSource, scryptParams utils.ScryptParams, lggr logg
 		scryptParams: scryptParams,
 		lock:         &sync.RWMutex{},
 		logger:       logger.Named(lggr, "KeyStore"),
+		remote:       newRemoteKeys(),
+	}
+	for _, opt := range opts {
+		opt(km)
 	}
 
 	return &master{
@@ -159,6 +166,8 @@ type ORM interface {
 	isEmpty(context.Context) (bool, error)
 	saveEncryptedKeyRing(context.Context, *encryptedKeyRing, ...func(sqlutil.DataSource) error) error
 	getEncryptedKeyRing(context.Context) (encryptedKeyRing, error)
+	// rotateEncryptedKeyRing replaces the stored key ring with the one returned by rotate, in a single transaction.
+	rotateEncryptedKeyRing(ctx context.Context, rotate func(current encryptedKeyRing) (*encryptedKeyRing, error)) error
 }
 
 type keystateORM interface {
@@ -174,6 +183,10 @@ type keyManager struct {
 	lock         *sync.RWMutex
 	password     string
 	logger       logger.Logger
+
+	signer        Signer        // optional
+	signerTimeout time.Duration // bounds signer requests, if not zero
+	remote        *remoteKeys   // keys held by signer
 }
 
 func (km *keyManager) IsEmpty(ctx context.Context) (bool, error) {
@@ -207,10 +220,67 @@ func (km *keyManager) Unlock(ctx context.Context, password string) error {
 	}
 	km.keyStates = ks
 
+	if km.signer != nil {
+		rk, err := km.loadRemoteKeys(ctx)
+		if err != nil {
+			return err
+		}
+		km.logger.Infow("Loaded keys from remote signer", "eth", len(rk.eth), "csa", len(rk.csa), "ocr2", len(rk.ocr2))
+		km.remote = rk
+	}
+
 	km.password = password
 	return nil
 }
 
+// RotatePassword re-encrypts the key ring stored in the database with newPassword and scryptParams.
+// The stored key ring is decrypted with the current password and must hold the same keys as the unlocked key ring.
+// The re-encrypted key ring is decrypted again with newPassword and verified before the transaction is committed,
+// so that a failure leaves the stored key ring encrypted with the current password.
+func (km *keyManager) RotatePassword(ctx context.Context, newPassword string, scryptParams utils.ScryptParams) error {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if km.isLocked() {
+		return ErrLocked
+	}
+	if newPassword == "" {
+		return errors.New("new password must not be empty")
+	}
+	if newPassword == km.password {
+		return errors.New("new password must differ from the current password")
+	}
+	var rotated *keyRing
+	err := km.orm.rotateEncryptedKeyRing(ctx, func(current encryptedKeyRing) (*encryptedKeyRing, error) {
+		stored, err := current.Decrypt(km.password)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to decrypt stored key ring with the current password")
+		}
+		if err = stored.sameKeys(km.keyRing); err != nil {
+			return nil, errors.Wrap(err, "stored key ring does not match the unlocked key ring")
+		}
+		ekr, err := stored.Encrypt(newPassword, scryptParams)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to encrypt key ring with the new password")
+		}
+		rotated, err = ekr.Decrypt(newPassword)
+		if err != nil {
+			return nil, errors.Wrap(err, "verification failed: unable to decrypt key ring with the new password")
+		}
+		if err = rotated.sameKeys(stored); err != nil {
+			return nil, errors.Wrap(err, "verification failed")
+		}
+		return &ekr, nil
+	})
+	if err != nil {
+		return err
+	}
+	km.keyRing = rotated
+	km.password = newPassword
+	km.scryptParams = scryptParams
+	km.logger.Info("Rotated key store password")
+	return nil
+}
+
 // caller must hold lock!
 func (km *keyManager) save(ctx context.Context, callbacks ...func(sqlutil.DataSource) error) error {
 	ekb, err := km.keyRing.Encrypt(km.password, km.scryptParams)
@@ -10,6 +10,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/internal"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func TestMasterKeystore_Unlock_Save(t *testing.T) {
@@ -65,6 +66,43 @@ func TestMasterKeystore_Unlock_Save(t *testing.T) {
 	})
 }
 
+func TestMasterKeystore_RotatePassword(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	keyStore := keystore.ExposedNewMaster(t, db)
+	ctx := testutils.Context(t)
+	const newPassword = "p4SsW0rD1!@#_new"
+
+	require.ErrorIs(t, keyStore.RotatePassword(ctx, newPassword, utils.FastScryptParams), keystore.ErrLocked)
+
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	ethKey, _ := cltest.MustInsertRandomKey(t, keyStore.Eth())
+	csaKey, err := keyStore.CSA().Create(ctx)
+	require.NoError(t, err)
+
+	require.Error(t, keyStore.RotatePassword(ctx, "", utils.FastScryptParams))
+	require.NoError(t, keyStore.RotatePassword(ctx, newPassword, utils.FastScryptParams))
+
+	// keys can still be used and saved after rotation
+	_, err = keyStore.P2P().Create(ctx)
+	require.NoError(t, err)
+
+	keyStore.ResetXXXTestOnly()
+	require.Error(t, keyStore.Unlock(ctx, cltest.Password))
+	require.NoError(t, keyStore.Unlock(ctx, newPassword))
+
+	gotEthKey, err := keyStore.Eth().Get(ctx, ethKey.ID())
+	require.NoError(t, err)
+	requireEqualKeys(t, ethKey, gotEthKey)
+	gotCSAKey, err := keyStore.CSA().Get(csaKey.ID())
+	require.NoError(t, err)
+	requireEqualKeys(t, csaKey, gotCSAKey)
+	p2pKeys, err := keyStore.P2P().GetAll()
+	require.NoError(t, err)
+	require.Len(t, p2pKeys, 1)
+}
+
 func requireEqualKeys(t *testing.T, a, b interface {
 	ID() string
 	Raw() internal.Raw
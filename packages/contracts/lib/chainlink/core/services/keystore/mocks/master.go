@@ -7,6 +7,8 @@ import (
 
 	keystore "github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	mock "github.com/stretchr/testify/mock"
+
+	utils "github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 // Master is an autogenerated mock type for the Master type
@@ -407,6 +409,54 @@ func (_c *Master_P2P_Call) RunAndReturn(run func() keystore.P2P) *Master_P2P_Cal
 	return _c
 }
 
+// RotatePassword provides a mock function with given fields: ctx, newPassword, scryptParams
+func (_m *Master) RotatePassword(ctx context.Context, newPassword string, scryptParams utils.ScryptParams) error {
+	ret := _m.Called(ctx, newPassword, scryptParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotatePassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, utils.ScryptParams) error); ok {
+		r0 = rf(ctx, newPassword, scryptParams)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Master_RotatePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotatePassword'
+type Master_RotatePassword_Call struct {
+	*mock.Call
+}
+
+// RotatePassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - newPassword string
+//   - scryptParams utils.ScryptParams
+func (_e *Master_Expecter) RotatePassword(ctx interface{}, newPassword interface{}, scryptParams interface{}) *Master_RotatePassword_Call {
+	return &Master_RotatePassword_Call{Call: _e.mock.On("RotatePassword", ctx, newPassword, scryptParams)}
+}
+
+func (_c *Master_RotatePassword_Call) Run(run func(ctx context.Context, newPassword string, scryptParams utils.ScryptParams)) *Master_RotatePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(utils.ScryptParams))
+	})
+	return _c
+}
+
+func (_c *Master_RotatePassword_Call) Return(_a0 error) *Master_RotatePassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Master_RotatePassword_Call) RunAndReturn(run func(context.Context, string, utils.ScryptParams) error) *Master_RotatePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Solana provides a mock function with no fields
 func (_m *Master) Solana() keystore.Solana {
 	ret := _m.Called()
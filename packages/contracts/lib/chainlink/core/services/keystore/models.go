@@ -1,9 +1,11 @@
 package keystore
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"slices"
 	"time"
 
 	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
@@ -259,6 +261,68 @@ func (kr *keyRing) raw() (rawKeys rawKeyRing) {
 	return rawKeys
 }
 
+// sameKeys returns an error if other does not hold the same private keys as kr, including the legacy keys.
+func (kr *keyRing) sameKeys(other *keyRing) error {
+	raw, otherRaw := kr.raw(), other.raw()
+	for _, keys := range []struct {
+		name      string
+		kr, other [][]byte
+	}{
+		{"Eth", raw.Eth, otherRaw.Eth},
+		{"CSA", raw.CSA, otherRaw.CSA},
+		{"OCR", raw.OCR, otherRaw.OCR},
+		{"OCR2", raw.OCR2, otherRaw.OCR2},
+		{"P2P", raw.P2P, otherRaw.P2P},
+		{"Cosmos", raw.Cosmos, otherRaw.Cosmos},
+		{"Solana", raw.Solana, otherRaw.Solana},
+		{"StarkNet", raw.StarkNet, otherRaw.StarkNet},
+		{"Aptos", raw.Aptos, otherRaw.Aptos},
+		{"Tron", raw.Tron, otherRaw.Tron},
+		{"TON", raw.TON, otherRaw.TON},
+		{"VRF", raw.VRF, otherRaw.VRF},
+		{"Workflow", raw.Workflow, otherRaw.Workflow},
+	} {
+		if !sameRawKeys(keys.kr, keys.other) {
+			return errors.Errorf("%s keys differ", keys.name)
+		}
+	}
+	if !sameLegacyKeys(kr.LegacyKeys.legacyRawKeys, other.LegacyKeys.legacyRawKeys) {
+		return errors.New("legacy keys differ")
+	}
+	return nil
+}
+
+// sameRawKeys compares a and b regardless of their order, without modifying them.
+func sameRawKeys(a, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.SortFunc(a, bytes.Compare)
+	slices.SortFunc(b, bytes.Compare)
+	return slices.EqualFunc(a, b, bytes.Equal)
+}
+
+// sameLegacyKeys compares a and b regardless of the order of their keys, without modifying them.
+func sameLegacyKeys(a, b rawLegacyKeys) bool {
+	if a.len() != b.len() {
+		return false
+	}
+	for name, keys := range a {
+		otherKeys := b[name]
+		if len(keys) != len(otherKeys) {
+			return false
+		}
+		keys, otherKeys = slices.Clone(keys), slices.Clone(otherKeys)
+		slices.Sort(keys)
+		slices.Sort(otherKeys)
+		if !slices.Equal(keys, otherKeys) {
+			return false
+		}
+	}
+	return true
+}
+
 func (kr *keyRing) logPubKeys(lggr logger.Logger) {
 	lggr = logger.Named(lggr, "KeyRing")
 	var csaIDs []string
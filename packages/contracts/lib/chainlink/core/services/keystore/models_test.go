@@ -162,3 +162,22 @@ func TestKeyRing_Encrypt_Decrypt(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestKeyRing_sameKeys(t *testing.T) {
+	csa1, csa2 := csakey.MustNewV2XXXTestingOnly(big.NewInt(1)), csakey.MustNewV2XXXTestingOnly(big.NewInt(2))
+	kr, other := newKeyRing(), newKeyRing()
+	kr.CSA[csa1.ID()], kr.CSA[csa2.ID()] = csa1, csa2
+	other.CSA[csa2.ID()], other.CSA[csa1.ID()] = csa2, csa1
+	require.NoError(t, kr.sameKeys(other))
+
+	delete(other.CSA, csa2.ID())
+	require.EqualError(t, kr.sameKeys(other), "CSA keys differ")
+
+	other.CSA[csa2.ID()] = csa2
+	kr.LegacyKeys.legacyRawKeys = rawLegacyKeys{"Legacy": {"b", "a"}}
+	require.EqualError(t, kr.sameKeys(other), "legacy keys differ")
+	other.LegacyKeys.legacyRawKeys = rawLegacyKeys{"Legacy": {"a", "b"}}
+	require.NoError(t, kr.sameKeys(other))
+	// the keys of the callers are not reordered
+	require.Equal(t, rawLegacyKey{"b", "a"}, kr.LegacyKeys.legacyRawKeys["Legacy"])
+}
@@ -3,7 +3,9 @@ package keystore
 import (
 	"context"
 	"fmt"
+	"slices"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
@@ -51,7 +53,7 @@ func (ks ocr2) GetAll() ([]ocr2key.KeyBundle, error) {
 		return keys, ErrLocked
 	}
 	for _, key := range ks.keyRing.OCR2 {
-		keys = append(keys, key)
+		keys = append(keys, ks.withRemoteOnchainKey(key))
 	}
 	return keys, nil
 }
@@ -163,14 +165,59 @@ func (ks ocr2) getByID(id string) (ocr2key.KeyBundle, error) {
 	if !found {
 		return nil, fmt.Errorf("unable to find OCR key with id %s", id)
 	}
-	return key, nil
+	return ks.withRemoteOnchainKey(key), nil
+}
+
+// withRemoteOnchainKey attaches the remote signer to key, if its onchain key is held by the remote signer.
+// caller must hold lock!
+func (ks ocr2) withRemoteOnchainKey(key ocr2key.KeyBundle) ocr2key.KeyBundle {
+	if !ocr2key.HasRemoteOnchainKey(key) {
+		return key
+	}
+	rk, found := ks.remote.ocr2[common.BytesToAddress(key.PublicKey())]
+	if !found {
+		// signing fails until the remote signer holds the key again
+		ks.logger.Errorw("Remote signer does not hold the onchain key of OCR2 key bundle", "keyID", key.ID(), "address", common.BytesToAddress(key.PublicKey()))
+		return key
+	}
+	// OCR signing has no context, requests are bounded by the signer timeout
+	remote, err := ocr2key.WithRemoteSigner(key, func(b []byte) ([]byte, error) {
+		return ks.remoteSign(context.Background(), SignerKeyTypeOCR2, rk.ID, b)
+	})
+	if err != nil {
+		ks.logger.Errorw("Ignoring remote onchain key", "keyID", key.ID(), "err", err)
+		return key
+	}
+	return remote
+}
+
+// unusedRemoteOnchainKey returns the address of an OCR2 key held by the remote signer that no key bundle uses.
+// caller must hold lock!
+func (ks ocr2) unusedRemoteOnchainKey() (common.Address, error) {
+	used := map[common.Address]bool{}
+	for _, key := range ks.keyRing.OCR2 {
+		if ocr2key.HasRemoteOnchainKey(key) {
+			used[common.BytesToAddress(key.PublicKey())] = true
+		}
+	}
+	var unused []common.Address
+	for address := range ks.remote.ocr2 {
+		if !used[address] {
+			unused = append(unused, address)
+		}
+	}
+	if len(unused) == 0 {
+		return common.Address{}, errors.Errorf("all %d OCR2 keys of the remote signer are used by key bundles", len(ks.remote.ocr2))
+	}
+	slices.SortFunc(unused, func(a, b common.Address) int { return a.Cmp(b) })
+	return unused[0], nil
 }
 
 func (ks ocr2) getAllOfType(chainType chaintype.ChainType) ([]ocr2key.KeyBundle, error) {
 	keys := []ocr2key.KeyBundle{}
 	for _, key := range ks.keyRing.OCR2 {
 		if key.ChainType() == chainType {
-			keys = append(keys, key)
+			keys = append(keys, ks.withRemoteOnchainKey(key))
 		}
 	}
 	return keys, nil
@@ -180,6 +227,21 @@ func (ks ocr2) create(ctx context.Context, chainType chaintype.ChainType) (ocr2k
 	if !chaintype.IsSupportedChainType(chainType) {
 		return nil, chaintype.NewErrInvalidChainType(chainType)
 	}
+	if chainType == chaintype.EVM && len(ks.remote.ocr2) > 0 {
+		// the onchain key is held by the remote signer, only its address is stored
+		address, err := ks.unusedRemoteOnchainKey()
+		if err != nil {
+			return nil, err
+		}
+		key, err := ocr2key.NewWithRemoteOnchainKey(address)
+		if err != nil {
+			return nil, err
+		}
+		if err = ks.safeAddKey(ctx, key); err != nil {
+			return nil, err
+		}
+		return ks.withRemoteOnchainKey(key), nil
+	}
 	key, err := ocr2key.New(chainType)
 	if err != nil {
 		return nil, err
@@ -48,6 +48,25 @@ func (orm ksORM) saveEncryptedKeyRing(ctx context.Context, kr *encryptedKeyRing,
 	})
 }
 
+func (orm ksORM) rotateEncryptedKeyRing(ctx context.Context, rotate func(current encryptedKeyRing) (*encryptedKeyRing, error)) error {
+	return sqlutil.TransactDataSource(ctx, orm.ds, nil, func(tx sqlutil.DataSource) error {
+		var current encryptedKeyRing
+		err := tx.GetContext(ctx, &current, `SELECT * FROM encrypted_key_rings LIMIT 1 FOR UPDATE`)
+		if err != nil {
+			return errors.Wrap(err, "while loading keyring")
+		}
+		kr, err := rotate(current)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, `
+		UPDATE encrypted_key_rings
+		SET encrypted_keys = $1, updated_at = NOW()
+	`, kr.EncryptedKeys)
+		return errors.Wrap(err, "while saving keyring")
+	})
+}
+
 func (orm ksORM) getEncryptedKeyRing(ctx context.Context) (kr encryptedKeyRing, err error) {
 	err = orm.ds.GetContext(ctx, &kr, `SELECT * FROM encrypted_key_rings LIMIT 1`)
 	if errors.Is(err, sql.ErrNoRows) {
@@ -0,0 +1,133 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+)
+
+const maxResponseBytes = 1 << 20
+
+type keysResponse struct {
+	Keys []keystore.SignerKey `json:"keys"`
+}
+
+type signRequest struct {
+	ID   string `json:"id"`
+	Data []byte `json:"data"`
+}
+
+type signResponse struct {
+	Signature []byte `json:"signature"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+var _ keystore.Signer = (*Client)(nil)
+
+// Client is a keystore.Signer delegating to a remote signer.
+type Client struct {
+	url    *url.URL
+	client *http.Client
+}
+
+// NewClient returns a client of the signer served at u. Each request times out after timeout.
+// The signer must be served over mutual TLS configured by tlsConfig, see ClientTLSConfig, unless allowInsecureLoopback
+// is set and u is a plaintext http URL on the loopback interface.
+func NewClient(u *url.URL, timeout time.Duration, tlsConfig *tls.Config, allowInsecureLoopback bool) (*Client, error) {
+	switch u.Scheme {
+	case "https":
+		if tlsConfig == nil || len(tlsConfig.Certificates) == 0 || tlsConfig.RootCAs == nil {
+			return nil, errors.New("remote signer requires mutual TLS: a client certificate and the CA of the signer must be set")
+		}
+	case "http":
+		if !allowInsecureLoopback || !IsLoopback(u.Hostname()) {
+			return nil, errors.Errorf("plaintext remote signer URL %s is only allowed on the loopback interface with AllowInsecureLoopback", u.Redacted())
+		}
+		tlsConfig = nil
+	default:
+		return nil, errors.Errorf("unsupported remote signer URL scheme %q", u.Scheme)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return &Client{url: u, client: &http.Client{Timeout: timeout, Transport: transport}}, nil
+}
+
+// NewClientFromConfig returns a client of the signer configured by cfg.
+func NewClientFromConfig(cfg config.RemoteSigner) (*Client, error) {
+	var tlsConfig *tls.Config
+	if cfg.URL().Scheme == "https" {
+		var err error
+		tlsConfig, err = ClientTLSConfig(cfg.TLSCertPath(), cfg.TLSKeyPath(), cfg.TLSCACertPath())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return NewClient(cfg.URL(), cfg.Timeout(), tlsConfig, cfg.AllowInsecureLoopback())
+}
+
+func (c *Client) Keys(ctx context.Context, keyType keystore.SignerKeyType) ([]keystore.SignerKey, error) {
+	var resp keysResponse
+	if err := c.do(ctx, http.MethodGet, "/v1/keys/"+string(keyType), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Keys, nil
+}
+
+func (c *Client) Sign(ctx context.Context, keyType keystore.SignerKeyType, id string, data []byte) ([]byte, error) {
+	var resp signResponse
+	if err := c.do(ctx, http.MethodPost, "/v1/keys/"+string(keyType)+"/sign", signRequest{ID: id, Data: data}, &resp); err != nil {
+		return nil, errors.Wrapf(err, "failed to sign with %s key %s", keyType, id)
+	}
+	return resp.Signature, nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body, result interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.url.JoinPath(path).String(), reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "remote signer request failed")
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return errors.Wrap(err, "failed to read remote signer response")
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var errResp errorResponse
+		_ = json.Unmarshal(b, &errResp)
+		err = fmt.Errorf("remote signer returned status %d: %s", resp.StatusCode, errResp.Error)
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("%w: %w", keystore.ErrKeyNotFound, err)
+		}
+		return err
+	}
+	return errors.Wrap(json.Unmarshal(b, result), "invalid remote signer response")
+}
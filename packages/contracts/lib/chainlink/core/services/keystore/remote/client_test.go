@@ -0,0 +1,216 @@
+package remote_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remote"
+)
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	signer := remote.NewLocalSigner()
+	ethKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ethID := signer.AddEthKey(ethKey)
+	_, csaKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	csaID := signer.AddCSAKey(csaKey)
+
+	serverCA := newTestCA(t, "server-ca")
+	clientCA := newTestCA(t, "client-ca")
+	serverCert, serverKey := serverCA.issue(t, "server", x509.ExtKeyUsageServerAuth)
+	clientCert, clientKey := clientCA.issue(t, "client", x509.ExtKeyUsageClientAuth)
+	u := newTLSServer(t, remote.NewHandler(signer, logger.TestLogger(t)), serverCert, serverKey, clientCA.certPath)
+	tlsConfig, err := remote.ClientTLSConfig(clientCert, clientKey, serverCA.certPath)
+	require.NoError(t, err)
+	client, err := remote.NewClient(u, 5*time.Second, tlsConfig, false)
+	require.NoError(t, err)
+
+	t.Run("lists keys", func(t *testing.T) {
+		keys, err := client.Keys(ctx, keystore.SignerKeyTypeEth)
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		assert.Equal(t, ethID, keys[0].ID)
+		assert.Equal(t, crypto.FromECDSAPub(&ethKey.PublicKey), keys[0].PublicKey)
+
+		keys, err = client.Keys(ctx, keystore.SignerKeyTypeOCR2)
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+
+		_, err = client.Keys(ctx, "unknown")
+		require.Error(t, err)
+	})
+
+	t.Run("signs with eth keys", func(t *testing.T) {
+		hash := crypto.Keccak256([]byte("hello"))
+		sig, err := client.Sign(ctx, keystore.SignerKeyTypeEth, ethID, hash)
+		require.NoError(t, err)
+		pub, err := crypto.SigToPub(hash, sig)
+		require.NoError(t, err)
+		assert.Equal(t, ethID, crypto.PubkeyToAddress(*pub).Hex())
+	})
+
+	t.Run("signs with CSA keys", func(t *testing.T) {
+		sig, err := client.Sign(ctx, keystore.SignerKeyTypeCSA, csaID, []byte("hello"))
+		require.NoError(t, err)
+		assert.True(t, ed25519.Verify(csaKey.Public().(ed25519.PublicKey), []byte("hello"), sig))
+	})
+
+	t.Run("returns ErrKeyNotFound for unknown keys", func(t *testing.T) {
+		_, err := client.Sign(ctx, keystore.SignerKeyTypeEth, "0x0000000000000000000000000000000000000000", []byte("hello"))
+		require.ErrorIs(t, err, keystore.ErrKeyNotFound)
+	})
+}
+
+func TestClient_Authentication(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	signer := remote.NewLocalSigner()
+	serverCA := newTestCA(t, "server-ca")
+	clientCA := newTestCA(t, "client-ca")
+	serverCert, serverKey := serverCA.issue(t, "server", x509.ExtKeyUsageServerAuth)
+	u := newTLSServer(t, remote.NewHandler(signer, logger.TestLogger(t)), serverCert, serverKey, clientCA.certPath)
+
+	t.Run("rejects clients with a certificate from another CA", func(t *testing.T) {
+		otherCert, otherKey := newTestCA(t, "other-ca").issue(t, "client", x509.ExtKeyUsageClientAuth)
+		tlsConfig, err := remote.ClientTLSConfig(otherCert, otherKey, serverCA.certPath)
+		require.NoError(t, err)
+		client, err := remote.NewClient(u, 5*time.Second, tlsConfig, false)
+		require.NoError(t, err)
+		_, err = client.Keys(ctx, keystore.SignerKeyTypeEth)
+		require.Error(t, err)
+	})
+
+	t.Run("rejects servers with a certificate from another CA", func(t *testing.T) {
+		clientCert, clientKey := clientCA.issue(t, "client", x509.ExtKeyUsageClientAuth)
+		tlsConfig, err := remote.ClientTLSConfig(clientCert, clientKey, newTestCA(t, "other-ca").certPath)
+		require.NoError(t, err)
+		client, err := remote.NewClient(u, 5*time.Second, tlsConfig, false)
+		require.NoError(t, err)
+		_, err = client.Keys(ctx, keystore.SignerKeyTypeEth)
+		require.Error(t, err)
+	})
+
+	t.Run("requires a TLS config for https", func(t *testing.T) {
+		_, err := remote.NewClient(u, 5*time.Second, nil, false)
+		require.Error(t, err)
+	})
+
+	t.Run("rejects plaintext requests", func(t *testing.T) {
+		server := httptest.NewServer(remote.NewHandler(signer, logger.TestLogger(t)))
+		t.Cleanup(server.Close)
+		resp, err := http.Get(server.URL + "/v1/keys/eth")
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, resp.Body.Close()) })
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("serves plaintext loopback requests when opted into", func(t *testing.T) {
+		server := httptest.NewServer(remote.NewHandler(signer, logger.TestLogger(t), remote.WithInsecureLoopback()))
+		t.Cleanup(server.Close)
+		su, err := url.Parse(server.URL)
+		require.NoError(t, err)
+
+		_, err = remote.NewClient(su, 5*time.Second, nil, false)
+		require.Error(t, err)
+
+		client, err := remote.NewClient(su, 5*time.Second, nil, true)
+		require.NoError(t, err)
+		keys, err := client.Keys(ctx, keystore.SignerKeyTypeEth)
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("rejects plaintext to other hosts", func(t *testing.T) {
+		_, err := remote.NewClient(&url.URL{Scheme: "http", Host: "signer.example.com:8765"}, 5*time.Second, nil, true)
+		require.Error(t, err)
+	})
+}
+
+func newTLSServer(t *testing.T, handler http.Handler, certPath, keyPath, clientCACertPath string) *url.URL {
+	tlsConfig, err := remote.ServerTLSConfig(certPath, keyPath, clientCACertPath)
+	require.NoError(t, err)
+	server := httptest.NewUnstartedServer(handler)
+	server.TLS = tlsConfig
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	return u
+}
+
+type testCA struct {
+	cert     *x509.Certificate
+	key      *ecdsa.PrivateKey
+	certPath string
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key, certPath: writePEM(t, name+".crt", "CERTIFICATE", der)}
+}
+
+// issue returns the paths of a new certificate, valid for the loopback address, and its key.
+func (ca *testCA) issue(t *testing.T, name string, usage x509.ExtKeyUsage) (certPath, keyPath string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return writePEM(t, name+".crt", "CERTIFICATE", der), writePEM(t, name+".key", "EC PRIVATE KEY", keyDER)
+}
+
+func writePEM(t *testing.T, name, blockType string, der []byte) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600))
+	return path
+}
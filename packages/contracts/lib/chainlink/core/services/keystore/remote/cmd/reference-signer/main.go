@@ -0,0 +1,63 @@
+// reference-signer is a remote signer for tests, serving the keys of a JSON keys file over HTTP.
+// It is not meant to be used in production: its keys are stored in plaintext.
+//
+//	go run ./core/services/keystore/remote/cmd/reference-signer -keys keys.json -listen 127.0.0.1:8765 \
+//		-tls-cert signer.crt -tls-key signer.key -client-ca node-ca.crt
+//
+// Without TLS flags, -insecure-loopback serves plaintext HTTP on a loopback address.
+//
+// The keys file is formatted like remote.LocalSignerKeys:
+//
+//	{"eth": ["<secp256k1 key>"], "csa": ["<ed25519 seed>"], "ocr2": ["<secp256k1 key>"]}
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remote"
+)
+
+func main() {
+	keysPath := flag.String("keys", "keys.json", "path to the JSON keys file")
+	listen := flag.String("listen", "127.0.0.1:8765", "address to listen on")
+	tlsCert := flag.String("tls-cert", "", "path to the TLS certificate of the signer")
+	tlsKey := flag.String("tls-key", "", "path to the TLS key of the signer")
+	clientCA := flag.String("client-ca", "", "path to the CA certificate of the clients")
+	insecureLoopback := flag.Bool("insecure-loopback", false, "serve plaintext HTTP without authentication, only on a loopback address")
+	flag.Parse()
+
+	lggr, err := logger.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+	signer, err := remote.LoadLocalSigner(*keysPath)
+	if err != nil {
+		log.Fatalf("failed to load keys: %v", err)
+	}
+
+	server := &http.Server{
+		Addr:              *listen,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	if *insecureLoopback {
+		if !remote.IsLoopback(*listen) {
+			log.Fatalf("-insecure-loopback requires a loopback -listen address, got %s", *listen)
+		}
+		server.Handler = remote.NewHandler(signer, lggr, remote.WithInsecureLoopback())
+		lggr.Warnw("Serving remote signer over plaintext HTTP without authentication", "address", *listen)
+		log.Fatal(server.ListenAndServe())
+	}
+
+	server.TLSConfig, err = remote.ServerTLSConfig(*tlsCert, *tlsKey, *clientCA)
+	if err != nil {
+		log.Fatalf("invalid TLS config: %v", err)
+	}
+	server.Handler = remote.NewHandler(signer, lggr)
+	lggr.Infow("Serving remote signer", "address", *listen)
+	log.Fatal(server.ListenAndServeTLS("", ""))
+}
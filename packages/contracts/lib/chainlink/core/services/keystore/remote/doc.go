@@ -0,0 +1,28 @@
+// Package remote delegates keystore signing to an external signer over HTTP, so that private keys stay out of the
+// node process. See keystore.WithSigner.
+//
+// # Protocol
+//
+// The signer serves JSON over HTTP, byte fields are base64 encoded. The key types and their signatures are described
+// by keystore.SignerKeyType. OCR2 keys may have any ID, the node matches them with its EVM OCR2 key bundles by their
+// onchain address, and uses an unused one as the onchain key of each bundle it creates.
+//
+//	GET /v1/keys/{type}
+//		200 {"keys": [{"id": "...", "publicKey": "..."}]}
+//
+//	POST /v1/keys/{type}/sign {"id": "...", "data": "..."}
+//		200 {"signature": "..."}
+//		404 if the signer does not hold the key
+//
+// Errors are returned with a non 2xx status and a {"error": "..."} body.
+//
+// # Authentication
+//
+// The node and the signer authenticate each other with mutual TLS: the node presents a client certificate, which the
+// signer verifies against the CA of its clients, and only trusts a signer whose certificate is issued by the CA set
+// in its config, see ClientTLSConfig and ServerTLSConfig. Plaintext HTTP, without authentication, is only allowed
+// when explicitly opted into for a signer on the loopback interface.
+//
+// NewHandler serves the protocol for any keystore.Signer, and LocalSigner is a reference signer holding keys in
+// memory, see cmd/reference-signer.
+package remote
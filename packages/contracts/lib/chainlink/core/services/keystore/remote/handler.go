@@ -0,0 +1,103 @@
+package remote
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+)
+
+const maxRequestBytes = 1 << 20
+
+// HandlerOption configures NewHandler.
+type HandlerOption func(*handler)
+
+// WithInsecureLoopback also serves plaintext requests from the loopback interface, which are not authenticated.
+func WithInsecureLoopback() HandlerOption {
+	return func(h *handler) { h.allowInsecureLoopback = true }
+}
+
+// NewHandler serves the remote signer protocol, delegating to signer. Only requests authenticated with a verified
+// client certificate are served, so the handler must be served with mutual TLS, see ServerTLSConfig.
+func NewHandler(signer keystore.Signer, lggr logger.Logger, opts ...HandlerOption) http.Handler {
+	h := &handler{signer: signer, lggr: logger.Named(lggr, "RemoteSigner")}
+	for _, opt := range opts {
+		opt(h)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/keys/{type}", h.keys)
+	mux.HandleFunc("POST /v1/keys/{type}/sign", h.sign)
+	return h.authenticate(mux)
+}
+
+type handler struct {
+	signer                keystore.Signer
+	lggr                  logger.Logger
+	allowInsecureLoopback bool
+}
+
+func (h *handler) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authenticated := r.TLS != nil && len(r.TLS.VerifiedChains) > 0
+		if !authenticated && !(h.allowInsecureLoopback && r.TLS == nil && IsLoopback(r.RemoteAddr)) {
+			h.lggr.Warnw("Rejected unauthenticated request", "remoteAddr", r.RemoteAddr)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnauthorized)
+			h.write(w, errorResponse{Error: "a verified client certificate is required"})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (h *handler) keys(w http.ResponseWriter, r *http.Request) {
+	keys, err := h.signer.Keys(r.Context(), keystore.SignerKeyType(r.PathValue("type")))
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+	if keys == nil {
+		keys = []keystore.SignerKey{}
+	}
+	h.respond(w, keysResponse{Keys: keys})
+}
+
+func (h *handler) sign(w http.ResponseWriter, r *http.Request) {
+	var req signRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBytes)).Decode(&req); err != nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		h.write(w, errorResponse{Error: err.Error()})
+		return
+	}
+	signature, err := h.signer.Sign(r.Context(), keystore.SignerKeyType(r.PathValue("type")), req.ID, req.Data)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+	h.respond(w, signResponse{Signature: signature})
+}
+
+func (h *handler) respond(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	h.write(w, v)
+}
+
+func (h *handler) error(w http.ResponseWriter, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	if errors.Is(err, keystore.ErrKeyNotFound) {
+		w.WriteHeader(http.StatusNotFound)
+	} else {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	h.write(w, errorResponse{Error: err.Error()})
+}
+
+func (h *handler) write(w http.ResponseWriter, v interface{}) {
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		h.lggr.Errorw("Failed to write response", "err", err)
+	}
+}
@@ -0,0 +1,158 @@
+package remote
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+)
+
+var _ keystore.Signer = (*LocalSigner)(nil)
+
+// LocalSigner is a reference keystore.Signer holding its keys in memory.
+type LocalSigner struct {
+	mu   sync.RWMutex
+	eth  map[string]*ecdsa.PrivateKey
+	csa  map[string]ed25519.PrivateKey
+	ocr2 map[string]*ecdsa.PrivateKey
+}
+
+func NewLocalSigner() *LocalSigner {
+	return &LocalSigner{
+		eth:  map[string]*ecdsa.PrivateKey{},
+		csa:  map[string]ed25519.PrivateKey{},
+		ocr2: map[string]*ecdsa.PrivateKey{},
+	}
+}
+
+// LocalSignerKeys are the hex encoded private keys of a LocalSigner, as stored in a keys file.
+type LocalSignerKeys struct {
+	// Eth are secp256k1 private keys.
+	Eth []string `json:"eth"`
+	// CSA are ed25519 seeds.
+	CSA []string `json:"csa"`
+	// OCR2 are secp256k1 onchain private keys of EVM OCR2 key bundles.
+	OCR2 []string `json:"ocr2"`
+}
+
+// LoadLocalSigner returns a LocalSigner with the keys of the JSON LocalSignerKeys file at path.
+func LoadLocalSigner(path string) (*LocalSigner, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var keys LocalSignerKeys
+	if err = json.Unmarshal(b, &keys); err != nil {
+		return nil, errors.Wrap(err, "invalid keys file")
+	}
+
+	s := NewLocalSigner()
+	for i, k := range keys.Eth {
+		priv, err := crypto.HexToECDSA(k)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid eth key %d", i)
+		}
+		s.AddEthKey(priv)
+	}
+	for i, k := range keys.CSA {
+		seed, err := hex.DecodeString(k)
+		if err != nil || len(seed) != ed25519.SeedSize {
+			return nil, errors.Errorf("invalid CSA key %d: expected a %d bytes hex encoded seed", i, ed25519.SeedSize)
+		}
+		s.AddCSAKey(ed25519.NewKeyFromSeed(seed))
+	}
+	for i, k := range keys.OCR2 {
+		priv, err := crypto.HexToECDSA(k)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid OCR2 key %d", i)
+		}
+		s.AddOCR2Key(priv)
+	}
+	return s, nil
+}
+
+// AddEthKey adds an eth key and returns its ID.
+func (s *LocalSigner) AddEthKey(priv *ecdsa.PrivateKey) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := crypto.PubkeyToAddress(priv.PublicKey).Hex()
+	s.eth[id] = priv
+	return id
+}
+
+// AddCSAKey adds a CSA key and returns its ID.
+func (s *LocalSigner) AddCSAKey(priv ed25519.PrivateKey) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := hex.EncodeToString(priv.Public().(ed25519.PublicKey))
+	s.csa[id] = priv
+	return id
+}
+
+// AddOCR2Key adds an onchain key for EVM OCR2 key bundles and returns its ID.
+func (s *LocalSigner) AddOCR2Key(priv *ecdsa.PrivateKey) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := crypto.PubkeyToAddress(priv.PublicKey).Hex()
+	s.ocr2[id] = priv
+	return id
+}
+
+func (s *LocalSigner) Keys(_ context.Context, keyType keystore.SignerKeyType) (keys []keystore.SignerKey, err error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	switch keyType {
+	case keystore.SignerKeyTypeEth:
+		for id, priv := range s.eth {
+			keys = append(keys, keystore.SignerKey{ID: id, PublicKey: crypto.FromECDSAPub(&priv.PublicKey)})
+		}
+	case keystore.SignerKeyTypeCSA:
+		for id, priv := range s.csa {
+			keys = append(keys, keystore.SignerKey{ID: id, PublicKey: priv.Public().(ed25519.PublicKey)})
+		}
+	case keystore.SignerKeyTypeOCR2:
+		for id, priv := range s.ocr2 {
+			address := crypto.PubkeyToAddress(priv.PublicKey)
+			keys = append(keys, keystore.SignerKey{ID: id, PublicKey: address[:]})
+		}
+	default:
+		return nil, fmt.Errorf("unknown key type %q", keyType)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].ID < keys[j].ID })
+	return keys, nil
+}
+
+func (s *LocalSigner) Sign(_ context.Context, keyType keystore.SignerKeyType, id string, data []byte) ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	switch keyType {
+	case keystore.SignerKeyTypeEth, keystore.SignerKeyTypeOCR2:
+		keys := s.eth
+		if keyType == keystore.SignerKeyTypeOCR2 {
+			keys = s.ocr2
+		}
+		priv, ok := keys[id]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s key %s", keystore.ErrKeyNotFound, keyType, id)
+		}
+		return crypto.Sign(data, priv)
+	case keystore.SignerKeyTypeCSA:
+		priv, ok := s.csa[id]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s key %s", keystore.ErrKeyNotFound, keyType, id)
+		}
+		return ed25519.Sign(priv, data), nil
+	default:
+		return nil, fmt.Errorf("unknown key type %q", keyType)
+	}
+}
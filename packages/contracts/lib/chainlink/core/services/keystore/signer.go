@@ -0,0 +1,137 @@
+package keystore
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+)
+
+// SignerKeyType identifies a type of keys held by a Signer.
+type SignerKeyType string
+
+const (
+	// SignerKeyTypeEth keys are secp256k1 keys identified by their EIP55 address. Their public key is uncompressed,
+	// and they sign 32 bytes hashes with 65 bytes [R || S || V] signatures.
+	SignerKeyTypeEth SignerKeyType = "eth"
+	// SignerKeyTypeCSA keys are ed25519 keys identified by their hex encoded public key. They sign messages.
+	SignerKeyTypeCSA SignerKeyType = "csa"
+	// SignerKeyTypeOCR2 keys are the secp256k1 onchain keys of EVM OCR2 key bundles. Their public key is the 20 bytes
+	// onchain address, which matches them with the bundles, and they sign like SignerKeyTypeEth keys.
+	SignerKeyTypeOCR2 SignerKeyType = "ocr2"
+)
+
+// SignerKey is a key held by a Signer.
+type SignerKey struct {
+	ID        string `json:"id"`
+	PublicKey []byte `json:"publicKey"`
+}
+
+// Signer signs with keys held outside of the node process.
+type Signer interface {
+	// Keys returns the keys of keyType held by the signer.
+	Keys(ctx context.Context, keyType SignerKeyType) ([]SignerKey, error)
+	// Sign signs data with the key of keyType identified by id.
+	Sign(ctx context.Context, keyType SignerKeyType, id string, data []byte) ([]byte, error)
+}
+
+// Option configures the keystore.
+type Option func(km *keyManager)
+
+// WithSigner delegates signing to signer for the Eth, CSA and OCR2 keys it holds. Those keys are loaded from the
+// signer on Unlock, and used alongside the keys stored in the database. Signing requests are bounded by timeout,
+// unless it is zero. New EVM OCR2 key bundles use the OCR2 keys of the signer as onchain keys, see ocr2.Create.
+func WithSigner(signer Signer, timeout time.Duration) Option {
+	return func(km *keyManager) {
+		km.signer = signer
+		km.signerTimeout = timeout
+	}
+}
+
+// remoteKeys are the keys held by the Signer of the keystore.
+type remoteKeys struct {
+	eth  map[string]ethkey.KeyV2
+	csa  map[string]csakey.KeyV2
+	ocr2 map[common.Address]SignerKey // by onchain address
+}
+
+func newRemoteKeys() *remoteKeys {
+	return &remoteKeys{
+		eth:  map[string]ethkey.KeyV2{},
+		csa:  map[string]csakey.KeyV2{},
+		ocr2: map[common.Address]SignerKey{},
+	}
+}
+
+// remoteSign signs data with the key of keyType identified by id, held by the Signer.
+func (km *keyManager) remoteSign(ctx context.Context, keyType SignerKeyType, id string, data []byte) ([]byte, error) {
+	if km.signerTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, km.signerTimeout)
+		defer cancel()
+	}
+	return km.signer.Sign(ctx, keyType, id, data)
+}
+
+// loadRemoteKeys fetches the keys held by the Signer.
+func (km *keyManager) loadRemoteKeys(ctx context.Context) (*remoteKeys, error) {
+	rk := newRemoteKeys()
+	signer := km.signer
+
+	ethKeys, err := signer.Keys(ctx, SignerKeyTypeEth)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get eth keys from remote signer")
+	}
+	for _, k := range ethKeys {
+		pub, err := gethcrypto.UnmarshalPubkey(k.PublicKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid public key for eth key %s", k.ID)
+		}
+		address := gethcrypto.PubkeyToAddress(*pub)
+		if address.Hex() != k.ID {
+			return nil, errors.Errorf("eth key %s does not match its public key address %s", k.ID, address.Hex())
+		}
+		id := k.ID
+		rk.eth[id] = ethkey.NewRemoteV2(address, func(ctx context.Context, data []byte) ([]byte, error) {
+			return km.remoteSign(ctx, SignerKeyTypeEth, id, data)
+		})
+	}
+
+	csaKeys, err := signer.Keys(ctx, SignerKeyTypeCSA)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get CSA keys from remote signer")
+	}
+	for _, k := range csaKeys {
+		if len(k.PublicKey) != ed25519.PublicKeySize || hex.EncodeToString(k.PublicKey) != k.ID {
+			return nil, errors.Errorf("CSA key %s does not match its public key", k.ID)
+		}
+		id := k.ID
+		rk.csa[id] = csakey.NewRemoteV2(k.PublicKey, func(ctx context.Context, message []byte) ([]byte, error) {
+			return km.remoteSign(ctx, SignerKeyTypeCSA, id, message)
+		})
+	}
+
+	ocr2Keys, err := signer.Keys(ctx, SignerKeyTypeOCR2)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get OCR2 keys from remote signer")
+	}
+	for _, k := range ocr2Keys {
+		if len(k.PublicKey) != common.AddressLength {
+			return nil, errors.Errorf("OCR2 key %s must have a 20 bytes onchain address as public key", k.ID)
+		}
+		address := common.BytesToAddress(k.PublicKey)
+		if _, dup := rk.ocr2[address]; dup {
+			return nil, errors.Errorf("OCR2 key %s has the same onchain address %s as another key", k.ID, address.Hex())
+		}
+		rk.ocr2[address] = k
+	}
+
+	return rk, nil
+}
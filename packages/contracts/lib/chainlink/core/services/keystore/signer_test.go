@@ -0,0 +1,152 @@
+package keystore_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remote"
+)
+
+func Test_KeyStore_WithSigner(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+
+	signer := remote.NewLocalSigner()
+	ethKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ethID := signer.AddEthKey(ethKey)
+	_, csaKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	csaID := signer.AddCSAKey(csaKey)
+	ocr2Key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer.AddOCR2Key(ocr2Key)
+
+	keyStore := keystore.ExposedNewMaster(t, db, keystore.WithSigner(signer, time.Minute))
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+
+	t.Run("enables remote eth keys instead of creating keys", func(t *testing.T) {
+		chainID := big.NewInt(1337)
+		require.NoError(t, keyStore.Eth().EnsureKeys(ctx, chainID))
+
+		keys, err := keyStore.Eth().GetAll(ctx)
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		assert.Equal(t, ethID, keys[0].ID())
+		assert.True(t, keys[0].IsRemote())
+
+		addresses, err := keyStore.Eth().EnabledAddressesForChain(ctx, chainID)
+		require.NoError(t, err)
+		require.Len(t, addresses, 1)
+		assert.Equal(t, ethID, addresses[0].Hex())
+
+		hash := crypto.Keccak256([]byte("hello"))
+		sig, err := keystore.NewEthSigner(keyStore.Eth(), chainID).Sign(ctx, ethID, hash)
+		require.NoError(t, err)
+		pub, err := crypto.SigToPub(hash, sig)
+		require.NoError(t, err)
+		assert.Equal(t, ethID, crypto.PubkeyToAddress(*pub).Hex())
+	})
+
+	t.Run("remote eth keys cannot be exported or deleted", func(t *testing.T) {
+		_, err := keyStore.Eth().Export(ctx, ethID, cltest.Password)
+		require.Error(t, err)
+		_, err = keyStore.Eth().Delete(ctx, ethID)
+		require.Error(t, err)
+	})
+
+	t.Run("signs with remote CSA keys", func(t *testing.T) {
+		require.NoError(t, keyStore.CSA().EnsureKey(ctx))
+		keys, err := keyStore.CSA().GetAll()
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		assert.Equal(t, csaID, keys[0].ID())
+
+		sig, err := keystore.CSASigner{CSA: keyStore.CSA()}.Sign(ctx, csaID, []byte("hello"))
+		require.NoError(t, err)
+		assert.True(t, ed25519.Verify(keys[0].PublicKey, []byte("hello"), sig))
+	})
+
+	t.Run("creates EVM OCR2 key bundles without local onchain keys", func(t *testing.T) {
+		address := crypto.PubkeyToAddress(ocr2Key.PublicKey)
+		created, err := keyStore.OCR2().Create(ctx, chaintype.EVM)
+		require.NoError(t, err)
+		assert.Equal(t, hex.EncodeToString(address[:]), created.OnChainPublicKey())
+
+		var raw struct {
+			Keyring          []byte
+			RemoteOnchainKey bool
+		}
+		b, err := created.Marshal()
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(b, &raw))
+		assert.True(t, raw.RemoteOnchainKey)
+		assert.Equal(t, address[:], raw.Keyring)
+
+		kb, err := keyStore.OCR2().Get(created.ID())
+		require.NoError(t, err)
+		report := []byte("report")
+		sig, err := kb.Sign3([32]byte{1}, 2, report)
+		require.NoError(t, err)
+		assert.True(t, kb.Verify3(kb.PublicKey(), [32]byte{1}, 2, report, sig))
+
+		_, err = keyStore.OCR2().Create(ctx, chaintype.EVM)
+		require.ErrorContains(t, err, "all 1 OCR2 keys of the remote signer are used by key bundles")
+
+		solana, err := keyStore.OCR2().Create(ctx, chaintype.Solana)
+		require.NoError(t, err)
+		assert.NotEmpty(t, solana.OnChainPublicKey())
+	})
+}
+
+// blockingSigner blocks signing requests until their context is done.
+type blockingSigner struct {
+	keystore.Signer
+}
+
+func (s blockingSigner) Sign(ctx context.Context, _ keystore.SignerKeyType, _ string, _ []byte) ([]byte, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func Test_KeyStore_WithSigner_Context(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+
+	signer := remote.NewLocalSigner()
+	ethKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ethID := signer.AddEthKey(ethKey)
+
+	keyStore := keystore.ExposedNewMaster(t, db, keystore.WithSigner(blockingSigner{signer}, 10*time.Millisecond))
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	key, err := keyStore.Eth().Get(ctx, ethID)
+	require.NoError(t, err)
+	hash := crypto.Keccak256([]byte("hello"))
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = key.SignContext(canceled, hash)
+	require.ErrorIs(t, err, context.Canceled)
+
+	_, err = key.Sign(hash)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -33,6 +33,9 @@ type channelDefinitionCacheFactory struct {
 }
 
 func (f *channelDefinitionCacheFactory) NewCache(cfg lloconfig.PluginConfig) (llotypes.ChannelDefinitionCache, error) {
+	if cfg.ChannelDefinitionsSource != nil {
+		return NewSourceChannelDefinitionCache(f.lggr, f.orm, f.client, *cfg.ChannelDefinitionsSource, cfg.DonID)
+	}
 	if cfg.ChannelDefinitions != "" {
 		return NewStaticChannelDefinitionCache(f.lggr, cfg.ChannelDefinitions)
 	}
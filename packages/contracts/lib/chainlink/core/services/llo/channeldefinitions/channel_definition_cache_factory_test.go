@@ -23,6 +23,18 @@ func Test_ChannelDefinitionCacheFactory(t *testing.T) {
 			require.NoError(t, err)
 			require.IsType(t, &staticCDC{}, cdc)
 		})
+		t.Run("when ChannelDefinitionsSource is present, returns source cache", func(t *testing.T) {
+			_, err := cdcFactory.NewCache(lloconfig.PluginConfig{ChannelDefinitionsSource: &lloconfig.ChannelDefinitionsSource{URL: "ftp://example.com/definitions.json"}})
+			require.EqualError(t, err, `llo: ChannelDefinitionsSource URL scheme must be file or s3, got: "ftp://example.com/definitions.json"`)
+
+			cdc, err := cdcFactory.NewCache(lloconfig.PluginConfig{
+				ChannelDefinitions:       "...",
+				ChannelDefinitionsSource: &lloconfig.ChannelDefinitionsSource{URL: "s3://llo/definitions.json"},
+				DonID:                    1,
+			})
+			require.NoError(t, err)
+			require.IsType(t, &sourceChannelDefinitionCache{}, cdc)
+		})
 		t.Run("when ChannelDefinitions is not present, returns dynamic cache", func(t *testing.T) {
 			cdc, err := cdcFactory.NewCache(lloconfig.PluginConfig{
 				ChannelDefinitionsContractAddress: common.HexToAddress("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"),
@@ -0,0 +1,237 @@
+package channeldefinitions
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/types"
+	lloconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/llo/config"
+)
+
+// A CDC that loads the channel definitions from a local file or an
+// S3-compatible store, for DONs without a ChannelConfigStore contract. The
+// source is polled and the definitions are reloaded whenever they change;
+// every change bumps the version of the definitions, which are persisted
+// like the on-chain ones.
+
+const defaultSourcePollInterval = 10 * time.Second
+
+var _ llotypes.ChannelDefinitionCache = &sourceChannelDefinitionCache{}
+
+type sourceChannelDefinitionCache struct {
+	services.Service
+	eng *services.Engine
+
+	orm          ChannelDefinitionCacheORM
+	store        definitionsStore
+	cfg          lloconfig.ChannelDefinitionsSource
+	pin          []byte
+	pollInterval time.Duration
+	addr         common.Address
+	donID        uint32
+
+	definitionsMu      sync.RWMutex
+	definitions        llotypes.ChannelDefinitions
+	definitionsVersion uint32
+	// definitionsSha is the SHA256 of the last loaded source content
+	definitionsSha [32]byte
+
+	// only accessed by the poll loop
+	persistedVersion uint32
+}
+
+func NewSourceChannelDefinitionCache(lggr logger.Logger, orm ChannelDefinitionCacheORM, client HTTPClient, cfg lloconfig.ChannelDefinitionsSource, donID uint32) (llotypes.ChannelDefinitionCache, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	store, err := newDefinitionsStore(client, cfg.URL, cfg.S3Endpoint, cfg.S3Region)
+	if err != nil {
+		return nil, err
+	}
+	return newSourceChannelDefinitionCache(lggr, orm, store, cfg, donID), nil
+}
+
+func newSourceChannelDefinitionCache(lggr logger.Logger, orm ChannelDefinitionCacheORM, store definitionsStore, cfg lloconfig.ChannelDefinitionsSource, donID uint32) *sourceChannelDefinitionCache {
+	c := &sourceChannelDefinitionCache{
+		orm:          orm,
+		store:        store,
+		cfg:          cfg,
+		pollInterval: defaultSourcePollInterval,
+		addr:         types.ChannelDefinitionsSourceAddress(cfg.URL),
+		donID:        donID,
+	}
+	if cfg.SHA256 != "" {
+		// validated by cfg.Validate
+		c.pin, _ = hex.DecodeString(cfg.SHA256)
+	}
+	if cfg.PollIntervalMs > 0 {
+		c.pollInterval = time.Duration(cfg.PollIntervalMs) * time.Millisecond
+	}
+	c.Service, c.eng = services.Config{
+		Name:  "SourceChannelDefinitionCache",
+		Start: c.start,
+	}.NewServiceEngine(logger.With(lggr, "url", cfg.URL, "donID", donID))
+	return c
+}
+
+func (c *sourceChannelDefinitionCache) start(ctx context.Context) error {
+	// Initial load from DB, then poll the source thereafter
+	pd, err := c.orm.LoadChannelDefinitions(ctx, c.addr, c.donID)
+	if err != nil {
+		return err
+	}
+	if pd != nil {
+		c.definitions = pd.Definitions
+		c.definitionsVersion = pd.Version
+		c.persistedVersion = pd.Version
+	} else {
+		c.definitions = make(llotypes.ChannelDefinitions)
+	}
+	c.eng.Go(c.pollLoop)
+	return nil
+}
+
+func (c *sourceChannelDefinitionCache) pollLoop(ctx context.Context) {
+	pollT := services.NewTicker(c.pollInterval)
+	defer pollT.Stop()
+
+	for {
+		// failures will be tried again on the next tick
+		if err := c.fetchAndSetChannelDefinitions(ctx); err != nil {
+			c.eng.Warnw("Error while loading channel definitions", "err", err)
+		}
+		if err := c.persist(ctx); err != nil {
+			c.eng.Warnw("Failed to persist channel definitions", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			// Try one final persist with a short-ish timeout, then return
+			ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+			defer cancel()
+			if err := c.persist(ctx); err != nil {
+				c.eng.Errorw("Failed to persist channel definitions on shutdown", "err", err)
+			}
+			return
+		case <-pollT.C:
+		}
+	}
+}
+
+func (c *sourceChannelDefinitionCache) fetchAndSetChannelDefinitions(ctx context.Context) error {
+	expectedSha := c.pin
+	if c.cfg.SHA256URL != "" {
+		b, err := c.store.Get(ctx, c.cfg.SHA256URL)
+		if err != nil {
+			return fmt.Errorf("failed to read SHA256: %w", err)
+		}
+		// accept the output of sha256sum, i.e. the hash followed by the file name
+		fields := strings.Fields(string(b))
+		if len(fields) > 0 {
+			expectedSha, err = hex.DecodeString(fields[0])
+		}
+		if len(fields) == 0 || err != nil || len(expectedSha) != sha256.Size {
+			return fmt.Errorf("invalid SHA256 in %s", c.cfg.SHA256URL)
+		}
+	}
+
+	b, err := c.store.Get(ctx, c.cfg.URL)
+	if err != nil {
+		return fmt.Errorf("failed to read channel definitions: %w", err)
+	}
+	actualSha := sha256.Sum256(b)
+	if expectedSha != nil && !bytes.Equal(expectedSha, actualSha[:]) {
+		return fmt.Errorf("SHA256 mismatch: expected %x, got %x", expectedSha, actualSha)
+	}
+
+	c.definitionsMu.RLock()
+	unchanged := actualSha == c.definitionsSha
+	c.definitionsMu.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	var dfns llotypes.ChannelDefinitions
+	if err = json.Unmarshal(b, &dfns); err != nil {
+		return fmt.Errorf("failed to decode JSON: %w", err)
+	}
+
+	c.definitionsMu.Lock()
+	defer c.definitionsMu.Unlock()
+	c.definitionsSha = actualSha
+	if equal, err := equalChannelDefinitions(c.definitions, dfns); err != nil {
+		return err
+	} else if equal {
+		// e.g. the definitions were persisted before a restart
+		return nil
+	}
+	c.definitions = dfns
+	c.definitionsVersion++
+	c.eng.Infow("Loaded new channel definitions", "version", c.definitionsVersion, "sha", fmt.Sprintf("%x", actualSha), "nChannels", len(dfns))
+	return nil
+}
+
+// equalChannelDefinitions compares the definitions regardless of the
+// formatting of their opts, which the database does not preserve
+func equalChannelDefinitions(a, b llotypes.ChannelDefinitions) (bool, error) {
+	if len(a) != len(b) {
+		return false, nil
+	}
+	normalize := func(dfns llotypes.ChannelDefinitions) (v any, err error) {
+		raw, err := json.Marshal(dfns)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode channel definitions: %w", err)
+		}
+		d := json.NewDecoder(bytes.NewReader(raw))
+		d.UseNumber()
+		err = d.Decode(&v)
+		return v, err
+	}
+	na, err := normalize(a)
+	if err != nil {
+		return false, err
+	}
+	nb, err := normalize(b)
+	if err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(na, nb), nil
+}
+
+func (c *sourceChannelDefinitionCache) persist(ctx context.Context) error {
+	c.definitionsMu.RLock()
+	version := c.definitionsVersion
+	dfns := c.definitions
+	c.definitionsMu.RUnlock()
+
+	if version <= c.persistedVersion {
+		return nil
+	}
+	// There is no block number for definitions which do not come from chain
+	if err := c.orm.StoreChannelDefinitions(ctx, c.addr, c.donID, version, dfns, 0); err != nil {
+		return err
+	}
+	c.persistedVersion = version
+	return nil
+}
+
+func (c *sourceChannelDefinitionCache) Definitions() llotypes.ChannelDefinitions {
+	c.definitionsMu.RLock()
+	defer c.definitionsMu.RUnlock()
+	return maps.Clone(c.definitions)
+}
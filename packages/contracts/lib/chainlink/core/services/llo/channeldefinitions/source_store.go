@@ -0,0 +1,224 @@
+package channeldefinitions
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	defaultS3Region = "us-east-1"
+	// emptyPayloadSHA256 is the hex-encoded SHA256 of an empty request body
+	emptyPayloadSHA256 = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// definitionsStore reads the channel definitions and their pinned SHA256 from
+// a ChannelDefinitionsSource
+type definitionsStore interface {
+	Get(ctx context.Context, location string) ([]byte, error)
+}
+
+func newDefinitionsStore(client HTTPClient, sourceURL, s3Endpoint, s3Region string) (definitionsStore, error) {
+	u, err := url.Parse(sourceURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid source URL: %w", err)
+	}
+	switch u.Scheme {
+	case "file":
+		return fileStore{}, nil
+	case "s3":
+		if s3Region == "" {
+			s3Region = defaultS3Region
+		}
+		if s3Endpoint == "" {
+			s3Endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", s3Region)
+		}
+		endpoint, err := url.Parse(s3Endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid S3 endpoint: %w", err)
+		}
+		return &s3Store{client: client, endpoint: endpoint, region: s3Region, now: time.Now}, nil
+	default:
+		return nil, fmt.Errorf("unsupported source URL scheme: %q", u.Scheme)
+	}
+}
+
+type fileStore struct{}
+
+func (fileStore) Get(_ context.Context, location string) ([]byte, error) {
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid file URL: %w", err)
+	}
+	f, err := os.Open(u.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return readLimited(f)
+}
+
+// s3Store reads objects from an S3-compatible store with path-style requests,
+// which are supported by AWS as well as by self-hosted stores like MinIO.
+type s3Store struct {
+	client   HTTPClient
+	endpoint *url.URL
+	region   string
+	now      func() time.Time
+}
+
+type s3Credentials struct {
+	accessKeyID     string
+	secretAccessKey string
+	sessionToken    string
+}
+
+// s3CredentialsFromEnv reads the credentials on every request, so that
+// rotated credentials are picked up without restarting the job
+func s3CredentialsFromEnv() s3Credentials {
+	return s3Credentials{
+		accessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		secretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		sessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+	}
+}
+
+func (s *s3Store) Get(ctx context.Context, location string) ([]byte, error) {
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid S3 URL: %w", err)
+	}
+	key := strings.TrimPrefix(u.Path, "/")
+	if u.Host == "" || key == "" {
+		return nil, fmt.Errorf("invalid S3 URL %q: expected s3://bucket/key", location)
+	}
+
+	objectURL := *s.endpoint
+	objectURL.Path = strings.TrimSuffix(objectURL.Path, "/") + "/" + u.Host + "/" + key
+	objectURL.RawPath = s3URIEncode(objectURL.Path, false)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, objectURL.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create http.Request; %w", err)
+	}
+	if creds := s3CredentialsFromEnv(); creds.accessKeyID != "" {
+		signS3Request(req, creds, s.region, s.now())
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making http request: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		// NOTE: Truncate the returned body here as we don't want to spam the
+		// logs with potentially huge messages
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("got error from %s: (status code: %d, response body: %s)", location, resp.StatusCode, body)
+	}
+	return readLimited(resp.Body)
+}
+
+func readLimited(r io.Reader) ([]byte, error) {
+	b, err := io.ReadAll(io.LimitReader(r, MaxChannelDefinitionsFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) > MaxChannelDefinitionsFileSize {
+		return nil, errors.New("channel definitions exceed the maximum size of 25MB")
+	}
+	return b, nil
+}
+
+// signS3Request signs a request without body with AWS Signature Version 4.
+// All the headers of the request are signed.
+func signS3Request(req *http.Request, creds s3Credentials, region string, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", emptyPayloadSHA256)
+	if creds.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", creds.sessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		trimmed := make([]string, len(values))
+		for i, v := range values {
+			trimmed[i] = strings.Join(strings.Fields(v), " ")
+		}
+		headers[strings.ToLower(name)] = strings.Join(trimmed, ",")
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		s3URIEncode(req.URL.Path, false),
+		s3CanonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		emptyPayloadSHA256,
+	}, "\n")
+	scope := date + "/" + region + "/s3/aws4_request"
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(canonicalRequestHash[:])
+
+	key := hmacSHA256([]byte("AWS4"+creds.secretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s", creds.accessKeyID, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func s3CanonicalQuery(query url.Values) string {
+	params := make([]string, 0, len(query))
+	for name, values := range query {
+		for _, v := range values {
+			params = append(params, s3URIEncode(name, true)+"="+s3URIEncode(v, true))
+		}
+	}
+	sort.Strings(params)
+	return strings.Join(params, "&")
+}
+
+// s3URIEncode escapes every byte but the unreserved characters, as required
+// by the canonical request of Signature Version 4
+func s3URIEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+			c == '-' || c == '.' || c == '_' || c == '~' || (c == '/' && !encodeSlash) {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
@@ -0,0 +1,299 @@
+// Package decoder decodes LLO reports to human-readable JSON and verifies
+// their signatures offline, for debugging.
+package decoder
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"google.golang.org/protobuf/proto"
+
+	commonds "github.com/smartcontractkit/chainlink-common/pkg/capabilities/datastreams"
+	capabilitiespb "github.com/smartcontractkit/chainlink-common/pkg/capabilities/pb"
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+	lloevm "github.com/smartcontractkit/chainlink-data-streams/llo/reportcodecs/evm"
+)
+
+// DecodedReport is the human-readable form of a report.
+type DecodedReport struct {
+	ReportFormat string `json:"reportFormat"`
+	// ChannelID is set if the report encodes it
+	ChannelID *llotypes.ChannelID `json:"channelID,omitempty"`
+	// Fields are the decoded fields of the report. Integers which may not fit
+	// in a float64 are formatted as decimal strings and bytes as hex strings.
+	Fields map[string]any `json:"fields"`
+}
+
+// abiOpts is the part of the channel options of the EVM formats which
+// describes how the stream values are encoded.
+type abiOpts struct {
+	ABI []struct {
+		Type string `json:"type"`
+	} `json:"abi"`
+}
+
+var (
+	premiumLegacySchema = append(append(abi.Arguments{}, lloevm.BaseSchema...),
+		abi.Argument{Name: "benchmarkPrice", Type: mustNewType("int192")},
+		abi.Argument{Name: "bid", Type: mustNewType("int192")},
+		abi.Argument{Name: "ask", Type: mustNewType("int192")},
+	)
+	packedIntRegexp = regexp.MustCompile(`^(u?)int([0-9]+)$`)
+)
+
+const (
+	// baseSchemaSize is the size of the ABI encoded header of the EVM reports
+	baseSchemaSize = 6 * 32
+	// streamlinedHeaderSize is the size of the report format, channel ID and timestamp of EVMStreamlined reports
+	streamlinedHeaderSize = 4 + 4 + 8
+)
+
+func mustNewType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
+
+// Decode decodes a report of the given format. The channel definition is
+// optional; it is used to decode the stream values of the EVMABIEncodeUnpacked
+// and EVMStreamlined reports, which are otherwise returned as hex strings.
+func Decode(rf llotypes.ReportFormat, report []byte, cd *llotypes.ChannelDefinition) (*DecodedReport, error) {
+	d := &DecodedReport{ReportFormat: rf.String()}
+	var err error
+	switch rf {
+	case llotypes.ReportFormatJSON:
+		err = d.decodeJSON(report)
+	case llotypes.ReportFormatEVMPremiumLegacy:
+		d.Fields, err = unpackABI(premiumLegacySchema, report)
+	case llotypes.ReportFormatEVMABIEncodeUnpacked:
+		err = d.decodeEVMABIEncodeUnpacked(report, cd)
+	case llotypes.ReportFormatEVMStreamlined:
+		err = d.decodeEVMStreamlined(report, cd)
+	case llotypes.ReportFormatCapabilityTrigger:
+		err = d.decodeCapabilityTrigger(report)
+	default:
+		return nil, fmt.Errorf("unsupported report format: %s", rf)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s report: %w", rf, err)
+	}
+	return d, nil
+}
+
+func (d *DecodedReport) decodeJSON(report []byte) error {
+	r, err := (llo.JSONReportCodec{}).Decode(report)
+	if err != nil {
+		return err
+	}
+	d.ChannelID = &r.ChannelID
+	// the report is JSON already; keep it as is, without losing precision
+	dec := json.NewDecoder(bytes.NewReader(report))
+	dec.UseNumber()
+	return dec.Decode(&d.Fields)
+}
+
+func (d *DecodedReport) decodeEVMABIEncodeUnpacked(report []byte, cd *llotypes.ChannelDefinition) error {
+	if len(report) < baseSchemaSize {
+		return fmt.Errorf("report is too short: %d bytes", len(report))
+	}
+	fields, err := unpackABI(lloevm.BaseSchema, report[:baseSchemaSize])
+	if err != nil {
+		return err
+	}
+	d.Fields = fields
+	payload := report[baseSchemaSize:]
+
+	types, err := channelABITypes(cd)
+	if err != nil {
+		return err
+	}
+	if types == nil {
+		words := make([]string, 0, len(payload)/32)
+		for i := 0; i+32 <= len(payload); i += 32 {
+			words = append(words, hexutil.Encode(payload[i:i+32]))
+		}
+		d.Fields["values"] = words
+		return nil
+	}
+	// the first two streams of the channel are the native and LINK prices of the fees
+	var streams []llotypes.Stream
+	if len(cd.Streams) > 2 {
+		streams = cd.Streams[2:]
+	}
+	args := make(abi.Arguments, len(types))
+	for i, t := range types {
+		typ, err := abi.NewType(t, "", nil)
+		if err != nil {
+			return fmt.Errorf("invalid ABI type %q of value %d: %w", t, i, err)
+		}
+		args[i] = abi.Argument{Name: valueName(streams, i), Type: typ}
+	}
+	unpacked, err := args.Unpack(payload)
+	if err != nil {
+		return fmt.Errorf("failed to unpack values: %w", err)
+	}
+	d.Fields["values"] = namedValues(streams, unpacked)
+	return nil
+}
+
+func (d *DecodedReport) decodeEVMStreamlined(report []byte, cd *llotypes.ChannelDefinition) error {
+	if len(report) < streamlinedHeaderSize {
+		return fmt.Errorf("report is too short: %d bytes", len(report))
+	}
+	if rf := binary.BigEndian.Uint32(report[:4]); rf != uint32(llotypes.ReportFormatEVMStreamlined) {
+		return fmt.Errorf("unexpected report format %d in header", rf)
+	}
+	channelID := binary.BigEndian.Uint32(report[4:8])
+	d.ChannelID = &channelID
+	d.Fields = map[string]any{
+		"channelID": channelID,
+		"timestamp": strconv.FormatUint(binary.BigEndian.Uint64(report[8:16]), 10),
+	}
+	payload := report[streamlinedHeaderSize:]
+
+	types, err := channelABITypes(cd)
+	if err != nil {
+		return err
+	}
+	if types == nil {
+		d.Fields["values"] = hexutil.Encode(payload)
+		return nil
+	}
+	unpacked := make([]any, len(types))
+	for i, t := range types {
+		m := packedIntRegexp.FindStringSubmatch(t)
+		if m == nil {
+			return fmt.Errorf("unsupported packed type %q of value %d", t, i)
+		}
+		bits, _ := strconv.Atoi(m[2])
+		if bits == 0 || bits > 256 || bits%8 != 0 {
+			return fmt.Errorf("unsupported packed type %q of value %d", t, i)
+		}
+		size := bits / 8
+		if len(payload) < size {
+			return fmt.Errorf("payload is too short for value %d of type %s", i, t)
+		}
+		v := new(big.Int).SetBytes(payload[:size])
+		if m[1] == "" && payload[0]&0x80 != 0 {
+			// two's complement
+			v.Sub(v, new(big.Int).Lsh(big.NewInt(1), uint(bits)))
+		}
+		unpacked[i] = v
+		payload = payload[size:]
+	}
+	if len(payload) > 0 {
+		return fmt.Errorf("%d unexpected trailing bytes", len(payload))
+	}
+	d.Fields["values"] = namedValues(cd.Streams, unpacked)
+	return nil
+}
+
+func (d *DecodedReport) decodeCapabilityTrigger(report []byte) error {
+	var p capabilitiespb.OCRTriggerReport
+	if err := proto.Unmarshal(report, &p); err != nil {
+		return err
+	}
+	outputs, err := values.FromMapValueProto(p.Outputs)
+	if err != nil {
+		return fmt.Errorf("failed to decode outputs: %w", err)
+	}
+	var event commonds.LLOStreamsTriggerEvent
+	if err = outputs.UnwrapTo(&event); err != nil {
+		return fmt.Errorf("failed to unwrap outputs: %w", err)
+	}
+	streams := make([]map[string]any, len(event.Payload))
+	for i, s := range event.Payload {
+		stream := map[string]any{"streamID": s.StreamID}
+		if len(s.Decimal) > 0 {
+			var v llo.Decimal
+			if err = v.UnmarshalBinary(s.Decimal); err != nil {
+				return fmt.Errorf("failed to decode value of stream %d: %w", s.StreamID, err)
+			}
+			stream["decimal"] = v.Decimal().String()
+		}
+		streams[i] = stream
+	}
+	d.Fields = map[string]any{
+		"eventID":                         p.EventID,
+		"timestamp":                       strconv.FormatUint(p.Timestamp, 10),
+		"observationTimestampNanoseconds": strconv.FormatUint(event.ObservationTimestampNanoseconds, 10),
+		"payload":                         streams,
+	}
+	return nil
+}
+
+// channelABITypes returns the types of the stream values from the channel
+// options, or nil without channel definition.
+func channelABITypes(cd *llotypes.ChannelDefinition) ([]string, error) {
+	if cd == nil {
+		return nil, nil
+	}
+	var opts abiOpts
+	if err := json.Unmarshal(cd.Opts, &opts); err != nil {
+		return nil, fmt.Errorf("failed to parse channel options: %w", err)
+	}
+	types := make([]string, len(opts.ABI))
+	for i, enc := range opts.ABI {
+		types[i] = enc.Type
+	}
+	return types, nil
+}
+
+// valueName names the value by its stream ID, if the channel has one stream per value.
+func valueName(streams []llotypes.Stream, i int) string {
+	if i < len(streams) {
+		return "stream" + strconv.FormatUint(uint64(streams[i].StreamID), 10)
+	}
+	return "value" + strconv.Itoa(i)
+}
+
+func namedValues(streams []llotypes.Stream, unpacked []any) []map[string]any {
+	named := make([]map[string]any, len(unpacked))
+	for i, v := range unpacked {
+		named[i] = map[string]any{"name": valueName(streams, i), "value": humanize(v)}
+		if i < len(streams) {
+			named[i]["streamID"] = streams[i].StreamID
+		}
+	}
+	return named
+}
+
+func unpackABI(args abi.Arguments, report []byte) (map[string]any, error) {
+	fields := make(map[string]any)
+	if err := args.UnpackIntoMap(fields, report); err != nil {
+		return nil, err
+	}
+	for k, v := range fields {
+		fields[k] = humanize(v)
+	}
+	return fields, nil
+}
+
+// humanize formats the values which JSON would not render readably.
+func humanize(v any) any {
+	switch v := v.(type) {
+	case *big.Int:
+		return v.String()
+	case [32]byte:
+		return hexutil.Encode(v[:])
+	case []byte:
+		return hexutil.Encode(v)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	default:
+		return v
+	}
+}
@@ -0,0 +1,244 @@
+package decoder_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+	lloevm "github.com/smartcontractkit/chainlink-data-streams/llo/reportcodecs/evm"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+	corello "github.com/smartcontractkit/chainlink/v2/core/services/llo"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/cre"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/decoder"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/retirement"
+)
+
+const donID = 4
+
+var feedID = [32]byte{0xfe, 0xed}
+
+func newReport(channelID llotypes.ChannelID, values ...int64) llo.Report {
+	r := llo.Report{
+		ConfigDigest:                    ocrtypes.ConfigDigest{1, 2, 3},
+		SeqNr:                           32,
+		ChannelID:                       channelID,
+		ValidAfterNanoseconds:           1_699_999_999_000_000_000,
+		ObservationTimestampNanoseconds: 1_700_000_000_000_000_000,
+	}
+	for _, v := range values {
+		r.Values = append(r.Values, llo.ToDecimal(decimal.NewFromInt(v)))
+	}
+	return r
+}
+
+func mustJSON(t *testing.T, v any) string {
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+	return string(b)
+}
+
+func mustABIEncoder(t *testing.T, typ string) (enc lloevm.ABIEncoder) {
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"`+typ+`"}`), &enc))
+	return enc
+}
+
+func Test_Decode(t *testing.T) {
+	t.Run("json", func(t *testing.T) {
+		report, err := llo.JSONReportCodec{}.Encode(newReport(31, 35), llotypes.ChannelDefinition{})
+		require.NoError(t, err)
+
+		d, err := decoder.Decode(llotypes.ReportFormatJSON, report, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "json", d.ReportFormat)
+		require.NotNil(t, d.ChannelID)
+		assert.Equal(t, llotypes.ChannelID(31), *d.ChannelID)
+		assert.Equal(t, json.Number("32"), d.Fields["SeqNr"])
+
+		_, err = decoder.Decode(llotypes.ReportFormatJSON, []byte("not json"), nil)
+		require.ErrorContains(t, err, "failed to decode json report")
+	})
+
+	t.Run("evm_premium_legacy", func(t *testing.T) {
+		cd := llotypes.ChannelDefinition{
+			ReportFormat: llotypes.ReportFormatEVMPremiumLegacy,
+			Streams:      []llotypes.Stream{{StreamID: 1}, {StreamID: 2}, {StreamID: 3}},
+			Opts:         []byte(`{"baseUSDFee":"0.1","expirationWindow":3600,"feedId":"0xfeed000000000000000000000000000000000000000000000000000000000000","multiplier":"1"}`),
+		}
+		r := newReport(3, 12, 13)
+		r.Values = append(r.Values, &llo.Quote{Bid: decimal.NewFromInt(16), Benchmark: decimal.NewFromInt(17), Ask: decimal.NewFromInt(18)})
+		report, err := lloevm.NewReportCodecPremiumLegacy(logger.TestLogger(t), donID).Encode(r, cd)
+		require.NoError(t, err)
+		expected, err := lloevm.ReportCodecPremiumLegacy{}.Decode(report)
+		require.NoError(t, err)
+
+		d, err := decoder.Decode(llotypes.ReportFormatEVMPremiumLegacy, report, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"feedId":                hexutil.Encode(feedID[:]),
+			"validFromTimestamp":    expected.ValidFromTimestamp,
+			"observationsTimestamp": uint32(1_700_000_000),
+			"nativeFee":             expected.NativeFee.String(),
+			"linkFee":               expected.LinkFee.String(),
+			"expiresAt":             expected.ExpiresAt,
+			"benchmarkPrice":        "17",
+			"bid":                   "16",
+			"ask":                   "18",
+		}, d.Fields)
+	})
+
+	t.Run("evm_abi_encode_unpacked", func(t *testing.T) {
+		cd := llotypes.ChannelDefinition{
+			ReportFormat: llotypes.ReportFormatEVMABIEncodeUnpacked,
+			Streams:      []llotypes.Stream{{StreamID: 1}, {StreamID: 2}, {StreamID: 3}, {StreamID: 4}},
+			Opts: []byte(mustJSON(t, lloevm.ReportFormatEVMABIEncodeOpts{
+				BaseUSDFee:       decimal.NewFromFloat32(0.1),
+				ExpirationWindow: 3600,
+				FeedID:           feedID,
+				ABI:              []lloevm.ABIEncoder{mustABIEncoder(t, "int192"), mustABIEncoder(t, "uint32")},
+			})),
+		}
+		report, err := lloevm.NewReportCodecEVMABIEncodeUnpacked(logger.TestLogger(t), donID).Encode(newReport(4, 12, 13, -15, 2), cd)
+		require.NoError(t, err)
+
+		d, err := decoder.Decode(llotypes.ReportFormatEVMABIEncodeUnpacked, report, nil)
+		require.NoError(t, err)
+		assert.Equal(t, hexutil.Encode(feedID[:]), d.Fields["feedId"])
+		assert.Equal(t, uint32(1_700_000_000), d.Fields["observationsTimestamp"])
+		assert.Len(t, d.Fields["values"], 2)
+
+		d, err = decoder.Decode(llotypes.ReportFormatEVMABIEncodeUnpacked, report, &cd)
+		require.NoError(t, err)
+		assert.JSONEq(t, `[
+			{"name": "stream3", "streamID": 3, "value": "-15"},
+			{"name": "stream4", "streamID": 4, "value": 2}
+		]`, mustJSON(t, d.Fields["values"]))
+	})
+
+	t.Run("evm_streamlined", func(t *testing.T) {
+		cd := llotypes.ChannelDefinition{
+			ReportFormat: llotypes.ReportFormatEVMStreamlined,
+			Streams:      []llotypes.Stream{{StreamID: 1}, {StreamID: 2}},
+			Opts: []byte(mustJSON(t, lloevm.ReportFormatEVMStreamlinedOpts{
+				ABI: []lloevm.ABIEncoder{mustABIEncoder(t, "int128"), mustABIEncoder(t, "uint8")},
+			})),
+		}
+		report, err := lloevm.NewReportCodecStreamlined().Encode(newReport(5, -123, 23), cd)
+		require.NoError(t, err)
+
+		d, err := decoder.Decode(llotypes.ReportFormatEVMStreamlined, report, nil)
+		require.NoError(t, err)
+		require.NotNil(t, d.ChannelID)
+		assert.Equal(t, llotypes.ChannelID(5), *d.ChannelID)
+		assert.Equal(t, "0xffffffffffffffffffffffffffffff8517", d.Fields["values"])
+
+		d, err = decoder.Decode(llotypes.ReportFormatEVMStreamlined, report, &cd)
+		require.NoError(t, err)
+		assert.JSONEq(t, `[
+			{"name": "stream1", "streamID": 1, "value": "-123"},
+			{"name": "stream2", "streamID": 2, "value": "23"}
+		]`, mustJSON(t, d.Fields["values"]))
+
+		cd.Opts = []byte(`{"abi":[{"type":"int128"}]}`)
+		_, err = decoder.Decode(llotypes.ReportFormatEVMStreamlined, report, &cd)
+		require.ErrorContains(t, err, "1 unexpected trailing bytes")
+	})
+
+	t.Run("capability_trigger", func(t *testing.T) {
+		cd := llotypes.ChannelDefinition{
+			ReportFormat: llotypes.ReportFormatCapabilityTrigger,
+			Streams:      []llotypes.Stream{{StreamID: 1}, {StreamID: 2}},
+		}
+		report, err := cre.NewReportCodecCapabilityTrigger(logger.TestLogger(t), donID).Encode(newReport(7, 35, 36), cd)
+		require.NoError(t, err)
+
+		d, err := decoder.Decode(llotypes.ReportFormatCapabilityTrigger, report, nil)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"eventID": "streams_4_1700000000000000000",
+			"timestamp": "1700000000000000000",
+			"observationTimestampNanoseconds": "1700000000000000000",
+			"payload": [{"streamID": 1, "decimal": "35"}, {"streamID": 2, "decimal": "36"}]
+		}`, mustJSON(t, d.Fields))
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		_, err := decoder.Decode(llotypes.ReportFormatRetirement, nil, nil)
+		require.EqualError(t, err, "unsupported report format: retirement")
+	})
+}
+
+func Test_Verify(t *testing.T) {
+	digest := ocrtypes.ConfigDigest{1, 2, 3}
+	const seqNr = 42
+	report := ocr3types.ReportWithInfo[llotypes.ReportInfo]{
+		Report: []byte("report"),
+		Info:   llotypes.ReportInfo{ReportFormat: llotypes.ReportFormatEVMPremiumLegacy},
+	}
+
+	config := retirement.Config{Digest: digest, F: 1}
+	var sigs []ocrtypes.AttributedOnchainSignature
+	for i := range 3 {
+		kb, err := ocr2key.New(chaintype.EVM)
+		require.NoError(t, err)
+		config.Signers = append(config.Signers, kb.PublicKey())
+		keyring := corello.NewOnchainKeyring(logger.TestLogger(t), map[llotypes.ReportFormat]corello.Key{report.Info.ReportFormat: kb}, donID)
+		sig, err := keyring.Sign(digest, seqNr, report)
+		require.NoError(t, err)
+		sigs = append(sigs, ocrtypes.AttributedOnchainSignature{Signer: commontypes.OracleID(i), Signature: sig})
+	}
+
+	v, err := decoder.Verify(config, donID, digest, seqNr, report, sigs)
+	require.NoError(t, err)
+	assert.Equal(t, 3, v.ValidSignatures)
+	assert.True(t, v.Quorum)
+
+	t.Run("invalid signatures", func(t *testing.T) {
+		// signed for another DON
+		v, err := decoder.Verify(config, donID+1, digest, seqNr, report, sigs)
+		require.NoError(t, err)
+		assert.Zero(t, v.ValidSignatures)
+		assert.False(t, v.Quorum)
+
+		// unknown and swapped signers
+		wrongSigs := []ocrtypes.AttributedOnchainSignature{
+			{Signer: 3, Signature: sigs[0].Signature},
+			{Signer: 1, Signature: sigs[0].Signature},
+			sigs[2],
+		}
+		v, err = decoder.Verify(config, donID, digest, seqNr, report, wrongSigs)
+		require.NoError(t, err)
+		assert.Equal(t, "signer index out of bounds (max: 2)", v.Signatures[0].Error)
+		assert.False(t, v.Signatures[1].Valid)
+		assert.True(t, v.Signatures[2].Valid)
+		assert.Equal(t, 1, v.ValidSignatures)
+		assert.False(t, v.Quorum)
+	})
+
+	t.Run("duplicate signatures", func(t *testing.T) {
+		v, err := decoder.Verify(config, donID, digest, seqNr, report, []ocrtypes.AttributedOnchainSignature{sigs[0], sigs[0]})
+		require.NoError(t, err)
+		assert.True(t, v.Signatures[0].Valid)
+		assert.False(t, v.Signatures[1].Valid)
+		assert.Equal(t, "duplicate signature of the signer", v.Signatures[1].Error)
+		assert.Equal(t, 1, v.ValidSignatures)
+		assert.False(t, v.Quorum)
+	})
+
+	t.Run("digest mismatch", func(t *testing.T) {
+		_, err := decoder.Verify(config, donID, ocrtypes.ConfigDigest{4}, seqNr, report, sigs)
+		require.ErrorContains(t, err, "does not match the digest of the report")
+	})
+}
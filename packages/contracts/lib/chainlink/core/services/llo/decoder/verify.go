@@ -0,0 +1,111 @@
+package decoder
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/retirement"
+)
+
+// SignatureCheck is the verification result of one attributed signature.
+type SignatureCheck struct {
+	Signer uint8 `json:"signer"`
+	// Key is the onchain public key of the signer in the config
+	Key   hexutil.Bytes `json:"key,omitempty"`
+	Valid bool          `json:"valid"`
+	Error string        `json:"error,omitempty"`
+}
+
+type Verification struct {
+	Signatures      []SignatureCheck `json:"signatures"`
+	ValidSignatures int              `json:"validSignatures"`
+	// Quorum is true if more than F signatures are valid, i.e. the report
+	// would be accepted by the verifiers
+	Quorum bool `json:"quorum"`
+}
+
+// Verify verifies the signatures of a report against the signers of config,
+// like the LLO plugin does for the reports of its DON. Only EVM onchain keys,
+// which LLO uses by default for all report formats, are supported.
+func Verify(config retirement.Config, donID uint32, digest ocrtypes.ConfigDigest, seqNr uint64, report ocr3types.ReportWithInfo[llotypes.ReportInfo], sigs []ocrtypes.AttributedOnchainSignature) (*Verification, error) {
+	if config.Digest != ([32]byte{}) && config.Digest != digest {
+		return nil, fmt.Errorf("config digest %s does not match the digest of the report %s", ocrtypes.ConfigDigest(config.Digest).Hex(), digest.Hex())
+	}
+	keyring := llo.NewOnchainKeyring(logger.NullLogger, map[llotypes.ReportFormat]llo.Key{report.Info.ReportFormat: evmVerifier{}}, donID)
+
+	v := &Verification{Signatures: make([]SignatureCheck, len(sigs))}
+	// a signer counts once towards the quorum, however many times it signed
+	counted := map[commontypes.OracleID]bool{}
+	for i, sig := range sigs {
+		check := SignatureCheck{Signer: uint8(sig.Signer)}
+		if int(sig.Signer) >= len(config.Signers) {
+			check.Error = fmt.Sprintf("signer index out of bounds (max: %d)", len(config.Signers)-1)
+		} else if counted[sig.Signer] {
+			check.Key = config.Signers[sig.Signer]
+			check.Error = "duplicate signature of the signer"
+		} else {
+			check.Key = config.Signers[sig.Signer]
+			check.Valid = keyring.Verify(check.Key, digest, seqNr, report, sig.Signature)
+			if check.Valid {
+				v.ValidSignatures++
+				counted[sig.Signer] = true
+			}
+		}
+		v.Signatures[i] = check
+	}
+	v.Quorum = v.ValidSignatures > int(config.F)
+	return v, nil
+}
+
+var errVerifyOnly = errors.New("verification only key cannot sign")
+
+// evmVerifier is an EVM onchain key without private key, for verification.
+type evmVerifier struct{}
+
+var _ llo.Key = evmVerifier{}
+
+func (evmVerifier) Sign(ocrtypes.ReportContext, ocrtypes.Report) ([]byte, error) {
+	return nil, errVerifyOnly
+}
+
+func (v evmVerifier) Verify(publicKey ocrtypes.OnchainPublicKey, reportCtx ocrtypes.ReportContext, report ocrtypes.Report, signature []byte) bool {
+	return v.VerifyBlob(publicKey, ocr2key.ReportToSigData(reportCtx, report), signature)
+}
+
+func (evmVerifier) Sign3(ocrtypes.ConfigDigest, uint64, ocrtypes.Report) ([]byte, error) {
+	return nil, errVerifyOnly
+}
+
+func (v evmVerifier) Verify3(publicKey ocrtypes.OnchainPublicKey, cd ocrtypes.ConfigDigest, seqNr uint64, r ocrtypes.Report, signature []byte) bool {
+	return v.VerifyBlob(publicKey, ocr2key.ReportToSigData3(cd, seqNr, r), signature)
+}
+
+func (evmVerifier) SignBlob([]byte) ([]byte, error) {
+	return nil, errVerifyOnly
+}
+
+func (evmVerifier) VerifyBlob(publicKey ocrtypes.OnchainPublicKey, b []byte, sig []byte) bool {
+	authorPubkey, err := crypto.SigToPub(b, sig)
+	if err != nil {
+		return false
+	}
+	authorAddress := crypto.PubkeyToAddress(*authorPubkey)
+	return bytes.Equal(publicKey, authorAddress[:])
+}
+
+func (evmVerifier) PublicKey() ocrtypes.OnchainPublicKey { return nil }
+
+func (evmVerifier) MaxSignatureLength() int { return 65 }
@@ -0,0 +1,159 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+)
+
+const (
+	defaultFilePrefix       = "reports"
+	defaultMaxFileSizeBytes = 100 * 1024 * 1024
+	defaultMaxFiles         = 10
+)
+
+var (
+	promFileTransmitCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "llo",
+		Subsystem: "filetransmitter",
+		Name:      "transmit_count",
+		Help:      "Number of reports appended to the report files",
+	},
+		[]string{"donID"},
+	)
+	promFileTransmitErrorCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "llo",
+		Subsystem: "filetransmitter",
+		Name:      "transmit_error_count",
+		Help:      "Number of reports which could not be appended to the report files",
+	},
+		[]string{"donID"},
+	)
+)
+
+type Transmitter interface {
+	llotypes.Transmitter
+	services.Service
+}
+
+// FileTransmitterConfig configures a sub-transmitter which appends every
+// report to rotating files of JSON records, see Record.
+type FileTransmitterConfig struct {
+	Logger      logger.Logger `json:"-"`
+	DonID       uint32        `json:"-"`
+	FromAccount string        `json:"-"`
+
+	// Dir is created if it does not exist
+	Dir string `json:"dir"`
+	// FilePrefix names the current file <Dir>/<FilePrefix>.jsonl, defaults to "reports"
+	FilePrefix string `json:"filePrefix"`
+	// MaxFileSizeBytes triggers the rotation of the current file, defaults to 100MiB
+	MaxFileSizeBytes int64 `json:"maxFileSizeBytes"`
+	// MaxFiles is the number of rotated files kept, defaults to 10
+	MaxFiles int `json:"maxFiles"`
+	// ReportFormats restricts the written reports, e.g. ["json"]; all formats are written if empty
+	ReportFormats []string `json:"reportFormats"`
+}
+
+var _ Transmitter = &fileTransmitter{}
+
+type fileTransmitter struct {
+	services.Service
+	eng *services.Engine
+
+	config  FileTransmitterConfig
+	formats reportFormats
+	donID   string
+
+	mu   sync.Mutex
+	file *recordFile
+}
+
+func (c FileTransmitterConfig) NewTransmitter() (*fileTransmitter, error) {
+	if c.Dir == "" {
+		return nil, errors.New("dir must be specified")
+	}
+	if c.FilePrefix == "" {
+		c.FilePrefix = defaultFilePrefix
+	}
+	if c.MaxFileSizeBytes == 0 {
+		c.MaxFileSizeBytes = defaultMaxFileSizeBytes
+	}
+	if c.MaxFiles == 0 {
+		c.MaxFiles = defaultMaxFiles
+	}
+	formats, err := parseReportFormats(c.ReportFormats)
+	if err != nil {
+		return nil, err
+	}
+	t := &fileTransmitter{
+		config:  c,
+		formats: formats,
+		donID:   strconv.FormatUint(uint64(c.DonID), 10),
+	}
+	t.Service, t.eng = services.Config{
+		Name:  "FileTransmitter",
+		Start: t.start,
+		Close: t.close,
+	}.NewServiceEngine(c.Logger)
+	return t, nil
+}
+
+func (t *fileTransmitter) start(context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	file, err := openRecordFile(t.config.Dir, t.config.FilePrefix, t.config.MaxFileSizeBytes, t.config.MaxFiles)
+	if err != nil {
+		return err
+	}
+	t.file = file
+	return nil
+}
+
+func (t *fileTransmitter) close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.file.close()
+}
+
+func (t *fileTransmitter) FromAccount(context.Context) (ocr2types.Account, error) {
+	return ocr2types.Account(t.config.FromAccount), nil
+}
+
+func (t *fileTransmitter) Transmit(
+	ctx context.Context,
+	digest ocr2types.ConfigDigest,
+	seqNr uint64,
+	report ocr3types.ReportWithInfo[llotypes.ReportInfo],
+	sigs []ocr2types.AttributedOnchainSignature,
+) error {
+	if !t.formats.allow(report.Info.ReportFormat) {
+		return nil
+	}
+	line, err := NewRecord(digest, seqNr, report, sigs, time.Now()).marshalLine()
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if err = t.file.append(line); err != nil {
+		promFileTransmitErrorCount.WithLabelValues(t.donID).Inc()
+		t.eng.Errorw("Failed to write report", "digest", digest, "seqNr", seqNr, "err", err)
+		return err
+	}
+	promFileTransmitCount.WithLabelValues(t.donID).Inc()
+	return nil
+}
@@ -0,0 +1,136 @@
+package export
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+var (
+	testDigest = ocr2types.ConfigDigest{1, 2, 3}
+	testSigs   = []ocr2types.AttributedOnchainSignature{{Signer: 2, Signature: []byte{4, 5, 6}}}
+)
+
+func newJSONReport(t *testing.T, channelID llotypes.ChannelID) ocr3types.ReportWithInfo[llotypes.ReportInfo] {
+	rep := llo.Report{
+		ConfigDigest:                    testDigest,
+		SeqNr:                           32,
+		ChannelID:                       channelID,
+		ValidAfterNanoseconds:           28,
+		ObservationTimestampNanoseconds: 30,
+		Values:                          []llo.StreamValue{llo.ToDecimal(decimal.NewFromInt(35))},
+	}
+	rawReport, err := llo.JSONReportCodec{}.Encode(rep, llotypes.ChannelDefinition{})
+	require.NoError(t, err)
+	return ocr3types.ReportWithInfo[llotypes.ReportInfo]{
+		Report: rawReport,
+		Info: llotypes.ReportInfo{
+			LifeCycleStage: llo.LifeCycleStageProduction,
+			ReportFormat:   llotypes.ReportFormatJSON,
+		},
+	}
+}
+
+func readRecords(t *testing.T, path string) []Record {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var records []Record
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var r Record
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &r))
+		records = append(records, r)
+	}
+	require.NoError(t, scanner.Err())
+	return records
+}
+
+func Test_NewRecord(t *testing.T) {
+	report := newJSONReport(t, 31)
+	r := NewRecord(testDigest, 5, report, testSigs, time.Unix(10, 0))
+
+	assert.Equal(t, testDigest.Hex(), r.ConfigDigest)
+	assert.Equal(t, uint64(5), r.SeqNr)
+	assert.Equal(t, "json", r.ReportFormat)
+	assert.Equal(t, string(llo.LifeCycleStageProduction), r.LifeCycleStage)
+	require.NotNil(t, r.ChannelID)
+	assert.Equal(t, llotypes.ChannelID(31), *r.ChannelID)
+	assert.Equal(t, report.Report, []byte(r.Report))
+	assert.Equal(t, []Signature{{Signer: 2, Signature: []byte{4, 5, 6}}}, r.Signatures)
+	assert.Equal(t, time.Unix(10, 0).UTC(), r.TransmittedAt)
+
+	t.Run("channel ID is not encoded", func(t *testing.T) {
+		report.Info.ReportFormat = llotypes.ReportFormatEVMPremiumLegacy
+		r := NewRecord(testDigest, 5, report, testSigs, time.Now())
+		assert.Equal(t, "evm_premium_legacy", r.ReportFormat)
+		assert.Nil(t, r.ChannelID)
+	})
+}
+
+func Test_FileTransmitter(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("invalid config", func(t *testing.T) {
+		_, err := FileTransmitterConfig{Logger: logger.TestLogger(t)}.NewTransmitter()
+		require.EqualError(t, err, "dir must be specified")
+
+		_, err = FileTransmitterConfig{Logger: logger.TestLogger(t), Dir: dir, ReportFormats: []string{"foo"}}.NewTransmitter()
+		require.ErrorContains(t, err, `invalid report format "foo"`)
+	})
+
+	t.Run("appends and rotates", func(t *testing.T) {
+		tr, err := FileTransmitterConfig{
+			Logger:           logger.TestLogger(t),
+			Dir:              dir,
+			MaxFileSizeBytes: 1, // every report is written to a new file
+			MaxFiles:         2,
+			ReportFormats:    []string{"json"},
+		}.NewTransmitter()
+		require.NoError(t, err)
+		servicetest.Run(t, tr)
+		now := time.Now()
+		tr.file.now = func() time.Time {
+			now = now.Add(time.Second)
+			return now
+		}
+
+		for i := range 5 {
+			require.NoError(t, tr.Transmit(t.Context(), testDigest, uint64(i), newJSONReport(t, llotypes.ChannelID(i)), testSigs))
+		}
+		// ignored format
+		require.NoError(t, tr.Transmit(t.Context(), testDigest, 6, ocr3types.ReportWithInfo[llotypes.ReportInfo]{
+			Info: llotypes.ReportInfo{ReportFormat: llotypes.ReportFormatEVMPremiumLegacy},
+		}, testSigs))
+
+		current := readRecords(t, filepath.Join(dir, "reports.jsonl"))
+		require.Len(t, current, 1)
+		assert.Equal(t, uint64(4), current[0].SeqNr)
+
+		rotated, err := tr.file.rotated()
+		require.NoError(t, err)
+		require.Len(t, rotated, 2)
+		for i, path := range rotated {
+			records := readRecords(t, path)
+			require.Len(t, records, 1)
+			assert.Equal(t, uint64(i+2), records[0].SeqNr)
+		}
+	})
+}
@@ -0,0 +1,91 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+)
+
+// Record is a transmitted report, as written by the file transmitter (one
+// JSON record per line) and posted by the webhook transmitter.
+type Record struct {
+	ConfigDigest   string `json:"configDigest"`
+	SeqNr          uint64 `json:"seqNr"`
+	ReportFormat   string `json:"reportFormat"`
+	LifeCycleStage string `json:"lifeCycleStage"`
+	// ChannelID is only known for the report formats which encode it, e.g.
+	// JSON reports.
+	ChannelID     *llotypes.ChannelID `json:"channelID,omitempty"`
+	Report        hexutil.Bytes       `json:"report"`
+	Signatures    []Signature         `json:"signatures"`
+	TransmittedAt time.Time           `json:"transmittedAt"`
+}
+
+type Signature struct {
+	Signer    uint8         `json:"signer"`
+	Signature hexutil.Bytes `json:"signature"`
+}
+
+func NewRecord(digest ocr2types.ConfigDigest, seqNr uint64, report ocr3types.ReportWithInfo[llotypes.ReportInfo], sigs []ocr2types.AttributedOnchainSignature, now time.Time) Record {
+	r := Record{
+		ConfigDigest:   digest.Hex(),
+		SeqNr:          seqNr,
+		ReportFormat:   report.Info.ReportFormat.String(),
+		LifeCycleStage: string(report.Info.LifeCycleStage),
+		Report:         report.Report,
+		Signatures:     make([]Signature, len(sigs)),
+		TransmittedAt:  now.UTC(),
+	}
+	if report.Info.ReportFormat == llotypes.ReportFormatJSON {
+		if decoded, err := (llo.JSONReportCodec{}).Decode(report.Report); err == nil {
+			r.ChannelID = &decoded.ChannelID
+		}
+	}
+	for i, sig := range sigs {
+		r.Signatures[i] = Signature{Signer: uint8(sig.Signer), Signature: sig.Signature}
+	}
+	return r
+}
+
+// marshalLine returns the record as a single line of JSON, terminated by a newline.
+func (r Record) marshalLine() ([]byte, error) {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal record: %w", err)
+	}
+	return append(b, '\n'), nil
+}
+
+// reportFormats filters the transmitted reports by format; nil allows all of them.
+type reportFormats map[llotypes.ReportFormat]struct{}
+
+func parseReportFormats(formats []string) (reportFormats, error) {
+	if len(formats) == 0 {
+		return nil, nil
+	}
+	allowed := make(reportFormats, len(formats))
+	for _, f := range formats {
+		rf, err := llotypes.ReportFormatFromString(f)
+		if err != nil {
+			return nil, fmt.Errorf("invalid report format %q: %w", f, err)
+		}
+		allowed[rf] = struct{}{}
+	}
+	return allowed, nil
+}
+
+func (f reportFormats) allow(rf llotypes.ReportFormat) bool {
+	if f == nil {
+		return true
+	}
+	_, ok := f[rf]
+	return ok
+}
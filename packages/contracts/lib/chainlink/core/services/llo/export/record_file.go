@@ -0,0 +1,114 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+const (
+	recordFileExt = ".jsonl"
+	// rotatedTimeFormat sorts the rotated files chronologically by name
+	rotatedTimeFormat = "20060102T150405.000000000Z"
+)
+
+// recordFile appends lines to <dir>/<prefix>.jsonl. Once the file would exceed
+// maxSize, it is renamed to <dir>/<prefix>-<UTC time>.jsonl and a new file is
+// started; only the maxRotated most recent rotated files are kept.
+// recordFile is not thread-safe.
+type recordFile struct {
+	dir        string
+	prefix     string
+	maxSize    int64
+	maxRotated int
+	now        func() time.Time
+
+	f    *os.File
+	size int64
+}
+
+func openRecordFile(dir, prefix string, maxSize int64, maxRotated int) (*recordFile, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create directory %s: %w", dir, err)
+	}
+	rf := &recordFile{dir: dir, prefix: prefix, maxSize: maxSize, maxRotated: maxRotated, now: time.Now}
+	if err := rf.open(); err != nil {
+		return nil, err
+	}
+	return rf, nil
+}
+
+func (rf *recordFile) path() string {
+	return filepath.Join(rf.dir, rf.prefix+recordFileExt)
+}
+
+func (rf *recordFile) open() error {
+	f, err := os.OpenFile(rf.path(), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", rf.path(), err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return errors.Join(fmt.Errorf("failed to stat %s: %w", rf.path(), err), f.Close())
+	}
+	rf.f, rf.size = f, info.Size()
+	return nil
+}
+
+func (rf *recordFile) append(line []byte) error {
+	if rf.maxSize > 0 && rf.size > 0 && rf.size+int64(len(line)) > rf.maxSize {
+		if err := rf.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := rf.f.Write(line)
+	rf.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("failed to write to %s: %w", rf.path(), err)
+	}
+	return nil
+}
+
+func (rf *recordFile) rotate() error {
+	if err := rf.f.Close(); err != nil {
+		return fmt.Errorf("failed to close %s: %w", rf.path(), err)
+	}
+	rotated := filepath.Join(rf.dir, rf.prefix+"-"+rf.now().UTC().Format(rotatedTimeFormat)+recordFileExt)
+	if err := os.Rename(rf.path(), rotated); err != nil {
+		return errors.Join(fmt.Errorf("failed to rotate %s: %w", rf.path(), err), rf.open())
+	}
+	if err := rf.open(); err != nil {
+		return err
+	}
+	return rf.prune()
+}
+
+// rotated returns the rotated files, from the oldest to the most recent.
+func (rf *recordFile) rotated() ([]string, error) {
+	matches, err := filepath.Glob(filepath.Join(rf.dir, rf.prefix+"-*"+recordFileExt))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(matches)
+	return matches, nil
+}
+
+func (rf *recordFile) prune() error {
+	rotated, err := rf.rotated()
+	if err != nil {
+		return err
+	}
+	var merr error
+	for len(rotated) > rf.maxRotated {
+		merr = errors.Join(merr, os.Remove(rotated[0]))
+		rotated = rotated[1:]
+	}
+	return merr
+}
+
+func (rf *recordFile) close() error {
+	return rf.f.Close()
+}
@@ -0,0 +1,421 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/timeutil"
+)
+
+const (
+	defaultRequestTimeoutMs       = 5_000
+	defaultMaxRetries             = 3
+	defaultRetryIntervalMs        = 500
+	defaultQueueSize              = 1_000
+	defaultMaxConcurrentRequests  = 1
+	defaultMaxBacklogBytes        = 100 * 1024 * 1024
+	defaultBacklogRetryIntervalMs = 30_000
+
+	backlogFileName = "backlog" + recordFileExt
+	// maxRecordSize bounds the lines read back from the backlog
+	maxRecordSize = 16 * 1024 * 1024
+)
+
+var (
+	promWebhookTransmitSuccessCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "llo",
+		Subsystem: "webhooktransmitter",
+		Name:      "transmit_success_count",
+		Help:      "Number of reports delivered to the webhook",
+	},
+		[]string{"donID"},
+	)
+	promWebhookTransmitErrorCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "llo",
+		Subsystem: "webhooktransmitter",
+		Name:      "transmit_error_count",
+		Help:      "Number of failed requests to the webhook, including retries",
+	},
+		[]string{"donID"},
+	)
+	promWebhookBacklogCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "llo",
+		Subsystem: "webhooktransmitter",
+		Name:      "backlog_count",
+		Help:      "Number of reports written to the on-disk backlog, to be delivered later",
+	},
+		[]string{"donID"},
+	)
+	promWebhookDroppedCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "llo",
+		Subsystem: "webhooktransmitter",
+		Name:      "dropped_count",
+		Help:      "Number of reports which were rejected by the webhook or could not be queued",
+	},
+		[]string{"donID"},
+	)
+)
+
+// errRejected is returned for the responses which are not worth retrying,
+// i.e. 4xx status codes other than 408 and 429.
+var errRejected = errors.New("rejected by webhook")
+
+// WebhookTransmitterConfig configures a sub-transmitter which POSTs every
+// report to an HTTP endpoint, as a JSON Record. Failed requests are retried,
+// and the reports which still could not be delivered are kept in an on-disk
+// backlog, which is retried periodically and survives restarts. Delivery is
+// at-least-once, so the endpoint should deduplicate by digest and seqNr.
+type WebhookTransmitterConfig struct {
+	Logger      logger.Logger `json:"-"`
+	DonID       uint32        `json:"-"`
+	FromAccount string        `json:"-"`
+
+	URL string `json:"url"`
+	// Headers are added to every request, e.g. for authorization
+	Headers          map[string]string `json:"headers"`
+	RequestTimeoutMs int               `json:"requestTimeoutMs"`
+	// MaxRetries is the number of retries of a failed request, before the
+	// report is moved to the backlog
+	MaxRetries int `json:"maxRetries"`
+	// RetryIntervalMs is doubled after each retry
+	RetryIntervalMs       int `json:"retryIntervalMs"`
+	QueueSize             int `json:"queueSize"`
+	MaxConcurrentRequests int `json:"maxConcurrentRequests"`
+	// BacklogDir enables the on-disk backlog; without it, the undelivered reports are dropped
+	BacklogDir             string `json:"backlogDir"`
+	MaxBacklogBytes        int64  `json:"maxBacklogBytes"`
+	BacklogRetryIntervalMs int    `json:"backlogRetryIntervalMs"`
+	// ReportFormats restricts the posted reports, e.g. ["json"]; all formats are posted if empty
+	ReportFormats []string `json:"reportFormats"`
+}
+
+var _ Transmitter = &webhookTransmitter{}
+
+type webhookTransmitter struct {
+	services.Service
+	eng *services.Engine
+
+	config  WebhookTransmitterConfig
+	formats reportFormats
+	donID   string
+	client  *http.Client
+
+	queue   chan []byte
+	backlog *backlog
+}
+
+func (c WebhookTransmitterConfig) NewTransmitter() (*webhookTransmitter, error) {
+	u, err := url.Parse(c.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("url must be a valid http(s) URL, got: %q", c.URL)
+	}
+	if c.RequestTimeoutMs == 0 {
+		c.RequestTimeoutMs = defaultRequestTimeoutMs
+	}
+	if c.MaxRetries == 0 {
+		c.MaxRetries = defaultMaxRetries
+	}
+	if c.RetryIntervalMs == 0 {
+		c.RetryIntervalMs = defaultRetryIntervalMs
+	}
+	if c.QueueSize == 0 {
+		c.QueueSize = defaultQueueSize
+	}
+	if c.MaxConcurrentRequests == 0 {
+		c.MaxConcurrentRequests = defaultMaxConcurrentRequests
+	}
+	if c.MaxBacklogBytes == 0 {
+		c.MaxBacklogBytes = defaultMaxBacklogBytes
+	}
+	if c.BacklogRetryIntervalMs == 0 {
+		c.BacklogRetryIntervalMs = defaultBacklogRetryIntervalMs
+	}
+	formats, err := parseReportFormats(c.ReportFormats)
+	if err != nil {
+		return nil, err
+	}
+	t := &webhookTransmitter{
+		config:  c,
+		formats: formats,
+		donID:   strconv.FormatUint(uint64(c.DonID), 10),
+		client:  &http.Client{Timeout: time.Duration(c.RequestTimeoutMs) * time.Millisecond},
+		queue:   make(chan []byte, c.QueueSize),
+	}
+	t.Service, t.eng = services.Config{
+		Name:  "WebhookTransmitter",
+		Start: t.start,
+		Close: t.close,
+	}.NewServiceEngine(c.Logger)
+	return t, nil
+}
+
+func (t *webhookTransmitter) start(context.Context) error {
+	if t.config.BacklogDir != "" {
+		b, err := openBacklog(t.config.BacklogDir, t.config.MaxBacklogBytes)
+		if err != nil {
+			return err
+		}
+		t.backlog = b
+		t.eng.GoTick(timeutil.NewTicker(func() time.Duration {
+			return time.Duration(t.config.BacklogRetryIntervalMs) * time.Millisecond
+		}), t.replayBacklog)
+	}
+	for i := 0; i < t.config.MaxConcurrentRequests; i++ {
+		t.eng.Go(t.runDeliveries)
+	}
+	return nil
+}
+
+// close moves the queued reports to the backlog, after the deliveries stopped.
+func (t *webhookTransmitter) close() error {
+	for {
+		select {
+		case line := <-t.queue:
+			t.toBacklog(line)
+		default:
+			if t.backlog != nil {
+				return t.backlog.close()
+			}
+			return nil
+		}
+	}
+}
+
+func (t *webhookTransmitter) FromAccount(context.Context) (ocr2types.Account, error) {
+	return ocr2types.Account(t.config.FromAccount), nil
+}
+
+// Transmit queues the report, so that a slow webhook does not delay the other
+// transmitters. The report is moved to the backlog if the queue is full.
+func (t *webhookTransmitter) Transmit(
+	ctx context.Context,
+	digest ocr2types.ConfigDigest,
+	seqNr uint64,
+	report ocr3types.ReportWithInfo[llotypes.ReportInfo],
+	sigs []ocr2types.AttributedOnchainSignature,
+) error {
+	if !t.formats.allow(report.Info.ReportFormat) {
+		return nil
+	}
+	line, err := NewRecord(digest, seqNr, report, sigs, time.Now()).marshalLine()
+	if err != nil {
+		return err
+	}
+	select {
+	case t.queue <- line:
+		return nil
+	default:
+	}
+	if !t.toBacklog(line) {
+		return fmt.Errorf("webhook queue is full, dropped report with digest %s and seqNr %d", digest, seqNr)
+	}
+	return nil
+}
+
+func (t *webhookTransmitter) runDeliveries(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case line := <-t.queue:
+			err := t.deliverWithRetries(ctx, line)
+			switch {
+			case err == nil:
+			case errors.Is(err, errRejected):
+				promWebhookDroppedCount.WithLabelValues(t.donID).Inc()
+				t.eng.Errorw("Webhook rejected report, dropping it", "err", err)
+			default:
+				t.eng.Warnw("Failed to deliver report to webhook", "err", err)
+				t.toBacklog(line)
+			}
+		}
+	}
+}
+
+func (t *webhookTransmitter) deliverWithRetries(ctx context.Context, line []byte) error {
+	interval := time.Duration(t.config.RetryIntervalMs) * time.Millisecond
+	var err error
+	for attempt := 0; ; attempt++ {
+		if err = t.deliver(ctx, line); err == nil || errors.Is(err, errRejected) || attempt == t.config.MaxRetries {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		case <-time.After(interval):
+		}
+		interval *= 2
+	}
+}
+
+func (t *webhookTransmitter) deliver(ctx context.Context, line []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.config.URL, bytes.NewReader(line))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range t.config.Headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := t.client.Do(req)
+	if err != nil {
+		promWebhookTransmitErrorCount.WithLabelValues(t.donID).Inc()
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1024*1024))
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		promWebhookTransmitSuccessCount.WithLabelValues(t.donID).Inc()
+		return nil
+	case resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusRequestTimeout && resp.StatusCode != http.StatusTooManyRequests:
+		promWebhookTransmitErrorCount.WithLabelValues(t.donID).Inc()
+		return fmt.Errorf("%w: status %s", errRejected, resp.Status)
+	default:
+		promWebhookTransmitErrorCount.WithLabelValues(t.donID).Inc()
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+}
+
+// toBacklog returns false if the report was dropped.
+func (t *webhookTransmitter) toBacklog(line []byte) bool {
+	if t.backlog == nil {
+		promWebhookDroppedCount.WithLabelValues(t.donID).Inc()
+		t.eng.Error("Backlog is not configured, dropping report")
+		return false
+	}
+	if err := t.backlog.add(line); err != nil {
+		promWebhookDroppedCount.WithLabelValues(t.donID).Inc()
+		t.eng.Errorw("Failed to write report to backlog, dropping it", "err", err)
+		return false
+	}
+	promWebhookBacklogCount.WithLabelValues(t.donID).Inc()
+	return true
+}
+
+// replayBacklog delivers the backlog in order, once per report. The reports
+// from the first failed delivery onwards are put back to the backlog.
+func (t *webhookTransmitter) replayBacklog(ctx context.Context) {
+	path, err := t.backlog.take()
+	if err != nil {
+		t.eng.Errorw("Failed to read backlog", "err", err)
+		return
+	}
+	if path == "" {
+		return
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		t.eng.Errorw("Failed to open backlog", "path", path, "err", err)
+		return
+	}
+	defer f.Close()
+
+	var delivered, failed int
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, maxRecordSize)
+	for scanner.Scan() {
+		line := append(bytes.Clone(scanner.Bytes()), '\n')
+		if failed == 0 {
+			err = t.deliver(ctx, line)
+			if err == nil || errors.Is(err, errRejected) {
+				delivered++
+				continue
+			}
+		}
+		failed++
+		if err := t.backlog.add(line); err != nil {
+			promWebhookDroppedCount.WithLabelValues(t.donID).Inc()
+			t.eng.Errorw("Failed to put report back to backlog, dropping it", "err", err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		// keep the rest of the file for the next replay
+		t.eng.Errorw("Failed to read backlog", "path", path, "err", err)
+		return
+	}
+	t.eng.Debugw("Replayed backlog", "delivered", delivered, "failed", failed)
+	if err := os.Remove(path); err != nil {
+		t.eng.Errorw("Failed to remove replayed backlog", "path", path, "err", err)
+	}
+}
+
+// backlog is an append-only file of the reports to deliver later. It is
+// thread-safe.
+type backlog struct {
+	mu   sync.Mutex
+	file *recordFile
+}
+
+func openBacklog(dir string, maxSize int64) (*backlog, error) {
+	// the backlog never rotates: appending fails once maxSize is reached
+	file, err := openRecordFile(dir, "backlog", maxSize, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &backlog{file: file}, nil
+}
+
+func (b *backlog) add(line []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.file.size+int64(len(line)) > b.file.maxSize {
+		return errors.New("backlog is full")
+	}
+	return b.file.append(line)
+}
+
+// take moves the backlog to a separate file for replaying, and returns its
+// path, or an empty path if there is nothing to replay. A replay interrupted
+// by a shutdown is resumed first.
+func (b *backlog) take() (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	replayPath := filepath.Join(b.file.dir, "replay-"+backlogFileName)
+	if _, err := os.Stat(replayPath); err == nil {
+		return replayPath, nil
+	}
+	if b.file.size == 0 {
+		return "", nil
+	}
+	if err := b.file.close(); err != nil {
+		return "", err
+	}
+	if err := os.Rename(b.file.path(), replayPath); err != nil {
+		return "", errors.Join(err, b.file.open())
+	}
+	return replayPath, b.file.open()
+}
+
+func (b *backlog) size() int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.file.size
+}
+
+func (b *backlog) close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.file.close()
+}
@@ -0,0 +1,148 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+type testWebhook struct {
+	*httptest.Server
+	status   atomic.Int32
+	requests atomic.Int32
+
+	mu      sync.Mutex
+	records []Record
+}
+
+func newTestWebhook(t *testing.T) *testWebhook {
+	w := &testWebhook{}
+	w.status.Store(http.StatusOK)
+	w.Server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+		assert.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+		w.requests.Add(1)
+		status := int(w.status.Load())
+		if status == http.StatusOK {
+			body, err := io.ReadAll(req.Body)
+			assert.NoError(t, err)
+			var r Record
+			assert.NoError(t, json.Unmarshal(body, &r))
+			w.mu.Lock()
+			w.records = append(w.records, r)
+			w.mu.Unlock()
+		}
+		rw.WriteHeader(status)
+	}))
+	t.Cleanup(w.Close)
+	return w
+}
+
+func (w *testWebhook) received() []Record {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]Record(nil), w.records...)
+}
+
+func newWebhookTransmitterConfig(t *testing.T, url string, backlogDir string) WebhookTransmitterConfig {
+	return WebhookTransmitterConfig{
+		Logger:                 logger.TestLogger(t),
+		URL:                    url,
+		Headers:                map[string]string{"Authorization": "Bearer token"},
+		MaxRetries:             1,
+		RetryIntervalMs:        1,
+		BacklogDir:             backlogDir,
+		BacklogRetryIntervalMs: 10,
+	}
+}
+
+func Test_WebhookTransmitter(t *testing.T) {
+	t.Run("invalid config", func(t *testing.T) {
+		_, err := WebhookTransmitterConfig{URL: "ftp://example.com"}.NewTransmitter()
+		require.EqualError(t, err, `url must be a valid http(s) URL, got: "ftp://example.com"`)
+	})
+
+	t.Run("delivers reports", func(t *testing.T) {
+		webhook := newTestWebhook(t)
+		tr, err := newWebhookTransmitterConfig(t, webhook.URL, "").NewTransmitter()
+		require.NoError(t, err)
+		servicetest.Run(t, tr)
+
+		require.NoError(t, tr.Transmit(t.Context(), testDigest, 7, newJSONReport(t, 31), testSigs))
+		testutils.RequireEventually(t, func() bool { return len(webhook.received()) == 1 })
+		r := webhook.received()[0]
+		assert.Equal(t, uint64(7), r.SeqNr)
+		assert.Equal(t, testDigest.Hex(), r.ConfigDigest)
+		require.NotNil(t, r.ChannelID)
+		assert.EqualValues(t, 31, *r.ChannelID)
+	})
+
+	t.Run("drops rejected reports", func(t *testing.T) {
+		webhook := newTestWebhook(t)
+		webhook.status.Store(http.StatusBadRequest)
+		dir := t.TempDir()
+		tr, err := newWebhookTransmitterConfig(t, webhook.URL, dir).NewTransmitter()
+		require.NoError(t, err)
+		servicetest.Run(t, tr)
+
+		require.NoError(t, tr.Transmit(t.Context(), testDigest, 7, newJSONReport(t, 31), testSigs))
+		// rejected reports are not retried
+		testutils.RequireEventually(t, func() bool { return webhook.requests.Load() == 1 })
+		webhook.status.Store(http.StatusOK)
+		require.NoError(t, tr.Transmit(t.Context(), testDigest, 8, newJSONReport(t, 31), testSigs))
+		testutils.RequireEventually(t, func() bool { return len(webhook.received()) == 1 })
+		assert.Equal(t, uint64(8), webhook.received()[0].SeqNr)
+		assert.Zero(t, tr.backlog.size())
+	})
+
+	t.Run("delivers the backlog after a restart", func(t *testing.T) {
+		webhook := newTestWebhook(t)
+		webhook.status.Store(http.StatusServiceUnavailable)
+		dir := t.TempDir()
+		cfg := newWebhookTransmitterConfig(t, webhook.URL, dir)
+		cfg.BacklogRetryIntervalMs = 60_000
+
+		tr, err := cfg.NewTransmitter()
+		require.NoError(t, err)
+		require.NoError(t, tr.Start(t.Context()))
+		for seqNr := range uint64(3) {
+			require.NoError(t, tr.Transmit(t.Context(), testDigest, seqNr, newJSONReport(t, 31), testSigs))
+		}
+		testutils.RequireEventually(t, func() bool {
+			b, err := os.ReadFile(filepath.Join(dir, backlogFileName))
+			return err == nil && bytes.Count(b, []byte{'\n'}) == 3
+		})
+		require.NoError(t, tr.Close())
+		assert.Empty(t, webhook.received())
+
+		webhook.status.Store(http.StatusOK)
+		cfg.BacklogRetryIntervalMs = 10
+		tr, err = cfg.NewTransmitter()
+		require.NoError(t, err)
+		servicetest.Run(t, tr)
+
+		testutils.RequireEventually(t, func() bool { return len(webhook.received()) == 3 })
+		for i, r := range webhook.received() {
+			assert.Equal(t, uint64(i), r.SeqNr)
+		}
+		testutils.RequireEventually(t, func() bool {
+			_, err := os.Stat(filepath.Join(dir, "replay-"+backlogFileName))
+			return os.IsNotExist(err) && tr.backlog.size() == 0
+		})
+	})
+}
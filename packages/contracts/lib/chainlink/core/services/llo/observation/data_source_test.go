@@ -438,6 +438,7 @@ func BenchmarkObserve(b *testing.B) {
 		nil,
 		nil,
 		nil,
+		nil,
 		lggr,
 		c,
 		c,
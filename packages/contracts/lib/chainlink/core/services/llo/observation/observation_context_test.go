@@ -24,6 +24,7 @@ import (
 	"github.com/smartcontractkit/chainlink-data-streams/llo"
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 	clhttptest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/httptest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -188,6 +189,24 @@ func (m *mockBridgeConfig) BridgeResponseURL() *url.URL {
 func (m *mockBridgeConfig) BridgeCacheTTL() time.Duration {
 	return 0
 }
+func (m *mockBridgeConfig) BridgeHealth() config.BridgeHealth {
+	return &mockBridgeHealthConfig{}
+}
+func (m *mockBridgeConfig) BridgeClientTLS() config.BridgeClientTLS {
+	return &mockBridgeClientTLSConfig{}
+}
+
+type mockBridgeHealthConfig struct{}
+
+func (m *mockBridgeHealthConfig) ProbeInterval() time.Duration { return 0 }
+func (m *mockBridgeHealthConfig) ProbeTimeout() time.Duration  { return 0 }
+func (m *mockBridgeHealthConfig) FailureThreshold() uint32     { return 0 }
+func (m *mockBridgeHealthConfig) OpenTimeout() time.Duration   { return 0 }
+
+type mockBridgeClientTLSConfig struct{}
+
+func (m *mockBridgeClientTLSConfig) CertPath() string { return "" }
+func (m *mockBridgeClientTLSConfig) KeyPath() string  { return "" }
 
 func createBridge(t testing.TB, name string, val string, borm bridges.ORM, maxCalls int64) {
 	callcount := atomic.NewInt64(0)
@@ -234,6 +253,7 @@ func TestObservationContext_Observe_integrationRealPipeline(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		lggr,
 		c,
 		c,
@@ -316,6 +336,7 @@ func BenchmarkObservationContext_Observe_integrationRealPipeline_concurrencyStre
 		nil,
 		nil,
 		nil,
+		nil,
 		lggr,
 		c,
 		c,
@@ -12,6 +12,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/cre"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/export"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/mercurytransmitter"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/llo/config"
 
@@ -88,6 +89,34 @@ func NewTransmitter(opts TransmitterOpts) (Transmitter, error) {
 				return nil, fmt.Errorf("failed to create CRE transmitter: %w", err)
 			}
 			subTransmitters = append(subTransmitters, creTransmitter)
+		case config.TransmitterTypeFile:
+			var fileTransmitterCfg export.FileTransmitterConfig
+			err := json.Unmarshal(cfg.Opts, &fileTransmitterCfg)
+			if err != nil {
+				return nil, fmt.Errorf("failed to unmarshal file transmitter config: %w", err)
+			}
+			fileTransmitterCfg.Logger = opts.Lggr
+			fileTransmitterCfg.DonID = opts.DonID
+			fileTransmitterCfg.FromAccount = opts.FromAccount
+			fileTransmitter, err := fileTransmitterCfg.NewTransmitter()
+			if err != nil {
+				return nil, fmt.Errorf("failed to create file transmitter: %w", err)
+			}
+			subTransmitters = append(subTransmitters, fileTransmitter)
+		case config.TransmitterTypeWebhook:
+			var webhookTransmitterCfg export.WebhookTransmitterConfig
+			err := json.Unmarshal(cfg.Opts, &webhookTransmitterCfg)
+			if err != nil {
+				return nil, fmt.Errorf("failed to unmarshal webhook transmitter config: %w", err)
+			}
+			webhookTransmitterCfg.Logger = opts.Lggr
+			webhookTransmitterCfg.DonID = opts.DonID
+			webhookTransmitterCfg.FromAccount = opts.FromAccount
+			webhookTransmitter, err := webhookTransmitterCfg.NewTransmitter()
+			if err != nil {
+				return nil, fmt.Errorf("failed to create webhook transmitter: %w", err)
+			}
+			subTransmitters = append(subTransmitters, webhookTransmitter)
 		default:
 			return nil, fmt.Errorf("unknown transmitter type: %s", cfg.Type)
 		}
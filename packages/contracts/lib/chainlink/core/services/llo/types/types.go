@@ -8,12 +8,20 @@ import (
 	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 func ChannelDefinitionCacheFilterName(addr common.Address, donID uint32) string {
 	return logpoller.FilterName("OCR3 LLO ChannelDefinitionCachePoller", addr.String(), strconv.FormatUint(uint64(donID), 10))
 }
 
+// ChannelDefinitionsSourceAddress is the address under which the channel
+// definitions loaded from a file or S3 source are persisted, in lieu of a
+// ChannelConfigStore contract address.
+func ChannelDefinitionsSourceAddress(sourceURL string) common.Address {
+	return common.BytesToAddress(crypto.Keccak256([]byte(sourceURL)))
+}
+
 type PersistedDefinitions struct {
 	ChainSelector uint64                      `db:"chain_selector"`
 	Address       common.Address              `db:"addr"`
@@ -51,6 +51,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/retirement"
+	llocoretypes "github.com/smartcontractkit/chainlink/v2/core/services/llo/types"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/ccipcommit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/ccipexec"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/config"
@@ -378,7 +379,11 @@ func (d *Delegate) cleanupEVM(ctx context.Context, jb job.Job, relayID types.Rel
 		if err != nil {
 			return err
 		}
-		if err = llo.Cleanup(ctx, lp, pluginCfg.ChannelDefinitionsContractAddress, pluginCfg.DonID, d.ds, chainSelector); err != nil {
+		addr := pluginCfg.ChannelDefinitionsContractAddress
+		if pluginCfg.ChannelDefinitionsSource != nil {
+			addr = llocoretypes.ChannelDefinitionsSourceAddress(pluginCfg.ChannelDefinitionsSource.URL)
+		}
+		if err = llo.Cleanup(ctx, lp, addr, pluginCfg.DonID, d.ds, chainSelector); err != nil {
 			// Cleanup is optimistic. Don't return error here, as we don't want
 			// to block job deletion
 			d.lggr.Errorw("failed to cleanup llo", "err", err, "spec", spec)
@@ -114,7 +114,7 @@ func newTestPipelineGetter(t *testing.T, source string) *pricegetter.PipelineGet
 	db := pgtest.NewSqlxDB(t)
 	bridgeORM := bridges.NewORM(db)
 	runner := pipeline.NewRunner(pipeline.NewORM(db, lggr, config.NewTestGeneralConfig(t).JobPipeline().MaxSuccessfulRuns()),
-		bridgeORM, cfg, nil, nil, nil, nil, lggr, &http.Client{}, &http.Client{})
+		bridgeORM, cfg, config.NewTestGeneralConfig(t).WebServer(), nil, nil, nil, nil, lggr, &http.Client{}, &http.Client{})
 	sourceNative := ccipcalc.EvmAddrToGeneric(common.HexToAddress("0x"))
 	sourceChain := chainsel.TEST_1000
 	destChain := chainsel.TEST_1338
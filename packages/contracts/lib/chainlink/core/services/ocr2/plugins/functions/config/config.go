@@ -51,6 +51,8 @@ type PluginConfig struct {
 	DecryptionQueueConfig                    *DecryptionQueueConfig                    `json:"decryptionQueueConfig"`
 	ExternalAdapterMaxRetries                *uint32                                   `json:"externalAdapterMaxRetries"`
 	ExternalAdapterExponentialBackoffBaseSec *uint32                                   `json:"externalAdapterExponentialBackoffBaseSec"`
+	ExternalAdapterAdditionalBridgeNames     []string                                  `json:"externalAdapterAdditionalBridgeNames"` // Bridges to other instances of the adapter, tried after the default one in order
+	ExternalAdapterHedgeDelayMillis          *uint32                                   `json:"externalAdapterHedgeDelayMillis"`      // Latency after which a request is also sent to the next bridge; 0 only fails over on errors
 }
 
 type DecryptionQueueConfig struct {
@@ -79,6 +81,16 @@ func ValidatePluginConfig(config PluginConfig) error {
 			return errors.New("missing or invalid decryptionQueueConfig decryptRequestTimeoutSec")
 		}
 	}
+	bridgeNames := make(map[string]struct{}, len(config.ExternalAdapterAdditionalBridgeNames))
+	for _, name := range config.ExternalAdapterAdditionalBridgeNames {
+		if name == "" {
+			return errors.New("invalid empty externalAdapterAdditionalBridgeNames entry")
+		}
+		if _, ok := bridgeNames[name]; ok {
+			return fmt.Errorf("duplicate externalAdapterAdditionalBridgeNames entry %q", name)
+		}
+		bridgeNames[name] = struct{}{}
+	}
 	return nil
 }
 
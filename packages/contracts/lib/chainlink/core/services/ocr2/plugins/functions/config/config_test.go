@@ -39,3 +39,11 @@ func TestS4ConfigDecoder(t *testing.T) {
 	assert.Equal(t, 200, limits.MaxObservationLength)
 	assert.Equal(t, 300, limits.MaxReportLength)
 }
+
+func TestValidatePluginConfig_ExternalAdapterBridges(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, config.ValidatePluginConfig(config.PluginConfig{ExternalAdapterAdditionalBridgeNames: []string{"ea_bridge_2", "ea_bridge_3"}}))
+	require.EqualError(t, config.ValidatePluginConfig(config.PluginConfig{ExternalAdapterAdditionalBridgeNames: []string{""}}), "invalid empty externalAdapterAdditionalBridgeNames entry")
+	require.EqualError(t, config.ValidatePluginConfig(config.PluginConfig{ExternalAdapterAdditionalBridgeNames: []string{"ea_bridge_2", "ea_bridge_2"}}), `duplicate externalAdapterAdditionalBridgeNames entry "ea_bridge_2"`)
+}
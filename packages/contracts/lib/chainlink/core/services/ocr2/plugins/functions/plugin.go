@@ -121,7 +121,16 @@ func NewFunctionsServices(ctx context.Context, functionsOracleArgs, thresholdOra
 	}
 	conf.Logger.Debugf("external adapter exponentialBackoffBase configured to: %g sec", exponentialBackoffBase.Seconds())
 
-	bridgeAccessor := functions.NewBridgeAccessor(conf.BridgeORM, FunctionsBridgeName, MaxAdapterResponseBytes, maxRetries, exponentialBackoffBase)
+	bridgeNames := append([]string{FunctionsBridgeName}, pluginConfig.ExternalAdapterAdditionalBridgeNames...)
+	var hedgeDelay time.Duration
+	if pluginConfig.ExternalAdapterHedgeDelayMillis != nil {
+		hedgeDelay = time.Duration(*pluginConfig.ExternalAdapterHedgeDelayMillis) * time.Millisecond
+	}
+	if len(bridgeNames) > 1 {
+		conf.Logger.Debugf("external adapter bridges configured to: %v, hedge delay: %s", bridgeNames, hedgeDelay)
+	}
+
+	bridgeAccessor := functions.NewBridgeAccessor(conf.BridgeORM, bridgeNames, MaxAdapterResponseBytes, maxRetries, exponentialBackoffBase, hedgeDelay)
 	functionsListener := functions.NewFunctionsListener(
 		conf.Job,
 		conf.Chain.Client(),
@@ -221,7 +230,7 @@ func NewConnector(ctx context.Context, pluginConfig *config.PluginConfig, ethKey
 	if err != nil {
 		return nil, nil, err
 	}
-	err = connector.AddHandler([]string{hf.MethodSecretsSet, hf.MethodSecretsList, hf.MethodHeartbeat}, handler)
+	err = connector.AddHandler([]string{hf.MethodSecretsSet, hf.MethodSecretsList, hf.MethodSecretsDelete, hf.MethodSecretsWatch, hf.MethodHeartbeat}, handler)
 	if err != nil {
 		return nil, nil, err
 	}
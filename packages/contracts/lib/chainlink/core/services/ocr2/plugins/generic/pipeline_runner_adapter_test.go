@@ -56,6 +56,7 @@ func TestAdapter_Integration(t *testing.T) {
 		nil,
 		keystore.Eth(),
 		keystore.VRF(),
+		keystore.CSA(),
 		logger,
 		http.DefaultClient,
 		http.DefaultClient,
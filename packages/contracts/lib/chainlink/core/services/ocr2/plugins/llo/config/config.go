@@ -4,12 +4,15 @@
 package config
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
 	"regexp"
 	"sort"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -30,6 +33,13 @@ type PluginConfig struct {
 	// ChannelDefinitionsContractFromBlock will be ignored
 	ChannelDefinitions string `json:"channelDefinitions" toml:"channelDefinitions"`
 
+	// ChannelDefinitionsSource loads the channel definitions from a local
+	// file or an S3-compatible store instead of a ChannelConfigStore
+	// contract, and reloads them when they change. Like ChannelDefinitions,
+	// it is exclusive with ChannelDefinitionsContractAddress and
+	// ChannelDefinitionsContractFromBlock.
+	ChannelDefinitionsSource *ChannelDefinitionsSource `json:"channelDefinitionsSource,omitempty" toml:"channelDefinitionsSource"`
+
 	// BenchmarkMode is a flag to enable benchmarking mode. In this mode, the
 	// transmitter will not transmit anything at all and instead emit
 	// logs/metrics.
@@ -47,16 +57,92 @@ type PluginConfig struct {
 	Transmitters []TransmitterConfig `json:"transmitters" toml:"transmitters"`
 }
 
+// ChannelDefinitionsSource is the location of a channel definitions JSON
+// file, in the same format as the files referenced by the ChannelConfigStore
+// contract.
+type ChannelDefinitionsSource struct {
+	// URL of the channel definitions, either file:///path/to/definitions.json
+	// or s3://bucket/key
+	URL string `json:"url" toml:"url"`
+	// S3Endpoint is the base URL of the S3-compatible store, e.g.
+	// http://localhost:9000 for MinIO. Defaults to AWS S3 in S3Region.
+	// Credentials are read from the AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY
+	// and AWS_SESSION_TOKEN environment variables; requests are anonymous if
+	// they are not set.
+	S3Endpoint string `json:"s3Endpoint,omitempty" toml:"s3Endpoint"`
+	// S3Region defaults to us-east-1
+	S3Region string `json:"s3Region,omitempty" toml:"s3Region"`
+	// SHA256 pins the hex-encoded SHA256 of the definitions; definitions with
+	// another digest are rejected.
+	SHA256 string `json:"sha256,omitempty" toml:"sha256"`
+	// SHA256URL is the location of a file holding the hex-encoded SHA256 of
+	// the definitions, in the same store as URL. Unlike SHA256, it is updated
+	// along with the definitions to roll out new ones.
+	SHA256URL string `json:"sha256URL,omitempty" toml:"sha256URL"`
+	// PollIntervalMs is how often the definitions are checked for changes,
+	// defaults to 10s
+	PollIntervalMs uint32 `json:"pollIntervalMs,omitempty" toml:"pollIntervalMs"`
+}
+
+func (s ChannelDefinitionsSource) Validate() (merr error) {
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		return fmt.Errorf("llo: invalid ChannelDefinitionsSource URL: %w", err)
+	}
+	switch u.Scheme {
+	case "file":
+		if u.Path == "" {
+			merr = errors.Join(merr, fmt.Errorf("llo: ChannelDefinitionsSource URL must have a path, got: %q", s.URL))
+		}
+		if s.S3Endpoint != "" || s.S3Region != "" {
+			merr = errors.Join(merr, errors.New("llo: ChannelDefinitionsSource S3Endpoint and S3Region are not allowed for file URLs"))
+		}
+	case "s3":
+		if u.Host == "" || strings.TrimPrefix(u.Path, "/") == "" {
+			merr = errors.Join(merr, fmt.Errorf("llo: ChannelDefinitionsSource URL must be s3://bucket/key, got: %q", s.URL))
+		}
+		if s.S3Endpoint != "" {
+			if err := validateHTTPURL(s.S3Endpoint); err != nil {
+				merr = errors.Join(merr, fmt.Errorf("llo: invalid ChannelDefinitionsSource S3Endpoint: %w", err))
+			}
+		}
+	default:
+		merr = errors.Join(merr, fmt.Errorf("llo: ChannelDefinitionsSource URL scheme must be file or s3, got: %q", s.URL))
+	}
+	if s.SHA256 != "" {
+		if s.SHA256URL != "" {
+			merr = errors.Join(merr, errors.New("llo: ChannelDefinitionsSource SHA256 and SHA256URL are mutually exclusive"))
+		}
+		if b, err := hex.DecodeString(s.SHA256); err != nil || len(b) != sha256.Size {
+			merr = errors.Join(merr, fmt.Errorf("llo: ChannelDefinitionsSource SHA256 must be a 32-byte hex string, got: %q", s.SHA256))
+		}
+	}
+	if s.SHA256URL != "" {
+		if shaURL, err := url.Parse(s.SHA256URL); err != nil || shaURL.Scheme != u.Scheme {
+			merr = errors.Join(merr, fmt.Errorf("llo: ChannelDefinitionsSource SHA256URL must be in the same store as URL, got: %q", s.SHA256URL))
+		}
+	}
+	return merr
+}
+
 type TransmitterType int
 
 const (
 	TransmitterTypeCRE TransmitterType = iota
+	// TransmitterTypeFile appends the reports to rotating local files
+	TransmitterTypeFile
+	// TransmitterTypeWebhook posts the reports to an HTTP endpoint
+	TransmitterTypeWebhook
 )
 
 func (t TransmitterType) String() string {
 	switch t {
 	case TransmitterTypeCRE:
 		return "cre"
+	case TransmitterTypeFile:
+		return "file"
+	case TransmitterTypeWebhook:
+		return "webhook"
 	default:
 		return fmt.Sprintf("unknown transmitter type: %d", t)
 	}
@@ -66,6 +152,10 @@ func (t *TransmitterType) UnmarshalText(text []byte) error {
 	switch string(text) {
 	case "cre":
 		*t = TransmitterTypeCRE
+	case "file":
+		*t = TransmitterTypeFile
+	case "webhook":
+		*t = TransmitterTypeWebhook
 	default:
 		return fmt.Errorf("unknown transmitter type: %s", text)
 	}
@@ -110,7 +200,18 @@ func (p PluginConfig) Validate() (merr error) {
 		}
 	}
 
-	if p.ChannelDefinitions != "" {
+	if p.ChannelDefinitions != "" && p.ChannelDefinitionsSource != nil {
+		merr = errors.Join(merr, errors.New("llo: ChannelDefinitions and ChannelDefinitionsSource are mutually exclusive"))
+	}
+	if p.ChannelDefinitionsSource != nil {
+		if p.ChannelDefinitionsContractAddress != (common.Address{}) {
+			merr = errors.Join(merr, errors.New("llo: ChannelDefinitionsContractAddress is not allowed if ChannelDefinitionsSource is specified"))
+		}
+		if p.ChannelDefinitionsContractFromBlock != 0 {
+			merr = errors.Join(merr, errors.New("llo: ChannelDefinitionsContractFromBlock is not allowed if ChannelDefinitionsSource is specified"))
+		}
+		merr = errors.Join(merr, p.ChannelDefinitionsSource.Validate())
+	} else if p.ChannelDefinitions != "" {
 		if p.ChannelDefinitionsContractAddress != (common.Address{}) {
 			merr = errors.Join(merr, errors.New("llo: ChannelDefinitionsContractAddress is not allowed if ChannelDefinitions is specified"))
 		}
@@ -167,5 +268,16 @@ func validateKeyBundleIDs(keyBundleIDs map[string]string) error {
 	return nil
 }
 
+func validateHTTPURL(rawURL string) error {
+	u, err := url.ParseRequestURI(rawURL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("expected an http or https URL, got: %q", rawURL)
+	}
+	return nil
+}
+
 var schemeRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*://`)
 var wssRegexp = regexp.MustCompile(`^wss://`)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pelletier/go-toml/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -173,3 +174,89 @@ func Test_PluginConfig_GetServers(t *testing.T) {
 		assert.Equal(t, utils.PlainHexBytes{4, 5, 6}, pc.GetServers()[1].PubKey)
 	})
 }
+
+func Test_TransmitterConfig(t *testing.T) {
+	var pc PluginConfig
+	err := pc.Unmarshal([]byte(`{"transmitters": [
+		{"type": "cre", "opts": {}},
+		{"type": "file", "opts": {"dir": "/tmp/reports"}},
+		{"type": "webhook", "opts": {"url": "https://example.com/reports"}}
+	]}`))
+	require.NoError(t, err)
+
+	require.Len(t, pc.Transmitters, 3)
+	assert.Equal(t, TransmitterTypeCRE, pc.Transmitters[0].Type)
+	assert.Equal(t, TransmitterTypeFile, pc.Transmitters[1].Type)
+	assert.JSONEq(t, `{"dir": "/tmp/reports"}`, string(pc.Transmitters[1].Opts))
+	assert.Equal(t, TransmitterTypeWebhook, pc.Transmitters[2].Type)
+	assert.Equal(t, "webhook", pc.Transmitters[2].Type.String())
+
+	err = pc.Unmarshal([]byte(`{"transmitters": [{"type": "kafka"}]}`))
+	require.EqualError(t, err, "unknown transmitter type: kafka")
+}
+
+func Test_ChannelDefinitionsSource(t *testing.T) {
+	t.Run("with file source", func(t *testing.T) {
+		rawToml := `
+			DonID = 12345
+			Servers = { "example.com:80" = "724ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93" }
+			[channelDefinitionsSource]
+			url = "file:///etc/llo/channel-definitions.json"
+			sha256URL = "file:///etc/llo/channel-definitions.json.sha256"
+			pollIntervalMs = 5000
+		`
+
+		var mc PluginConfig
+		err := toml.Unmarshal([]byte(rawToml), &mc)
+		require.NoError(t, err)
+
+		require.NotNil(t, mc.ChannelDefinitionsSource)
+		assert.Equal(t, "file:///etc/llo/channel-definitions.json", mc.ChannelDefinitionsSource.URL)
+		assert.Equal(t, uint32(5000), mc.ChannelDefinitionsSource.PollIntervalMs)
+		require.NoError(t, mc.Validate())
+	})
+	t.Run("with s3 source", func(t *testing.T) {
+		var mc PluginConfig
+		err := mc.Unmarshal([]byte(`{
+			"donID": 12345,
+			"servers": {"example.com:80": "724ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93"},
+			"channelDefinitionsSource": {
+				"url": "s3://llo/channel-definitions.json",
+				"s3Endpoint": "http://localhost:9000",
+				"sha256": "4a2a96deabf8bbcc8bf8e7e0f98ad2bd14b9a6e7e0ab5d4e61ed2e2b4a5fc3e8"
+			}
+		}`))
+		require.NoError(t, err)
+		require.NoError(t, mc.Validate())
+	})
+	t.Run("with invalid values", func(t *testing.T) {
+		mc := PluginConfig{
+			DonID:                             12345,
+			Transmitters:                      []TransmitterConfig{{Type: TransmitterTypeFile}},
+			ChannelDefinitions:                "{}",
+			ChannelDefinitionsContractAddress: common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF"),
+			ChannelDefinitionsSource: &ChannelDefinitionsSource{
+				URL:        "file:///etc/llo/channel-definitions.json",
+				S3Endpoint: "localhost:9000",
+				SHA256:     "abcd",
+				SHA256URL:  "s3://llo/channel-definitions.json.sha256",
+			},
+		}
+		err := mc.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ChannelDefinitions and ChannelDefinitionsSource are mutually exclusive")
+		assert.Contains(t, err.Error(), "ChannelDefinitionsContractAddress is not allowed if ChannelDefinitionsSource is specified")
+		assert.Contains(t, err.Error(), "S3Endpoint and S3Region are not allowed for file URLs")
+		assert.Contains(t, err.Error(), "SHA256 and SHA256URL are mutually exclusive")
+		assert.Contains(t, err.Error(), `SHA256 must be a 32-byte hex string, got: "abcd"`)
+		assert.Contains(t, err.Error(), `SHA256URL must be in the same store as URL, got: "s3://llo/channel-definitions.json.sha256"`)
+
+		for _, src := range []ChannelDefinitionsSource{
+			{URL: "https://example.com/channel-definitions.json"},
+			{URL: "s3://llo"},
+			{URL: "s3://llo/channel-definitions.json", S3Endpoint: "ftp://localhost"},
+		} {
+			require.Error(t, src.Validate(), src.URL)
+		}
+	})
+}
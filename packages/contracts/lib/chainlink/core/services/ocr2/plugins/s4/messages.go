@@ -70,6 +70,7 @@ func (row *Row) VerifySignature() error {
 		Payload:    row.Payload,
 		Version:    row.Version,
 		Expiration: row.Expiration,
+		Deleted:    row.Deleted,
 	}
 	signer, err := e.GetSignerAddress(row.Signature)
 	if err != nil {
@@ -66,6 +66,7 @@ func signRow(t *testing.T, row *s4.Row, address common.Address, pk *ecdsa.Privat
 		Version:    row.Version,
 		Expiration: row.Expiration,
 		Payload:    row.Payload,
+		Deleted:    row.Deleted,
 	}
 	sig, err := env.Sign(pk)
 	require.NoError(t, err)
@@ -119,4 +120,22 @@ func Test_VerifySignature(t *testing.T) {
 		sameRow := marshalUnmarshal(t, row)
 		require.NoError(t, sameRow.VerifySignature())
 	})
+
+	t.Run("deletion", func(t *testing.T) {
+		pk, addr := testutils.NewPrivateKeyAndAddress(t)
+		row := generateTestRows(t, 1, time.Minute)[0]
+		row.Payload = nil
+		row.Deleted = true
+		row.Address = addr.Big().Bytes()
+		signRow(t, row, addr, pk)
+
+		require.NoError(t, row.VerifySignature())
+		sameRow := marshalUnmarshal(t, row)
+		require.True(t, sameRow.Deleted)
+		require.NoError(t, sameRow.VerifySignature())
+
+		// a deletion cannot be replayed as a record with an empty payload
+		sameRow.Deleted = false
+		require.Error(t, sameRow.VerifySignature())
+	})
 }
@@ -2,14 +2,21 @@ package periodicbackup
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
+	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 
@@ -19,7 +26,11 @@ import (
 )
 
 var (
-	filePattern        = "cl_backup_%s.dump"
+	filePrefix         = "cl_backup_"
+	filePattern        = filePrefix + "%s_%s.dump"
+	fileTimeFormat     = "20060102T150405Z"
+	encryptedSuffix    = ".enc"
+	checksumSuffix     = ".sha256"
 	minBackupFrequency = time.Minute
 
 	excludedDataFromTables = []string{
@@ -31,6 +42,7 @@ var (
 type backupResult struct {
 	size            int64
 	path            string
+	checksum        string
 	maskedArguments []string
 	pgDumpArguments []string
 }
@@ -47,8 +59,16 @@ type (
 		databaseURL     url.URL
 		mode            config.DatabaseBackupMode
 		frequency       time.Duration
+		retention       uint32
+		encrypt         bool
+		encryption      Encryption
 		outputParentDir string
 		done            chan bool
+
+		mu           sync.RWMutex
+		startedAt    time.Time
+		lastBackupAt time.Time
+		lastErr      error
 	}
 
 	BackupConfig interface {
@@ -56,37 +76,53 @@ type (
 		Dir() string
 		Mode() config.DatabaseBackupMode
 		Frequency() time.Duration
+		Retention() uint32
+		Encrypt() bool
 	}
 )
 
 // NewDatabaseBackup instantiates a *databaseBackup
-func NewDatabaseBackup(dbUrl url.URL, rootDir string, backupConfig BackupConfig, lggr logger.Logger) (DatabaseBackup, error) {
+func NewDatabaseBackup(dbUrl url.URL, rootDir string, backupConfig BackupConfig, encryption Encryption, lggr logger.Logger) (DatabaseBackup, error) {
 	lggr = lggr.Named("DatabaseBackup")
 	dbBackupUrl := backupConfig.URL()
 	if dbBackupUrl != nil {
 		dbUrl = *dbBackupUrl
 	}
 
-	outputParentDir := filepath.Join(rootDir, "backup")
-	if backupConfig.Dir() != "" {
-		dir, err := filepath.Abs(backupConfig.Dir())
-		if err != nil {
-			return nil, errors.Errorf("failed to get path for Database.Backup.Dir (%s) - please set it to a valid directory path", backupConfig.Dir())
-		}
-		outputParentDir = dir
+	outputParentDir, err := BackupDir(rootDir, backupConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if backupConfig.Encrypt() && encryption.Password == "" {
+		return nil, errors.New("Database.Backup.Encrypt requires a keystore password")
 	}
 
 	return &databaseBackup{
-		services.StateMachine{},
-		lggr,
-		dbUrl,
-		backupConfig.Mode(),
-		backupConfig.Frequency(),
-		outputParentDir,
-		make(chan bool),
+		logger:          lggr,
+		databaseURL:     dbUrl,
+		mode:            backupConfig.Mode(),
+		frequency:       backupConfig.Frequency(),
+		retention:       backupConfig.Retention(),
+		encrypt:         backupConfig.Encrypt(),
+		encryption:      encryption,
+		outputParentDir: outputParentDir,
+		done:            make(chan bool),
 	}, nil
 }
 
+// BackupDir returns the directory backups are written to.
+func BackupDir(rootDir string, backupConfig BackupConfig) (string, error) {
+	if backupConfig.Dir() == "" {
+		return filepath.Join(rootDir, "backup"), nil
+	}
+	dir, err := filepath.Abs(backupConfig.Dir())
+	if err != nil {
+		return "", errors.Errorf("failed to get path for Database.Backup.Dir (%s) - please set it to a valid directory path", backupConfig.Dir())
+	}
+	return dir, nil
+}
+
 // Start starts DatabaseBackup.
 func (backup *databaseBackup) Start(context.Context) error {
 	return backup.StartOnce("DatabaseBackup", func() (err error) {
@@ -99,6 +135,10 @@ func (backup *databaseBackup) Start(context.Context) error {
 			return errors.Errorf("Database backup frequency (%s=%v) is too small. Please set it to at least %s (or set to 0 to disable periodic backups)", "Database.Backup.Frequency", backup.frequency, minBackupFrequency)
 		}
 
+		backup.mu.Lock()
+		backup.startedAt = time.Now()
+		backup.mu.Unlock()
+
 		go func() {
 			for {
 				select {
@@ -129,7 +169,29 @@ func (backup *databaseBackup) Name() string {
 }
 
 func (backup *databaseBackup) HealthReport() map[string]error {
-	return map[string]error{backup.Name(): backup.Healthy()}
+	return map[string]error{backup.Name(): multierr.Combine(backup.Healthy(), backup.backupHealth(time.Now()))}
+}
+
+// backupHealth returns an error if the last backup failed, or if periodic backups are overdue.
+func (backup *databaseBackup) backupHealth(now time.Time) error {
+	backup.mu.RLock()
+	defer backup.mu.RUnlock()
+
+	if backup.lastErr != nil {
+		return errors.Wrap(backup.lastErr, "last backup failed")
+	}
+	if backup.frequency == 0 || backup.startedAt.IsZero() {
+		return nil
+	}
+	// Backups can take a while, they are overdue once they miss a full period
+	last := backup.lastBackupAt
+	if last.IsZero() {
+		last = backup.startedAt
+	}
+	if overdue := now.Sub(last) - 2*backup.frequency; overdue > 0 {
+		return errors.Errorf("backup is overdue by %s", overdue.Round(time.Second))
+	}
+	return nil
 }
 
 func (backup *databaseBackup) frequencyIsTooSmall() bool {
@@ -137,16 +199,28 @@ func (backup *databaseBackup) frequencyIsTooSmall() bool {
 }
 
 func (backup *databaseBackup) RunBackup(version string) error {
-	backup.logger.Debugw("Starting backup", "mode", backup.mode, "directory", backup.outputParentDir)
+	backup.logger.Debugw("Starting backup", "mode", backup.mode, "directory", backup.outputParentDir, "encrypt", backup.encrypt)
 	startAt := time.Now()
 	result, err := backup.runBackup(version)
 	duration := time.Since(startAt)
+
+	backup.mu.Lock()
+	backup.lastErr = err
+	if err == nil {
+		backup.lastBackupAt = startAt
+	}
+	backup.mu.Unlock()
+
 	if err != nil {
 		backup.logger.Criticalw("Backup failed", "duration", duration, "err", err)
 		backup.SvcErrBuffer.Append(err)
 		return err
 	}
-	backup.logger.Infow("Backup completed successfully.", "duration", duration, "fileSize", result.size, "filePath", result.path)
+	backup.logger.Infow("Backup completed successfully.", "duration", duration, "fileSize", result.size, "filePath", result.path, "sha256", result.checksum)
+
+	if err = backup.pruneBackups(); err != nil {
+		backup.logger.Errorw("Failed to remove old backups", "retention", backup.retention, "err", err)
+	}
 	return nil
 }
 
@@ -206,16 +280,35 @@ func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
 		return partialResult, errors.Wrap(err, "pg_dump failed")
 	}
 
+	dumpPath := tmpFile.Name()
+	defer os.Remove(dumpPath)
+	if backup.encrypt {
+		dumpPath, err = backup.encryptDump(dumpPath)
+		if err != nil {
+			return nil, err
+		}
+		defer os.Remove(dumpPath)
+	}
+
+	checksum, err := fileChecksum(dumpPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to compute the backup checksum")
+	}
+
 	if version == "" {
 		version = "unknown"
 	}
-	finalFilePath := filepath.Join(backup.outputParentDir, fmt.Sprintf(filePattern, version))
-	_ = os.Remove(finalFilePath)
-	err = os.Rename(tmpFile.Name(), finalFilePath)
+	finalFilePath := filepath.Join(backup.outputParentDir, fmt.Sprintf(filePattern, version, time.Now().UTC().Format(fileTimeFormat)))
+	if backup.encrypt {
+		finalFilePath += encryptedSuffix
+	}
+	err = os.Rename(dumpPath, finalFilePath)
 	if err != nil {
-		_ = os.Remove(tmpFile.Name())
 		return nil, errors.Wrap(err, "Failed to rename the temp file to the final backup file")
 	}
+	if err = writeChecksumFile(finalFilePath, checksum); err != nil {
+		return nil, errors.Wrap(err, "Failed to write the backup checksum file")
+	}
 
 	file, err := os.Stat(finalFilePath)
 	if err != nil {
@@ -225,7 +318,121 @@ func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
 	return &backupResult{
 		size:            file.Size(),
 		path:            finalFilePath,
+		checksum:        checksum,
 		maskedArguments: maskedArgs,
 		pgDumpArguments: args,
 	}, nil
 }
+
+// encryptDump encrypts the dump at path into a new temp file, whose path is returned.
+func (backup *databaseBackup) encryptDump(path string) (string, error) {
+	src, err := os.Open(path)
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to open the dump")
+	}
+	defer src.Close()
+
+	dst, err := os.CreateTemp(backup.outputParentDir, "cl_backup_tmp_")
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to create a tmp file")
+	}
+	err = encryptBackup(dst, src, backup.encryption.Password, backup.encryption.ScryptParams)
+	err = multierr.Combine(err, dst.Close())
+	if err != nil {
+		_ = os.Remove(dst.Name())
+		return "", errors.Wrap(err, "Failed to encrypt the dump")
+	}
+	return dst.Name(), nil
+}
+
+// pruneBackups removes the oldest backups beyond the retention count, along with their checksum files.
+func (backup *databaseBackup) pruneBackups() error {
+	if backup.retention == 0 {
+		return nil
+	}
+	backups, err := ListBackups(backup.outputParentDir)
+	if err != nil {
+		return err
+	}
+	if len(backups) <= int(backup.retention) {
+		return nil
+	}
+	for _, old := range backups[backup.retention:] {
+		backup.logger.Infow("Removing old backup", "filePath", old.Path)
+		if rerr := os.Remove(old.Path); rerr != nil && !os.IsNotExist(rerr) {
+			err = multierr.Append(err, rerr)
+			continue
+		}
+		if rerr := os.Remove(old.Path + checksumSuffix); rerr != nil && !os.IsNotExist(rerr) {
+			err = multierr.Append(err, rerr)
+		}
+	}
+	return err
+}
+
+// Backup is a database backup file.
+type Backup struct {
+	Path      string
+	Size      int64
+	ModTime   time.Time
+	Encrypted bool
+}
+
+// ListBackups returns the backups in dir, newest first.
+func ListBackups(dir string) ([]Backup, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "Failed to list backups in %s", dir)
+	}
+
+	var backups []Backup
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, filePrefix) || strings.HasPrefix(name, "cl_backup_tmp_") {
+			continue
+		}
+		encrypted := strings.HasSuffix(name, ".dump"+encryptedSuffix)
+		if !encrypted && !strings.HasSuffix(name, ".dump") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		backups = append(backups, Backup{
+			Path:      filepath.Join(dir, name),
+			Size:      info.Size(),
+			ModTime:   info.ModTime(),
+			Encrypted: encrypted,
+		})
+	}
+	sort.SliceStable(backups, func(i, j int) bool {
+		return backups[i].ModTime.After(backups[j].ModTime)
+	})
+	return backups, nil
+}
+
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// writeChecksumFile writes the checksum of the backup at path next to it, in the format of sha256sum.
+func writeChecksumFile(path string, checksum string) error {
+	return os.WriteFile(path+checksumSuffix, []byte(fmt.Sprintf("%s  %s\n", checksum, filepath.Base(path))), 0o600)
+}
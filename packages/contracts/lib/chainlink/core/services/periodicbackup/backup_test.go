@@ -1,9 +1,12 @@
 package periodicbackup
 
 import (
+	"bytes"
+	"errors"
 	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,11 +18,12 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func mustNewDatabaseBackup(t *testing.T, url url.URL, rootDir string, config BackupConfig) *databaseBackup {
 	testutils.SkipShortDB(t)
-	b, err := NewDatabaseBackup(url, rootDir, config, logger.TestLogger(t))
+	b, err := NewDatabaseBackup(url, rootDir, config, Encryption{Password: "p4SsW0rD1!@#_", ScryptParams: utils.FastScryptParams}, logger.TestLogger(t))
 	require.NoError(t, err)
 	return b.(*databaseBackup)
 }
@@ -117,7 +121,97 @@ func TestPeriodicBackup_AlternativeOutputDir(t *testing.T) {
 	require.NoError(t, err, "error not nil when checking for output file")
 
 	assert.Positive(t, file.Size())
-	assert.Contains(t, result.path, "/alternative/cl_backup_0.9.9.dump")
+	assert.Contains(t, result.path, "/alternative/cl_backup_0.9.9_")
+	assert.True(t, strings.HasSuffix(result.path, ".dump"))
+}
+
+func TestPeriodicBackup_RunBackupEncrypted(t *testing.T) {
+	backupDir := t.TempDir()
+	backupConfig := newTestConfig(time.Minute, nil, backupDir, config.DatabaseBackupModeLite)
+	backupConfig.encrypt = true
+	periodicBackup := mustNewDatabaseBackup(t, *(must(t, string(env.DatabaseURL.Get()))), os.TempDir(), backupConfig)
+
+	result, err := periodicBackup.runBackup("0.9.9")
+	require.NoError(t, err, "error not nil for backup")
+
+	assert.True(t, strings.HasSuffix(result.path, ".dump.enc"))
+	encrypted, err := isEncryptedBackup(result.path)
+	require.NoError(t, err)
+	assert.True(t, encrypted)
+	require.NoError(t, VerifyBackup(result.path))
+
+	checksum, err := os.ReadFile(result.path + ".sha256")
+	require.NoError(t, err)
+	assert.Equal(t, result.checksum+"  "+filepath.Base(result.path)+"\n", string(checksum))
+
+	dumpPath, err := decryptToTemp(result.path, "p4SsW0rD1!@#_")
+	require.NoError(t, err)
+	defer os.Remove(dumpPath)
+	dump, err := os.ReadFile(dumpPath)
+	require.NoError(t, err)
+	// pg_dump custom format archives start with PGDMP
+	assert.True(t, bytes.HasPrefix(dump, []byte("PGDMP")))
+}
+
+func TestPeriodicBackup_EncryptRequiresPassword(t *testing.T) {
+	backupConfig := newTestConfig(time.Minute, nil, "", config.DatabaseBackupModeFull)
+	backupConfig.encrypt = true
+	_, err := NewDatabaseBackup(url.URL{}, os.TempDir(), backupConfig, Encryption{}, logger.TestLogger(t))
+	require.ErrorContains(t, err, "requires a keystore password")
+}
+
+func TestPeriodicBackup_PruneBackups(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+	var paths []string
+	for i, name := range []string{"cl_backup_1.0.0.dump", "cl_backup_2.0.0_20240101T000000Z.dump", "cl_backup_2.0.0_20240102T000000Z.dump.enc", "cl_backup_2.0.0_20240103T000000Z.dump"} {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(name), 0o600))
+		require.NoError(t, writeChecksumFile(path, "checksum"))
+		modTime := now.Add(time.Duration(i-4) * time.Hour)
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+		paths = append(paths, path)
+	}
+	// Unrelated files are left untouched
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), nil, 0o600))
+
+	backups, err := ListBackups(dir)
+	require.NoError(t, err)
+	require.Len(t, backups, 4)
+	assert.Equal(t, paths[3], backups[0].Path)
+	assert.True(t, backups[1].Encrypted)
+
+	backup := &databaseBackup{logger: logger.TestLogger(t), outputParentDir: dir, retention: 2}
+	require.NoError(t, backup.pruneBackups())
+
+	backups, err = ListBackups(dir)
+	require.NoError(t, err)
+	require.Len(t, backups, 2)
+	assert.Equal(t, paths[3], backups[0].Path)
+	assert.Equal(t, paths[2], backups[1].Path)
+	for _, removed := range paths[:2] {
+		assert.NoFileExists(t, removed)
+		assert.NoFileExists(t, removed+".sha256")
+	}
+	assert.FileExists(t, filepath.Join(dir, "notes.txt"))
+}
+
+func TestPeriodicBackup_BackupHealth(t *testing.T) {
+	now := time.Now()
+	backup := &databaseBackup{frequency: time.Hour}
+	assert.NoError(t, backup.backupHealth(now), "not started")
+
+	backup.startedAt = now.Add(-90 * time.Minute)
+	assert.NoError(t, backup.backupHealth(now))
+
+	backup.startedAt = now.Add(-3 * time.Hour)
+	assert.ErrorContains(t, backup.backupHealth(now), "backup is overdue by 1h0m0s")
+
+	backup.lastBackupAt = now.Add(-time.Hour)
+	assert.NoError(t, backup.backupHealth(now))
+
+	backup.lastErr = errors.New("pg_dump failed")
+	assert.ErrorContains(t, backup.backupHealth(now), "last backup failed: pg_dump failed")
 }
 
 type testConfig struct {
@@ -125,6 +219,8 @@ type testConfig struct {
 	mode      config.DatabaseBackupMode
 	url       *url.URL
 	dir       string
+	retention uint32
+	encrypt   bool
 }
 
 func (t *testConfig) Frequency() time.Duration {
@@ -143,11 +239,20 @@ func (t *testConfig) Dir() string {
 	return t.dir
 }
 
+func (t *testConfig) Retention() uint32 {
+	return t.retention
+}
+
+func (t *testConfig) Encrypt() bool {
+	return t.encrypt
+}
+
 func newTestConfig(frequency time.Duration, databaseBackupURL *url.URL, databaseBackupDir string, mode config.DatabaseBackupMode) *testConfig {
 	return &testConfig{
 		frequency: frequency,
 		mode:      mode,
 		url:       databaseBackupURL,
 		dir:       databaseBackupDir,
+		retention: 3,
 	}
 }
@@ -0,0 +1,166 @@
+package periodicbackup
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// Encrypted backups start with a header holding the scrypt parameters and salt the key is derived with. The dump
+// follows, sealed with AES-256-GCM in chunks of encryptionChunkSize bytes. The nonce of each chunk holds its index
+// and flags the final chunk, and the header is authenticated with every chunk, so that tampered, truncated or
+// reordered backups fail to decrypt.
+const (
+	encryptionMagic     = "CLBKENC1"
+	encryptionSaltSize  = 32
+	encryptionHeaderLen = len(encryptionMagic) + 4 + 4 + encryptionSaltSize
+	encryptionChunkSize = 64 * 1024
+	encryptionScryptR   = 8
+	encryptionKeySize   = 32
+)
+
+// Encryption holds the password backups are encrypted with when Database.Backup.Encrypt is set, and the scrypt
+// parameters the encryption key is derived with.
+type Encryption struct {
+	Password     string
+	ScryptParams utils.ScryptParams
+}
+
+func newBackupCipher(password string, header []byte) (cipher.AEAD, error) {
+	magicLen := len(encryptionMagic)
+	n := int(binary.BigEndian.Uint32(header[magicLen:]))
+	p := int(binary.BigEndian.Uint32(header[magicLen+4:]))
+	salt := header[magicLen+8:]
+	// The header is read before it is authenticated, so the cost of the key derivation is bounded by the parameters
+	// backups are written with
+	if n > utils.DefaultScryptParams.N || p > utils.DefaultScryptParams.P {
+		return nil, errors.Errorf("backup encryption header has scrypt parameters N=%d P=%d, above the maximum N=%d P=%d", n, p, utils.DefaultScryptParams.N, utils.DefaultScryptParams.P)
+	}
+
+	key, err := scrypt.Key([]byte(password), salt, n, encryptionScryptR, p, encryptionKeySize)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to derive the backup encryption key")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// chunkNonce returns the nonce of the chunk at index.
+func chunkNonce(aead cipher.AEAD, index uint64, final bool) []byte {
+	nonce := make([]byte, aead.NonceSize())
+	binary.BigEndian.PutUint64(nonce[len(nonce)-9:], index)
+	if final {
+		nonce[len(nonce)-1] = 1
+	}
+	return nonce
+}
+
+// encryptBackup encrypts src into dst with a key derived from password.
+func encryptBackup(dst io.Writer, src io.Reader, password string, params utils.ScryptParams) error {
+	if password == "" {
+		return errors.New("backup encryption requires a keystore password")
+	}
+
+	header := make([]byte, encryptionHeaderLen)
+	copy(header, encryptionMagic)
+	binary.BigEndian.PutUint32(header[len(encryptionMagic):], uint32(params.N))   //nolint:gosec // scrypt parameters are small
+	binary.BigEndian.PutUint32(header[len(encryptionMagic)+4:], uint32(params.P)) //nolint:gosec // scrypt parameters are small
+	if _, err := rand.Read(header[len(encryptionMagic)+8:]); err != nil {
+		return errors.Wrap(err, "failed to generate salt")
+	}
+	aead, err := newBackupCipher(password, header)
+	if err != nil {
+		return err
+	}
+	if _, err = dst.Write(header); err != nil {
+		return err
+	}
+
+	buf := make([]byte, encryptionChunkSize)
+	sealed := make([]byte, 0, encryptionChunkSize+aead.Overhead())
+	for index := uint64(0); ; index++ {
+		n, err := io.ReadFull(src, buf)
+		final := errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+		if err != nil && !final {
+			return err
+		}
+		sealed = aead.Seal(sealed[:0], chunkNonce(aead, index, final), buf[:n], header)
+		if _, err = dst.Write(sealed); err != nil {
+			return err
+		}
+		if final {
+			return nil
+		}
+	}
+}
+
+// decryptBackup decrypts src, which was encrypted by encryptBackup, into dst.
+func decryptBackup(dst io.Writer, src io.Reader, password string) error {
+	r := bufio.NewReader(src)
+	header := make([]byte, encryptionHeaderLen)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return errors.Wrap(err, "failed to read the backup encryption header")
+	}
+	if !bytes.HasPrefix(header, []byte(encryptionMagic)) {
+		return errors.New("backup is not encrypted")
+	}
+	aead, err := newBackupCipher(password, header)
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, encryptionChunkSize+aead.Overhead())
+	for index := uint64(0); ; index++ {
+		n, err := io.ReadFull(r, buf)
+		final := errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+		if err != nil && !final {
+			return err
+		}
+		if !final {
+			if _, err = r.Peek(1); errors.Is(err, io.EOF) {
+				final = true
+			}
+		}
+		plain, err := aead.Open(buf[:0], chunkNonce(aead, index, final), buf[:n], header)
+		if err != nil {
+			return errors.New("failed to decrypt backup: wrong keystore password, or the backup is corrupted")
+		}
+		if _, err = dst.Write(plain); err != nil {
+			return err
+		}
+		if final {
+			return nil
+		}
+	}
+}
+
+// isEncryptedBackup returns true if the backup at path was encrypted by encryptBackup.
+func isEncryptedBackup(path string) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	magic := make([]byte, len(encryptionMagic))
+	if _, err = io.ReadFull(f, magic); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return false, nil
+		}
+		return false, err
+	}
+	return string(magic) == encryptionMagic, nil
+}
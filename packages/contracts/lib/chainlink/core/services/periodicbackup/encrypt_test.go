@@ -0,0 +1,95 @@
+package periodicbackup
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestEncryptBackup_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, size := range []int{0, 1, encryptionChunkSize - 1, encryptionChunkSize, 2*encryptionChunkSize + 5} {
+		plain := make([]byte, size)
+		_, err := rand.Read(plain)
+		require.NoError(t, err)
+
+		var encrypted bytes.Buffer
+		require.NoError(t, encryptBackup(&encrypted, bytes.NewReader(plain), "password", utils.FastScryptParams))
+		assert.NotContains(t, encrypted.String(), string(plain))
+
+		var decrypted bytes.Buffer
+		require.NoError(t, decryptBackup(&decrypted, bytes.NewReader(encrypted.Bytes()), "password"), "size %d", size)
+		assert.Equal(t, plain, decrypted.Bytes(), "size %d", size)
+	}
+}
+
+func TestEncryptBackup_Invalid(t *testing.T) {
+	t.Parallel()
+
+	plain := make([]byte, 2*encryptionChunkSize+5)
+	_, err := rand.Read(plain)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, encryptBackup(&buf, bytes.NewReader(plain), "password", utils.FastScryptParams))
+	encrypted := buf.Bytes()
+
+	t.Run("empty password", func(t *testing.T) {
+		require.ErrorContains(t, encryptBackup(&bytes.Buffer{}, bytes.NewReader(plain), "", utils.FastScryptParams), "requires a keystore password")
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		require.ErrorContains(t, decryptBackup(&bytes.Buffer{}, bytes.NewReader(encrypted), "wrong"), "failed to decrypt backup")
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		truncated := encrypted[:encryptionHeaderLen+encryptionChunkSize+16]
+		require.ErrorContains(t, decryptBackup(&bytes.Buffer{}, bytes.NewReader(truncated), "password"), "failed to decrypt backup")
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		tampered := bytes.Clone(encrypted)
+		tampered[len(tampered)-1] ^= 1
+		require.ErrorContains(t, decryptBackup(&bytes.Buffer{}, bytes.NewReader(tampered), "password"), "failed to decrypt backup")
+	})
+
+	t.Run("scrypt parameters over the maximum", func(t *testing.T) {
+		tampered := bytes.Clone(encrypted)
+		binary.BigEndian.PutUint32(tampered[len(encryptionMagic):], 1<<30)
+		require.ErrorContains(t, decryptBackup(&bytes.Buffer{}, bytes.NewReader(tampered), "password"), "above the maximum")
+	})
+
+	t.Run("not encrypted", func(t *testing.T) {
+		require.ErrorContains(t, decryptBackup(&bytes.Buffer{}, bytes.NewReader(plain), "password"), "backup is not encrypted")
+	})
+}
+
+func TestVerifyBackup(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "cl_backup_1.0.0_20240101T000000Z.dump")
+	require.NoError(t, os.WriteFile(path, []byte("PGDMP"), 0o600))
+
+	require.ErrorIs(t, VerifyBackup(path), ErrMissingChecksum)
+
+	checksum, err := fileChecksum(path)
+	require.NoError(t, err)
+	require.NoError(t, writeChecksumFile(path, checksum))
+	require.NoError(t, VerifyBackup(path))
+
+	require.NoError(t, os.WriteFile(path, []byte("PGDMP corrupted"), 0o600))
+	require.ErrorContains(t, VerifyBackup(path), "the backup is corrupted")
+
+	encrypted, err := isEncryptedBackup(path)
+	require.NoError(t, err)
+	assert.False(t, encrypted)
+}
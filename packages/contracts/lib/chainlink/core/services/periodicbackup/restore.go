@@ -0,0 +1,123 @@
+package periodicbackup
+
+import (
+	"bufio"
+	"context"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// ErrMissingChecksum is returned by VerifyBackup for backups without a checksum file, like the ones taken by older
+// versions of the node.
+var ErrMissingChecksum = errors.New("backup has no checksum file")
+
+// VerifyBackup checks the backup at path against its checksum file.
+func VerifyBackup(path string) error {
+	f, err := os.Open(path + checksumSuffix)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ErrMissingChecksum
+		}
+		return errors.Wrap(err, "failed to open the backup checksum file")
+	}
+	defer f.Close()
+
+	line, err := bufio.NewReader(f).ReadString('\n')
+	if err != nil && line == "" {
+		return errors.Wrap(err, "failed to read the backup checksum file")
+	}
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return errors.New("backup checksum file is empty")
+	}
+
+	checksum, err := fileChecksum(path)
+	if err != nil {
+		return errors.Wrap(err, "failed to compute the backup checksum")
+	}
+	if !strings.EqualFold(fields[0], checksum) {
+		return errors.Errorf("backup checksum %s does not match the checksum file %s, the backup is corrupted", checksum, fields[0])
+	}
+	return nil
+}
+
+// Restore validates the backup at path and restores it into the database at dbURL, replacing its contents.
+// Encrypted backups are decrypted with password. Backups without a checksum file are rejected, unless
+// allowMissingChecksum is set.
+func Restore(ctx context.Context, dbURL url.URL, path string, password string, allowMissingChecksum bool, lggr logger.Logger) (err error) {
+	lggr = lggr.Named("DatabaseRestore")
+
+	if err = VerifyBackup(path); err != nil {
+		if !errors.Is(err, ErrMissingChecksum) || !allowMissingChecksum {
+			return err
+		}
+		lggr.Warnw("Restoring a backup without checksum file", "filePath", path)
+	}
+
+	dumpPath := path
+	encrypted, err := isEncryptedBackup(path)
+	if err != nil {
+		return errors.Wrap(err, "failed to read the backup")
+	}
+	if encrypted {
+		if password == "" {
+			return errors.New("backup is encrypted, the keystore password it was taken with is required to restore it")
+		}
+		lggr.Infow("Decrypting backup", "filePath", path)
+		dumpPath, err = decryptToTemp(path, password)
+		if err != nil {
+			return err
+		}
+		defer os.Remove(dumpPath)
+	}
+
+	// Validate the archive before touching the database
+	if out, lerr := exec.CommandContext(ctx, "pg_restore", "--list", dumpPath).CombinedOutput(); lerr != nil {
+		return errors.Wrapf(lerr, "backup is not a valid pg_dump archive: %s", string(out))
+	}
+
+	lggr.Infow("Restoring backup, this can take a while", "filePath", path, "database", dbURL.Redacted())
+	args := []string{
+		"--dbname", dbURL.String(),
+		"--clean",
+		"--if-exists",
+		"--no-owner",
+		"--single-transaction",
+		"--exit-on-error",
+		dumpPath,
+	}
+	if out, rerr := exec.CommandContext(ctx, "pg_restore", args...).CombinedOutput(); rerr != nil {
+		return errors.Wrapf(rerr, "pg_restore failed with output: %s", string(out))
+	}
+	lggr.Infow("Backup restored", "filePath", path)
+	return nil
+}
+
+// decryptToTemp decrypts the backup at path into a temp file next to it, whose path is returned.
+func decryptToTemp(path string, password string) (string, error) {
+	src, err := os.Open(path)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to open the backup")
+	}
+	defer src.Close()
+
+	dst, err := os.CreateTemp(filepath.Dir(path), "cl_restore_tmp_")
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create a tmp file")
+	}
+	err = decryptBackup(dst, src, password)
+	err = multierr.Combine(err, dst.Close())
+	if err != nil {
+		_ = os.Remove(dst.Name())
+		return "", err
+	}
+	return dst.Name(), nil
+}
@@ -0,0 +1,211 @@
+package pipeline
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const (
+	archiveFilePrefix = "pipeline_runs_"
+	archiveFileExt    = ".jsonl.gz"
+	archiveDirPerms   = 0700
+	archiveFilePerms  = 0600
+	// maxArchivedRunSize is the maximum size of a line of an archive file, holding a run and its task runs
+	maxArchivedRunSize = 64 * 1024 * 1024
+)
+
+// archiveRunsQuery selects the runs matching a condition as JSON objects, along with their task runs.
+const archiveRunsQuery = `SELECT id, to_jsonb(pipeline_runs) || jsonb_build_object('task_runs', COALESCE((
+	SELECT jsonb_agg(to_jsonb(ptr) ORDER BY ptr.index, ptr.created_at)
+	FROM pipeline_task_runs ptr WHERE ptr.pipeline_run_id = pipeline_runs.id
+), '[]'::jsonb)) AS run
+FROM pipeline_runs WHERE %s ORDER BY id`
+
+// RunArchiver exports pipeline runs, along with their task runs, to gzip compressed JSON Lines files before they are
+// pruned or reaped, so that they can be re-imported with ImportRuns for forensic analysis. Each line of a file holds
+// a row of pipeline_runs, with the rows of its pipeline_task_runs under the "task_runs" key.
+type RunArchiver struct {
+	dir string
+}
+
+// NewRunArchiver returns a RunArchiver writing to dir, which is created on the first write.
+func NewRunArchiver(dir string) *RunArchiver {
+	return &RunArchiver{dir: dir}
+}
+
+// Dir returns the directory archive files are written to.
+func (a *RunArchiver) Dir() string { return a.dir }
+
+// Archive writes runs, the JSON objects selected by archiveRunsQuery, to a new archive file. The file only appears
+// in the directory once it is complete, so that partial writes are never imported.
+func (a *RunArchiver) Archive(runs [][]byte) (err error) {
+	if err = utils.EnsureDirAndMaxPerms(a.dir, archiveDirPerms); err != nil {
+		return errors.Wrap(err, "failed to create archive directory")
+	}
+	name := fmt.Sprintf("%s%d%s", archiveFilePrefix, time.Now().UnixNano(), archiveFileExt)
+	tmp := filepath.Join(a.dir, "."+name+".tmp")
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_EXCL, archiveFilePerms)
+	if err != nil {
+		return errors.Wrap(err, "failed to create archive file")
+	}
+	defer func() {
+		if err != nil {
+			err = multierr.Combine(err, f.Close(), os.Remove(tmp))
+		}
+	}()
+
+	zw := gzip.NewWriter(f)
+	for _, run := range runs {
+		if _, err = zw.Write(run); err != nil {
+			return errors.Wrap(err, "failed to write archive file")
+		}
+		if _, err = zw.Write([]byte{'\n'}); err != nil {
+			return errors.Wrap(err, "failed to write archive file")
+		}
+	}
+	if err = zw.Close(); err != nil {
+		return errors.Wrap(err, "failed to write archive file")
+	}
+	if err = f.Sync(); err != nil {
+		return errors.Wrap(err, "failed to sync archive file")
+	}
+	if err = f.Close(); err != nil {
+		return errors.Wrap(err, "failed to close archive file")
+	}
+	return errors.Wrap(os.Rename(tmp, filepath.Join(a.dir, name)), "failed to rename archive file")
+}
+
+// ImportRunsFilter selects the archived runs imported by ImportRuns.
+type ImportRunsFilter struct {
+	// From and To bound the time runs finished at, From inclusive and To exclusive. Zero times are unbounded.
+	From, To time.Time
+	// JobID selects the runs of a single job, if it is not zero.
+	JobID int32
+}
+
+func (f ImportRunsFilter) matches(run archivedRun) bool {
+	if f.JobID != 0 && run.PruningKey != f.JobID {
+		return false
+	}
+	if run.FinishedAt == nil {
+		return f.From.IsZero() && f.To.IsZero()
+	}
+	if !f.From.IsZero() && run.FinishedAt.Before(f.From) {
+		return false
+	}
+	return f.To.IsZero() || run.FinishedAt.Before(f.To)
+}
+
+// ImportRunsResult counts the archived runs matching the filter of ImportRuns.
+type ImportRunsResult struct {
+	Imported int
+	// Existing runs are still, or already, in the database.
+	Existing int
+	// Orphaned runs cannot be imported, since their pipeline spec was deleted along with their job.
+	Orphaned int
+}
+
+// archivedRun holds the fields of an archived run ImportRuns needs to decode.
+type archivedRun struct {
+	ID             int64      `json:"id"`
+	PipelineSpecID int32      `json:"pipeline_spec_id"`
+	PruningKey     int32      `json:"pruning_key"`
+	FinishedAt     *time.Time `json:"finished_at"`
+}
+
+// ImportRuns imports the runs archived by a RunArchiver in dir that match filter back into the database. Imported
+// runs are pruned and reaped again like any other run, so the retention of their job should be raised beforehand if
+// they are to be kept for longer.
+func ImportRuns(ctx context.Context, ds sqlutil.DataSource, dir string, filter ImportRunsFilter) (result ImportRunsResult, err error) {
+	paths, err := filepath.Glob(filepath.Join(dir, archiveFilePrefix+"*"+archiveFileExt))
+	if err != nil {
+		return result, errors.Wrap(err, "failed to list archive files")
+	}
+	sort.Strings(paths)
+	specs := map[int32]bool{}
+	for _, path := range paths {
+		err = sqlutil.TransactDataSource(ctx, ds, nil, func(tx sqlutil.DataSource) error {
+			return importRunsFile(ctx, tx, path, filter, specs, &result)
+		})
+		if err != nil {
+			return result, errors.Wrapf(err, "failed to import %s", filepath.Base(path))
+		}
+	}
+	return result, nil
+}
+
+func importRunsFile(ctx context.Context, ds sqlutil.DataSource, path string, filter ImportRunsFilter, specs map[int32]bool, result *ImportRunsResult) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+
+	scanner := bufio.NewScanner(zr)
+	scanner.Buffer(nil, maxArchivedRunSize)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		var run archivedRun
+		if err = json.Unmarshal([]byte(line), &run); err != nil {
+			return errors.Wrap(err, "failed to decode archived run")
+		}
+		if !filter.matches(run) {
+			continue
+		}
+
+		exists, ok := specs[run.PipelineSpecID]
+		if !ok {
+			if err = ds.GetContext(ctx, &exists, `SELECT EXISTS(SELECT 1 FROM pipeline_specs WHERE id = $1)`, run.PipelineSpecID); err != nil {
+				return errors.Wrap(err, "failed to check pipeline spec")
+			}
+			specs[run.PipelineSpecID] = exists
+		}
+		if !exists {
+			result.Orphaned++
+			continue
+		}
+
+		res, err := ds.ExecContext(ctx, `INSERT INTO pipeline_runs
+SELECT * FROM jsonb_populate_record(NULL::pipeline_runs, $1::jsonb) ON CONFLICT (id) DO NOTHING`, line)
+		if err != nil {
+			return errors.Wrapf(err, "failed to insert run %d", run.ID)
+		}
+		inserted, err := res.RowsAffected()
+		if err != nil {
+			return errors.Wrapf(err, "failed to insert run %d", run.ID)
+		}
+		if inserted == 0 {
+			result.Existing++
+			continue
+		}
+		if _, err = ds.ExecContext(ctx, `INSERT INTO pipeline_task_runs
+SELECT * FROM jsonb_populate_recordset(NULL::pipeline_task_runs, $1::jsonb->'task_runs') ON CONFLICT DO NOTHING`, line); err != nil {
+			return errors.Wrapf(err, "failed to insert task runs of run %d", run.ID)
+		}
+		result.Imported++
+	}
+	return errors.Wrap(scanner.Err(), "failed to read archive file")
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
 
 	"github.com/smartcontractkit/chainlink-evm/pkg/config"
+	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
 	cnull "github.com/smartcontractkit/chainlink/v2/core/null"
 )
 
@@ -77,6 +78,8 @@ type (
 	BridgeConfig interface {
 		BridgeResponseURL() *url.URL
 		BridgeCacheTTL() time.Duration
+		BridgeHealth() coreconfig.BridgeHealth
+		BridgeClientTLS() coreconfig.BridgeClientTLS
 	}
 )
 
@@ -109,6 +112,8 @@ const (
 type RunInfo struct {
 	IsRetryable bool
 	IsPending   bool
+	// nested are the results of the tasks executed by a fragment task
+	nested TaskRunResults
 }
 
 // retryableMeta should be returned if the error is non-deterministic; i.e. a
@@ -320,10 +325,13 @@ const (
 	TaskTypeETHCall          TaskType = "ethcall"
 	TaskTypeETHTx            TaskType = "ethtx"
 	TaskTypeEstimateGasLimit TaskType = "estimategaslimit"
+	TaskTypeForEach          TaskType = "foreach"
+	TaskTypeFragment         TaskType = "fragment"
 	TaskTypeHTTP             TaskType = "http"
 	TaskTypeHexDecode        TaskType = "hexdecode"
 	TaskTypeHexEncode        TaskType = "hexencode"
 	TaskTypeJSONParse        TaskType = "jsonparse"
+	TaskTypeJSONQuery        TaskType = "jsonquery"
 	TaskTypeLength           TaskType = "length"
 	TaskTypeLessThan         TaskType = "lessthan"
 	TaskTypeLookup           TaskType = "lookup"
@@ -384,6 +392,8 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &AnyTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeJSONParse:
 		task = &JSONParseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeJSONQuery:
+		task = &JSONQueryTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMemo:
 		task = &MemoTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMultiply:
@@ -414,6 +424,10 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &CBORParseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeFail:
 		task = &FailTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeForEach:
+		task = &ForEachTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeFragment:
+		task = &FragmentTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMerge:
 		task = &MergeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeLength:
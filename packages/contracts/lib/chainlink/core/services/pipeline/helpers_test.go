@@ -45,6 +45,14 @@ func (t *BridgeTask) HelperSetDependencies(
 	t.specId = specId
 }
 
+func (t *BridgeTask) HelperSetHealth(health *bridges.HealthMonitor) {
+	t.health = health
+}
+
+func (t *BridgeTask) HelperSetCSAKeyStore(csaKeyStore CSAKeyStore) {
+	t.csaKeyStore = csaKeyStore
+}
+
 func (t *HTTPTask) HelperSetDependencies(config Config, restrictedHTTPClient, unrestrictedHTTPClient *http.Client) {
 	t.config = config
 	t.httpClient = restrictedHTTPClient
@@ -0,0 +1,262 @@
+package pipeline
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// ErrRunRejected is returned for runs rejected by the RunLimits of their job.
+var ErrRunRejected = errors.New("run rejected by job limits")
+
+const (
+	rejectReasonConcurrency = "concurrency"
+	rejectReasonRate        = "rate"
+)
+
+// RunLimits bounds the resources used by the runs of a single job, so that a noisy job cannot starve the database
+// and HTTP clients shared with the other jobs. Unset fields are unlimited, or fall back to the node configuration.
+type RunLimits struct {
+	// MaxConcurrentRuns is the number of runs of the job executed at the same time.
+	MaxConcurrentRuns *uint32 `json:"maxConcurrentRuns,omitempty" toml:"maxConcurrentRuns"`
+	// MaxQueuedRuns is the number of runs waiting for one of MaxConcurrentRuns to finish. Runs are rejected once the
+	// queue is full, and immediately when it is unset.
+	MaxQueuedRuns *uint32 `json:"maxQueuedRuns,omitempty" toml:"maxQueuedRuns"`
+	// MaxRunsPerWindow is the number of runs started within any Window, further runs are rejected.
+	MaxRunsPerWindow *uint32          `json:"maxRunsPerWindow,omitempty" toml:"maxRunsPerWindow"`
+	Window           *models.Interval `json:"window,omitempty" toml:"window"`
+	// MaxRunDuration overrides JobPipeline.MaxRunDuration, bounding the whole run.
+	MaxRunDuration *models.Interval `json:"maxRunDuration,omitempty" toml:"maxRunDuration"`
+	// MaxTaskDuration bounds each task run of the job, including the tasks with a longer timeout attribute, on top of
+	// the maxTaskDuration of the job.
+	MaxTaskDuration *models.Interval `json:"maxTaskDuration,omitempty" toml:"maxTaskDuration"`
+	// MaxHTTPResponseSize overrides JobPipeline.HTTPRequest.MaxSize for the http and bridge tasks.
+	MaxHTTPResponseSize *utils.FileSize `json:"maxHTTPResponseSize,omitempty" toml:"maxHTTPResponseSize"`
+}
+
+// Validate returns an error if the limits of l are inconsistent.
+func (l RunLimits) Validate() error {
+	if l.MaxConcurrentRuns != nil && *l.MaxConcurrentRuns == 0 {
+		return errors.New("maxConcurrentRuns must be positive")
+	}
+	if l.MaxQueuedRuns != nil && l.MaxConcurrentRuns == nil {
+		return errors.New("maxQueuedRuns requires maxConcurrentRuns")
+	}
+	if (l.MaxRunsPerWindow == nil) != (l.Window == nil) {
+		return errors.New("maxRunsPerWindow and window must be set together")
+	}
+	if l.MaxRunsPerWindow != nil && *l.MaxRunsPerWindow == 0 {
+		return errors.New("maxRunsPerWindow must be positive")
+	}
+	if l.Window != nil && l.Window.Duration() <= 0 {
+		return errors.Errorf("window must be positive, got %s", l.Window.Duration())
+	}
+	if l.MaxRunDuration != nil && l.MaxRunDuration.Duration() <= 0 {
+		return errors.Errorf("maxRunDuration must be positive, got %s", l.MaxRunDuration.Duration())
+	}
+	if l.MaxTaskDuration != nil && l.MaxTaskDuration.Duration() <= 0 {
+		return errors.Errorf("maxTaskDuration must be positive, got %s", l.MaxTaskDuration.Duration())
+	}
+	if l.MaxHTTPResponseSize != nil && *l.MaxHTTPResponseSize == 0 {
+		return errors.New("maxHTTPResponseSize must be positive")
+	}
+	return nil
+}
+
+// admissionLimited returns true if l limits the admission of runs.
+func (l RunLimits) admissionLimited() bool {
+	return l.MaxConcurrentRuns != nil || l.MaxRunsPerWindow != nil
+}
+
+// Value returns l serialized for database storage.
+func (l RunLimits) Value() (driver.Value, error) {
+	return json.Marshal(l)
+}
+
+// Scan reads l from the database.
+func (l *RunLimits) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("RunLimits#Scan received a value of type %T", value)
+	}
+	return json.Unmarshal(b, l)
+}
+
+// limitedConfig overrides the Config of the tasks of a job with its RunLimits.
+type limitedConfig struct {
+	Config
+	maxHTTPResponseSize int64
+}
+
+func (c limitedConfig) DefaultHTTPLimit() int64 { return c.maxHTTPResponseSize }
+
+// runLimiter admits the runs of a job within its RunLimits.
+type runLimiter struct {
+	limits  RunLimits
+	jobID   string
+	jobName string
+	// slots holds a token for each running run, if the concurrency is limited
+	slots chan struct{}
+
+	mu sync.Mutex
+	// queued counts the runs waiting for a slot
+	queued uint32
+	// started holds the times runs were admitted at within the last window, oldest first
+	started []time.Time
+}
+
+func newRunLimiter(spec Spec) *runLimiter {
+	l := &runLimiter{
+		limits:  spec.Limits,
+		jobID:   strconv.Itoa(int(spec.JobID)),
+		jobName: spec.JobName,
+	}
+	if spec.Limits.MaxConcurrentRuns != nil {
+		l.slots = make(chan struct{}, *spec.Limits.MaxConcurrentRuns)
+	}
+	return l
+}
+
+// admit waits for a run to be allowed to start, calling onQueued if it has to wait for another run to finish. It
+// returns a function releasing the slot of the run once it finishes, or an error wrapping ErrRunRejected. A run only
+// counts towards MaxRunsPerWindow once it is admitted, not while it is queued nor when it is rejected.
+func (l *runLimiter) admit(ctx context.Context, now time.Time, onQueued func()) (release func(), err error) {
+	// runs are rejected right away if they would be once admitted, rather than queued
+	if err = l.checkRate(now, false); err != nil {
+		return nil, err
+	}
+	if l.slots == nil {
+		return l.start(now)
+	}
+
+	select {
+	case l.slots <- struct{}{}:
+		return l.start(now)
+	default:
+	}
+
+	l.mu.Lock()
+	if l.limits.MaxQueuedRuns == nil || l.queued >= *l.limits.MaxQueuedRuns {
+		l.mu.Unlock()
+		l.reject(rejectReasonConcurrency)
+		return nil, errors.Wrapf(ErrRunRejected, "%d runs are already running", cap(l.slots))
+	}
+	l.queued++
+	l.mu.Unlock()
+	PromPipelineRunsQueued.WithLabelValues(l.jobID, l.jobName).Inc()
+	onQueued()
+
+	defer func() {
+		l.mu.Lock()
+		l.queued--
+		l.mu.Unlock()
+		PromPipelineRunsQueued.WithLabelValues(l.jobID, l.jobName).Dec()
+	}()
+	select {
+	case l.slots <- struct{}{}:
+		return l.start(time.Now())
+	case <-ctx.Done():
+		return nil, errors.Wrap(ctx.Err(), "cancelled while queued")
+	}
+}
+
+// start admits a run at now, once it holds a slot if the concurrency is limited, unless MaxRunsPerWindow runs were
+// admitted within the window, in which case the slot is given back.
+func (l *runLimiter) start(now time.Time) (func(), error) {
+	if err := l.checkRate(now, true); err != nil {
+		if l.slots != nil {
+			<-l.slots
+		}
+		return nil, err
+	}
+	return l.running(), nil
+}
+
+// checkRate rejects a run at now if MaxRunsPerWindow runs were admitted within the window, or records it as admitted
+// if record is true.
+func (l *runLimiter) checkRate(now time.Time, record bool) error {
+	if l.limits.MaxRunsPerWindow == nil {
+		return nil
+	}
+	window := l.limits.Window.Duration()
+	l.mu.Lock()
+	i := 0
+	for i < len(l.started) && !l.started[i].After(now.Add(-window)) {
+		i++
+	}
+	l.started = l.started[i:]
+	if len(l.started) >= int(*l.limits.MaxRunsPerWindow) {
+		l.mu.Unlock()
+		l.reject(rejectReasonRate)
+		return errors.Wrapf(ErrRunRejected, "%d runs already started within %s", *l.limits.MaxRunsPerWindow, window)
+	}
+	if record {
+		l.started = append(l.started, now)
+	}
+	l.mu.Unlock()
+	return nil
+}
+
+// running counts a running run, returning the function releasing it.
+func (l *runLimiter) running() func() {
+	running := PromPipelineRunsRunning.WithLabelValues(l.jobID, l.jobName)
+	running.Inc()
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			running.Dec()
+			if l.slots != nil {
+				<-l.slots
+			}
+		})
+	}
+}
+
+func (l *runLimiter) reject(reason string) {
+	PromPipelineRunsRejected.WithLabelValues(l.jobID, l.jobName, reason).Inc()
+}
+
+// runLimiters holds the runLimiter of each job with limited admission.
+type runLimiters struct {
+	mu       sync.Mutex
+	limiters map[int32]*runLimiter
+}
+
+// get returns the runLimiter of the job of spec, or nil if its runs are not limited. The limiter is replaced when the
+// limits of the job change, the runs admitted by the previous one still release their slot to it.
+func (ls *runLimiters) get(spec Spec) *runLimiter {
+	if spec.JobID == 0 || !spec.Limits.admissionLimited() {
+		return nil
+	}
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	l, ok := ls.limiters[spec.JobID]
+	if !ok || !reflect.DeepEqual(l.limits, spec.Limits) {
+		if ls.limiters == nil {
+			ls.limiters = map[int32]*runLimiter{}
+		}
+		l = newRunLimiter(spec)
+		ls.limiters[spec.JobID] = l
+	}
+	return l
+}
+
+// forget drops the runLimiter of jobID, once the services of the job are stopped. The runs it admitted still release
+// their slot to it.
+func (ls *runLimiters) forget(jobID int32) {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	delete(ls.limiters, jobID)
+}
@@ -0,0 +1,81 @@
+package pipeline_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pelletier/go-toml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestRunLimits_TOML(t *testing.T) {
+	t.Parallel()
+
+	var spec struct {
+		Limits pipeline.RunLimits `toml:"limits"`
+	}
+	require.NoError(t, toml.Unmarshal([]byte(`
+[limits]
+maxConcurrentRuns = 4
+maxQueuedRuns = 16
+maxRunsPerWindow = 100
+window = "1m"
+maxRunDuration = "30s"
+maxTaskDuration = "10s"
+maxHTTPResponseSize = "1mb"
+`), &spec))
+	require.NoError(t, spec.Limits.Validate())
+	assert.Equal(t, ptr(uint32(4)), spec.Limits.MaxConcurrentRuns)
+	assert.Equal(t, ptr(uint32(16)), spec.Limits.MaxQueuedRuns)
+	assert.Equal(t, ptr(uint32(100)), spec.Limits.MaxRunsPerWindow)
+	assert.Equal(t, models.NewInterval(time.Minute), spec.Limits.Window)
+	assert.Equal(t, models.NewInterval(30*time.Second), spec.Limits.MaxRunDuration)
+	assert.Equal(t, models.NewInterval(10*time.Second), spec.Limits.MaxTaskDuration)
+	require.NotNil(t, spec.Limits.MaxHTTPResponseSize)
+	assert.Equal(t, utils.FileSize(utils.MB), *spec.Limits.MaxHTTPResponseSize)
+
+	b, err := spec.Limits.Value()
+	require.NoError(t, err)
+	var scanned pipeline.RunLimits
+	require.NoError(t, scanned.Scan(b))
+	assert.Equal(t, spec.Limits, scanned)
+
+	var empty pipeline.RunLimits
+	require.NoError(t, empty.Scan([]byte(`{}`)))
+	assert.Equal(t, pipeline.RunLimits{}, empty)
+}
+
+func TestRunLimits_Validate(t *testing.T) {
+	t.Parallel()
+
+	zero := utils.FileSize(0)
+	for _, tt := range []struct {
+		name   string
+		limits pipeline.RunLimits
+		err    string
+	}{
+		{"empty", pipeline.RunLimits{}, ""},
+		{"zero concurrency", pipeline.RunLimits{MaxConcurrentRuns: ptr(uint32(0))}, "maxConcurrentRuns must be positive"},
+		{"queue without concurrency", pipeline.RunLimits{MaxQueuedRuns: ptr(uint32(1))}, "maxQueuedRuns requires maxConcurrentRuns"},
+		{"rate without window", pipeline.RunLimits{MaxRunsPerWindow: ptr(uint32(1))}, "maxRunsPerWindow and window must be set together"},
+		{"zero rate", pipeline.RunLimits{MaxRunsPerWindow: ptr(uint32(0)), Window: models.NewInterval(time.Minute)}, "maxRunsPerWindow must be positive"},
+		{"zero window", pipeline.RunLimits{MaxRunsPerWindow: ptr(uint32(1)), Window: models.NewInterval(0)}, "window must be positive, got 0s"},
+		{"zero run duration", pipeline.RunLimits{MaxRunDuration: models.NewInterval(0)}, "maxRunDuration must be positive, got 0s"},
+		{"zero task duration", pipeline.RunLimits{MaxTaskDuration: models.NewInterval(0)}, "maxTaskDuration must be positive, got 0s"},
+		{"zero response size", pipeline.RunLimits{MaxHTTPResponseSize: &zero}, "maxHTTPResponseSize must be positive"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.limits.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
@@ -75,6 +75,64 @@ func (_c *ORM_Close_Call) RunAndReturn(run func() error) *ORM_Close_Call {
 	return _c
 }
 
+// CreateFragment provides a mock function with given fields: ctx, name, source
+func (_m *ORM) CreateFragment(ctx context.Context, name string, source string) (pipeline.Fragment, error) {
+	ret := _m.Called(ctx, name, source)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFragment")
+	}
+
+	var r0 pipeline.Fragment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (pipeline.Fragment, error)); ok {
+		return rf(ctx, name, source)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) pipeline.Fragment); ok {
+		r0 = rf(ctx, name, source)
+	} else {
+		r0 = ret.Get(0).(pipeline.Fragment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, name, source)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_CreateFragment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFragment'
+type ORM_CreateFragment_Call struct {
+	*mock.Call
+}
+
+// CreateFragment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - source string
+func (_e *ORM_Expecter) CreateFragment(ctx interface{}, name interface{}, source interface{}) *ORM_CreateFragment_Call {
+	return &ORM_CreateFragment_Call{Call: _e.mock.On("CreateFragment", ctx, name, source)}
+}
+
+func (_c *ORM_CreateFragment_Call) Run(run func(ctx context.Context, name string, source string)) *ORM_CreateFragment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ORM_CreateFragment_Call) Return(_a0 pipeline.Fragment, _a1 error) *ORM_CreateFragment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_CreateFragment_Call) RunAndReturn(run func(context.Context, string, string) (pipeline.Fragment, error)) *ORM_CreateFragment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateRun provides a mock function with given fields: ctx, run
 func (_m *ORM) CreateRun(ctx context.Context, run *pipeline.Run) error {
 	ret := _m.Called(ctx, run)
@@ -321,6 +379,122 @@ func (_c *ORM_DeleteRunsOlderThan_Call) RunAndReturn(run func(context.Context, t
 	return _c
 }
 
+// FindFragment provides a mock function with given fields: ctx, name, version
+func (_m *ORM) FindFragment(ctx context.Context, name string, version int32) (pipeline.Fragment, error) {
+	ret := _m.Called(ctx, name, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFragment")
+	}
+
+	var r0 pipeline.Fragment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) (pipeline.Fragment, error)); ok {
+		return rf(ctx, name, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) pipeline.Fragment); ok {
+		r0 = rf(ctx, name, version)
+	} else {
+		r0 = ret.Get(0).(pipeline.Fragment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int32) error); ok {
+		r1 = rf(ctx, name, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindFragment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindFragment'
+type ORM_FindFragment_Call struct {
+	*mock.Call
+}
+
+// FindFragment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - version int32
+func (_e *ORM_Expecter) FindFragment(ctx interface{}, name interface{}, version interface{}) *ORM_FindFragment_Call {
+	return &ORM_FindFragment_Call{Call: _e.mock.On("FindFragment", ctx, name, version)}
+}
+
+func (_c *ORM_FindFragment_Call) Run(run func(ctx context.Context, name string, version int32)) *ORM_FindFragment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *ORM_FindFragment_Call) Return(_a0 pipeline.Fragment, _a1 error) *ORM_FindFragment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_FindFragment_Call) RunAndReturn(run func(context.Context, string, int32) (pipeline.Fragment, error)) *ORM_FindFragment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindFragments provides a mock function with given fields: ctx
+func (_m *ORM) FindFragments(ctx context.Context) ([]pipeline.Fragment, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFragments")
+	}
+
+	var r0 []pipeline.Fragment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]pipeline.Fragment, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []pipeline.Fragment); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.Fragment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindFragments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindFragments'
+type ORM_FindFragments_Call struct {
+	*mock.Call
+}
+
+// FindFragments is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ORM_Expecter) FindFragments(ctx interface{}) *ORM_FindFragments_Call {
+	return &ORM_FindFragments_Call{Call: _e.mock.On("FindFragments", ctx)}
+}
+
+func (_c *ORM_FindFragments_Call) Run(run func(ctx context.Context)) *ORM_FindFragments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ORM_FindFragments_Call) Return(_a0 []pipeline.Fragment, _a1 error) *ORM_FindFragments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_FindFragments_Call) RunAndReturn(run func(context.Context) ([]pipeline.Fragment, error)) *ORM_FindFragments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindRun provides a mock function with given fields: ctx, id
 func (_m *ORM) FindRun(ctx context.Context, id int64) (pipeline.Run, error) {
 	ret := _m.Called(ctx, id)
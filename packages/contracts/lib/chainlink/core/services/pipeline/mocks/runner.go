@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	bridges "github.com/smartcontractkit/chainlink/v2/core/bridges"
+
 	context "context"
 
 	pipeline "github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
@@ -26,6 +28,53 @@ func (_m *Runner) EXPECT() *Runner_Expecter {
 	return &Runner_Expecter{mock: &_m.Mock}
 }
 
+// BridgeHealth provides a mock function with no fields
+func (_m *Runner) BridgeHealth() *bridges.HealthMonitor {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BridgeHealth")
+	}
+
+	var r0 *bridges.HealthMonitor
+	if rf, ok := ret.Get(0).(func() *bridges.HealthMonitor); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*bridges.HealthMonitor)
+		}
+	}
+
+	return r0
+}
+
+// Runner_BridgeHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BridgeHealth'
+type Runner_BridgeHealth_Call struct {
+	*mock.Call
+}
+
+// BridgeHealth is a helper method to define mock.On call
+func (_e *Runner_Expecter) BridgeHealth() *Runner_BridgeHealth_Call {
+	return &Runner_BridgeHealth_Call{Call: _e.mock.On("BridgeHealth")}
+}
+
+func (_c *Runner_BridgeHealth_Call) Run(run func()) *Runner_BridgeHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Runner_BridgeHealth_Call) Return(_a0 *bridges.HealthMonitor) *Runner_BridgeHealth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Runner_BridgeHealth_Call) RunAndReturn(run func() *bridges.HealthMonitor) *Runner_BridgeHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with no fields
 func (_m *Runner) Close() error {
 	ret := _m.Called()
@@ -208,6 +257,39 @@ func (_c *Runner_ExecuteRun_Call) RunAndReturn(run func(context.Context, pipelin
 	return _c
 }
 
+// ForgetJob provides a mock function with given fields: jobID
+func (_m *Runner) ForgetJob(jobID int32) {
+	_m.Called(jobID)
+}
+
+// Runner_ForgetJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForgetJob'
+type Runner_ForgetJob_Call struct {
+	*mock.Call
+}
+
+// ForgetJob is a helper method to define mock.On call
+//   - jobID int32
+func (_e *Runner_Expecter) ForgetJob(jobID interface{}) *Runner_ForgetJob_Call {
+	return &Runner_ForgetJob_Call{Call: _e.mock.On("ForgetJob", jobID)}
+}
+
+func (_c *Runner_ForgetJob_Call) Run(run func(jobID int32)) *Runner_ForgetJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int32))
+	})
+	return _c
+}
+
+func (_c *Runner_ForgetJob_Call) Return() *Runner_ForgetJob_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Runner_ForgetJob_Call) RunAndReturn(run func(int32)) *Runner_ForgetJob_Call {
+	_c.Run(run)
+	return _c
+}
+
 // HealthReport provides a mock function with no fields
 func (_m *Runner) HealthReport() map[string]error {
 	ret := _m.Called()
@@ -489,6 +571,40 @@ func (_c *Runner_OnRunFinished_Call) RunAndReturn(run func(func(*pipeline.Run)))
 	return _c
 }
 
+// PublishFinishedRuns provides a mock function with given fields: ctx, runs
+func (_m *Runner) PublishFinishedRuns(ctx context.Context, runs []*pipeline.Run) {
+	_m.Called(ctx, runs)
+}
+
+// Runner_PublishFinishedRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishFinishedRuns'
+type Runner_PublishFinishedRuns_Call struct {
+	*mock.Call
+}
+
+// PublishFinishedRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runs []*pipeline.Run
+func (_e *Runner_Expecter) PublishFinishedRuns(ctx interface{}, runs interface{}) *Runner_PublishFinishedRuns_Call {
+	return &Runner_PublishFinishedRuns_Call{Call: _e.mock.On("PublishFinishedRuns", ctx, runs)}
+}
+
+func (_c *Runner_PublishFinishedRuns_Call) Run(run func(ctx context.Context, runs []*pipeline.Run)) *Runner_PublishFinishedRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*pipeline.Run))
+	})
+	return _c
+}
+
+func (_c *Runner_PublishFinishedRuns_Call) Return() *Runner_PublishFinishedRuns_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Runner_PublishFinishedRuns_Call) RunAndReturn(run func(context.Context, []*pipeline.Run)) *Runner_PublishFinishedRuns_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Ready provides a mock function with no fields
 func (_m *Runner) Ready() error {
 	ret := _m.Called()
@@ -642,6 +758,74 @@ func (_c *Runner_Run_Call) RunAndReturn(run func(context.Context, *pipeline.Run,
 	return _c
 }
 
+// SimulateRun provides a mock function with given fields: ctx, spec, vars, stubs
+func (_m *Runner) SimulateRun(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, stubs map[string]pipeline.TaskStub) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	ret := _m.Called(ctx, spec, vars, stubs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateRun")
+	}
+
+	var r0 *pipeline.Run
+	var r1 pipeline.TaskRunResults
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Vars, map[string]pipeline.TaskStub) (*pipeline.Run, pipeline.TaskRunResults, error)); ok {
+		return rf(ctx, spec, vars, stubs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Vars, map[string]pipeline.TaskStub) *pipeline.Run); ok {
+		r0 = rf(ctx, spec, vars, stubs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.Spec, pipeline.Vars, map[string]pipeline.TaskStub) pipeline.TaskRunResults); ok {
+		r1 = rf(ctx, spec, vars, stubs)
+	} else {
+		r1 = ret.Get(1).(pipeline.TaskRunResults)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, pipeline.Spec, pipeline.Vars, map[string]pipeline.TaskStub) error); ok {
+		r2 = rf(ctx, spec, vars, stubs)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Runner_SimulateRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateRun'
+type Runner_SimulateRun_Call struct {
+	*mock.Call
+}
+
+// SimulateRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec pipeline.Spec
+//   - vars pipeline.Vars
+//   - stubs map[string]pipeline.TaskStub
+func (_e *Runner_Expecter) SimulateRun(ctx interface{}, spec interface{}, vars interface{}, stubs interface{}) *Runner_SimulateRun_Call {
+	return &Runner_SimulateRun_Call{Call: _e.mock.On("SimulateRun", ctx, spec, vars, stubs)}
+}
+
+func (_c *Runner_SimulateRun_Call) Run(run func(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, stubs map[string]pipeline.TaskStub)) *Runner_SimulateRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pipeline.Spec), args[2].(pipeline.Vars), args[3].(map[string]pipeline.TaskStub))
+	})
+	return _c
+}
+
+func (_c *Runner_SimulateRun_Call) Return(_a0 *pipeline.Run, _a1 pipeline.TaskRunResults, _a2 error) *Runner_SimulateRun_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Runner_SimulateRun_Call) RunAndReturn(run func(context.Context, pipeline.Spec, pipeline.Vars, map[string]pipeline.TaskStub) (*pipeline.Run, pipeline.TaskRunResults, error)) *Runner_SimulateRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Runner) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -688,6 +872,66 @@ func (_c *Runner_Start_Call) RunAndReturn(run func(context.Context) error) *Runn
 	return _c
 }
 
+// SubscribeRunEvents provides a mock function with given fields: jobID
+func (_m *Runner) SubscribeRunEvents(jobID int32) (<-chan pipeline.RunEvent, func()) {
+	ret := _m.Called(jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeRunEvents")
+	}
+
+	var r0 <-chan pipeline.RunEvent
+	var r1 func()
+	if rf, ok := ret.Get(0).(func(int32) (<-chan pipeline.RunEvent, func())); ok {
+		return rf(jobID)
+	}
+	if rf, ok := ret.Get(0).(func(int32) <-chan pipeline.RunEvent); ok {
+		r0 = rf(jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan pipeline.RunEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int32) func()); ok {
+		r1 = rf(jobID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
+
+// Runner_SubscribeRunEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeRunEvents'
+type Runner_SubscribeRunEvents_Call struct {
+	*mock.Call
+}
+
+// SubscribeRunEvents is a helper method to define mock.On call
+//   - jobID int32
+func (_e *Runner_Expecter) SubscribeRunEvents(jobID interface{}) *Runner_SubscribeRunEvents_Call {
+	return &Runner_SubscribeRunEvents_Call{Call: _e.mock.On("SubscribeRunEvents", jobID)}
+}
+
+func (_c *Runner_SubscribeRunEvents_Call) Run(run func(jobID int32)) *Runner_SubscribeRunEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int32))
+	})
+	return _c
+}
+
+func (_c *Runner_SubscribeRunEvents_Call) Return(events <-chan pipeline.RunEvent, unsubscribe func()) *Runner_SubscribeRunEvents_Call {
+	_c.Call.Return(events, unsubscribe)
+	return _c
+}
+
+func (_c *Runner_SubscribeRunEvents_Call) RunAndReturn(run func(int32) (<-chan pipeline.RunEvent, func())) *Runner_SubscribeRunEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewRunner creates a new instance of Runner. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRunner(t interface {
@@ -27,9 +27,10 @@ type Spec struct {
 	GasLimit          *uint32         `json:"-"`
 	ForwardingAllowed bool            `json:"-"`
 
-	JobID   int32  `json:"-"`
-	JobName string `json:"-"`
-	JobType string `json:"-"`
+	JobID   int32     `json:"-"`
+	JobName string    `json:"-"`
+	JobType string    `json:"-"`
+	Limits  RunLimits `json:"-"`
 
 	Pipeline *Pipeline `json:"-" db:"-"` // This may be nil, or may be populated manually as a cache. There is no locking on this, so be careful
 }
@@ -68,6 +69,9 @@ type Run struct {
 	Pending bool
 	// FailSilently is used to signal that a task with the failEarly flag has failed, and we want to not put this in the db
 	FailSilently bool
+
+	// executionID identifies the execution of the run in RunEvents
+	executionID uuid.UUID
 }
 
 func (r Run) GetID() string {
@@ -325,6 +329,12 @@ const (
 	RunStatusErrored RunStatus = "errored"
 	// RunStatusCompleted is used for when a run has successfully completed execution.
 	RunStatusCompleted RunStatus = "completed"
+	// RunStatusQueued is used for when a run waits for the concurrency limit of its job. It is only published to the
+	// subscribers of run events, and never saved.
+	RunStatusQueued RunStatus = "queued"
+	// RunStatusRejected is used for when a run is rejected by the limits of its job. It is saved as finished, with the
+	// reason of the rejection as its fatal error, but it has no outputs or task runs.
+	RunStatusRejected RunStatus = "rejected"
 )
 
 // Completed returns true if the status is RunStatusCompleted.
@@ -341,3 +351,12 @@ func (s RunStatus) Errored() bool {
 func (s RunStatus) Finished() bool {
 	return s.Completed() || s.Errored()
 }
+
+// Fragment is an immutable version of a reusable pipeline, invoked from other pipelines by the fragment task.
+type Fragment struct {
+	ID           int64     `json:"-"`
+	Name         string    `json:"name"`
+	Version      int32     `json:"version"`
+	DotDagSource string    `json:"dotDagSource"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
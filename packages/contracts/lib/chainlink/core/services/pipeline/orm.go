@@ -4,11 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
@@ -90,6 +92,12 @@ type ORM interface {
 	// If saveSuccessfulTaskRuns is false, only errored runs are saved.
 	InsertFinishedRuns(ctx context.Context, run []*Run, saveSuccessfulTaskRuns bool) (err error)
 
+	// CreateFragment validates source and stores it as the next version of the named fragment.
+	CreateFragment(ctx context.Context, name string, source string) (Fragment, error)
+	FindFragment(ctx context.Context, name string, version int32) (Fragment, error)
+	// FindFragments returns all versions of all fragments, ordered by name and version.
+	FindFragments(ctx context.Context) ([]Fragment, error)
+
 	DeleteRunsOlderThan(context.Context, time.Duration) error
 	FindRun(ctx context.Context, id int64) (Run, error)
 	GetAllRuns(ctx context.Context) ([]Run, error)
@@ -105,6 +113,7 @@ type orm struct {
 	ds                sqlutil.DataSource
 	lggr              logger.Logger
 	maxSuccessfulRuns uint64
+	archiver          *RunArchiver
 	// jobID => count
 	pm     sync.Map
 	wg     sync.WaitGroup
@@ -122,6 +131,14 @@ func NewORM(ds sqlutil.DataSource, lggr logger.Logger, jobPipelineMaxSuccessfulR
 	}
 }
 
+// SetArchiver enables archival, exporting runs with archiver before they are pruned or reaped. It must be called
+// before the ORM is used. As runs are inserted within transactions which could still be rolled back once an archive
+// is written, the runs of each job above its maximum number of successful runs are then pruned by the reaper, see
+// DeleteRunsOlderThan, instead of as they are inserted.
+func (o *orm) SetArchiver(archiver *RunArchiver) {
+	o.archiver = archiver
+}
+
 func (o *orm) Start(_ context.Context) error {
 	return o.StartOnce("PipelineORM", func() error {
 		var msg string
@@ -131,6 +148,9 @@ func (o *orm) Start(_ context.Context) error {
 			msg = fmt.Sprintf("Pipeline runs will be pruned above per-job limit of MaxSuccessfulRuns=%d", o.maxSuccessfulRuns)
 		}
 		o.lggr.Info(msg)
+		if o.archiver != nil {
+			o.lggr.Infow("Pipeline runs will be archived before they are pruned", "dir", o.archiver.Dir())
+		}
 		return nil
 	})
 }
@@ -176,6 +196,7 @@ func (o *orm) withDataSource(ds sqlutil.DataSource) *orm {
 		ds:                ds,
 		lggr:              o.lggr,
 		maxSuccessfulRuns: o.maxSuccessfulRuns,
+		archiver:          o.archiver,
 		stopCh:            make(chan struct{}),
 	}
 }
@@ -192,6 +213,49 @@ func (o *orm) CreateSpec(ctx context.Context, pipeline Pipeline, maxTaskDuration
 	return id, errors.WithStack(err)
 }
 
+func (o *orm) CreateFragment(ctx context.Context, name string, source string) (fragment Fragment, err error) {
+	if err = ValidateFragmentName(name); err != nil {
+		return fragment, err
+	}
+	p, err := Parse(source)
+	if err != nil {
+		return fragment, errors.Wrap(err, "invalid fragment pipeline")
+	}
+	err = o.transact(ctx, func(tx *orm) error {
+		// fragments may only reference existing fragment versions, which rules out cycles
+		for _, task := range p.Tasks {
+			ft, ok := task.(interface {
+				staticRef() (string, int32, bool)
+			})
+			if !ok {
+				continue
+			}
+			ref, version, ok := ft.staticRef()
+			if !ok {
+				continue
+			}
+			if _, ferr := tx.FindFragment(ctx, ref, version); ferr != nil {
+				return errors.Wrapf(ferr, "task %s references fragment %s version %d", task.DotID(), ref, version)
+			}
+		}
+		sql := `INSERT INTO pipeline_fragments (name, version, dot_dag_source, created_at)
+		SELECT $1, COALESCE(MAX(version), 0) + 1, $2, NOW() FROM pipeline_fragments WHERE name = $1
+		RETURNING *;`
+		return errors.Wrap(tx.ds.GetContext(ctx, &fragment, sql, name, source), "failed to insert fragment")
+	})
+	return fragment, err
+}
+
+func (o *orm) FindFragment(ctx context.Context, name string, version int32) (fragment Fragment, err error) {
+	err = o.ds.GetContext(ctx, &fragment, `SELECT * FROM pipeline_fragments WHERE name = $1 AND version = $2`, name, version)
+	return fragment, err
+}
+
+func (o *orm) FindFragments(ctx context.Context) (fragments []Fragment, err error) {
+	err = o.ds.SelectContext(ctx, &fragments, `SELECT * FROM pipeline_fragments ORDER BY name ASC, version ASC`)
+	return fragments, errors.Wrap(err, "failed to load fragments")
+}
+
 func (o *orm) CreateRun(ctx context.Context, run *Run) (err error) {
 	if run.CreatedAt.IsZero() {
 		return errors.New("run.CreatedAt must be set")
@@ -423,6 +487,13 @@ func (o *orm) checkFinishedRun(run *Run, saveSuccessfulTaskRuns bool) error {
 	if run.FinishedAt.IsZero() {
 		return errors.New("run.FinishedAt must be set")
 	}
+	if run.State == RunStatusRejected {
+		// rejected runs were never executed, so they have neither outputs nor task runs
+		if len(run.FatalErrors) == 0 {
+			return errors.New("rejected run must have FatalErrors")
+		}
+		return nil
+	}
 	if run.Outputs.Val == nil || len(run.FatalErrors)+len(run.AllErrors) == 0 {
 		return errors.Errorf("run must have both Outputs and Errors, got Outputs: %#v, FatalErrors: %#v, AllErrors: %#v", run.Outputs.Val, run.FatalErrors, run.AllErrors)
 	}
@@ -441,7 +512,7 @@ func (o *orm) InsertFinishedRun(ctx context.Context, run *Run, saveSuccessfulTas
 		return err
 	}
 
-	if o.maxSuccessfulRuns == 0 {
+	if o.retention(ctx, o.ds, run.PruningKey).maxSuccessfulRuns(o.maxSuccessfulRuns) == 0 {
 		// optimisation: avoid persisting if we oughtn't to save any
 		return nil
 	}
@@ -456,7 +527,7 @@ func (o *orm) InsertFinishedRunWithSpec(ctx context.Context, run *Run, saveSucce
 		return err
 	}
 
-	if o.maxSuccessfulRuns == 0 {
+	if o.retention(ctx, o.ds, run.PruningKey).maxSuccessfulRuns(o.maxSuccessfulRuns) == 0 {
 		// optimisation: avoid persisting if we oughtn't to save any
 		return nil
 	}
@@ -504,6 +575,9 @@ func (o *orm) insertFinishedRun(ctx context.Context, run *Run, saveSuccessfulTas
 	}
 
 	defer o.prune(ctx, o.ds, run.PruningKey)
+	if len(run.PipelineTaskRuns) == 0 {
+		return nil
+	}
 	sql = `
 		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
 		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at);`
@@ -511,43 +585,49 @@ func (o *orm) insertFinishedRun(ctx context.Context, run *Run, saveSuccessfulTas
 	return errors.Wrap(err, "failed to insert pipeline_task_runs")
 }
 
-// DeleteRunsOlderThan deletes all pipeline_runs that have been finished for a certain threshold to free DB space
+// DeleteRunsOlderThan deletes all pipeline_runs that have been finished for a certain threshold to free DB space,
+// except for the runs of jobs overriding their retention age, which are deleted according to their RunRetention.
+// With archival enabled, it also prunes the completed and rejected runs of each job above its maximum number of
+// successful runs.
 // Caller is expected to set timeout on calling context.
 func (o *orm) DeleteRunsOlderThan(ctx context.Context, threshold time.Duration) error {
 	start := time.Now()
 
 	queryThreshold := start.Add(-threshold)
 
-	rowsDeleted := int64(0)
+	var jobs []struct {
+		ID        int32
+		Retention RunRetention
+	}
+	err := o.ds.SelectContext(ctx, &jobs, `SELECT id, retention FROM jobs
+WHERE retention->'maxAge' IS NOT NULL OR retention->'keepErroredFor' IS NOT NULL`)
+	if err != nil {
+		return errors.Wrap(err, "DeleteRunsOlderThan failed to load job retentions")
+	}
+	jobIDs := make([]int32, len(jobs))
+	for i, jb := range jobs {
+		jobIDs[i] = jb.ID
+	}
 
-	err := pg.Batch(func(_, limit uint) (count uint, err error) {
-		result, err := o.ds.ExecContext(ctx, `
-WITH batched_pipeline_runs AS (
-	SELECT * FROM pipeline_runs
-	WHERE finished_at < ($1)
-	ORDER BY finished_at ASC
-	LIMIT $2
-)
-DELETE FROM pipeline_runs
-USING batched_pipeline_runs
-WHERE pipeline_runs.id = batched_pipeline_runs.id`,
-			queryThreshold,
-			limit,
-		)
+	rowsDeleted, err := o.reapRuns(ctx, `finished_at < $1 AND pruning_key <> ALL($2)`, queryThreshold, pq.Array(jobIDs))
+	if err != nil {
+		return errors.Wrap(err, "DeleteRunsOlderThan failed")
+	}
+	for _, jb := range jobs {
+		finished, errored := jb.Retention.thresholds(start, threshold)
+		n, err := o.reapRuns(ctx, `pruning_key = $1 AND ((state <> $2 AND finished_at < $3) OR (state = $2 AND finished_at < $4))`,
+			jb.ID, RunStatusErrored, finished, errored)
 		if err != nil {
-			return count, errors.Wrap(err, "DeleteRunsOlderThan failed to delete old pipeline_runs")
+			return errors.Wrapf(err, "DeleteRunsOlderThan failed for job %d", jb.ID)
 		}
-
-		rowsAffected, err := result.RowsAffected()
+		rowsDeleted += n
+	}
+	if o.archiver != nil {
+		n, err := o.pruneAll(ctx)
 		if err != nil {
-			return count, errors.Wrap(err, "DeleteRunsOlderThan failed to get rows affected")
+			return errors.Wrap(err, "DeleteRunsOlderThan failed")
 		}
-		rowsDeleted += rowsAffected
-
-		return uint(rowsAffected), err
-	})
-	if err != nil {
-		return errors.Wrap(err, "DeleteRunsOlderThan failed")
+		rowsDeleted += n
 	}
 
 	deleteTS := time.Now()
@@ -566,6 +646,63 @@ WHERE pipeline_runs.id = batched_pipeline_runs.id`,
 	return nil
 }
 
+// reapRuns deletes the finished runs matching condition in batches, oldest first.
+func (o *orm) reapRuns(ctx context.Context, condition string, args ...any) (rowsDeleted int64, err error) {
+	err = pg.Batch(func(_, limit uint) (count uint, err error) {
+		batch := fmt.Sprintf(`id IN (
+	SELECT id FROM pipeline_runs
+	WHERE %s
+	ORDER BY finished_at ASC
+	LIMIT $%d
+)`, condition, len(args)+1)
+		rowsAffected, err := o.deleteRuns(ctx, batch, append(slices.Clip(args), limit)...)
+		if err != nil {
+			return count, errors.Wrap(err, "failed to delete old pipeline_runs")
+		}
+		rowsDeleted += rowsAffected
+
+		return uint(rowsAffected), nil
+	})
+	return
+}
+
+// deleteRuns deletes the runs matching condition and returns the number of runs deleted. With archival enabled, the
+// runs are archived first, and only the runs archived are deleted.
+func (o *orm) deleteRuns(ctx context.Context, condition string, args ...any) (int64, error) {
+	if o.archiver == nil {
+		res, err := o.ds.ExecContext(ctx, `DELETE FROM pipeline_runs WHERE `+condition, args...)
+		if err != nil {
+			return 0, err
+		}
+		return res.RowsAffected()
+	}
+
+	var runs []struct {
+		ID  int64
+		Run []byte
+	}
+	if err := o.ds.SelectContext(ctx, &runs, fmt.Sprintf(archiveRunsQuery, condition), args...); err != nil {
+		return 0, errors.Wrap(err, "failed to select runs to archive")
+	}
+	if len(runs) == 0 {
+		return 0, nil
+	}
+	ids := make([]int64, len(runs))
+	records := make([][]byte, len(runs))
+	for i, r := range runs {
+		ids[i] = r.ID
+		records[i] = r.Run
+	}
+	if err := o.archiver.Archive(records); err != nil {
+		return 0, errors.Wrap(err, "failed to archive runs")
+	}
+	res, err := o.ds.ExecContext(ctx, `DELETE FROM pipeline_runs WHERE id = ANY($1)`, pq.Array(ids))
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
 func (o *orm) FindRun(ctx context.Context, id int64) (r Run, err error) {
 	var runs []*Run
 	err = o.transact(ctx, func(tx *orm) error {
@@ -679,6 +816,22 @@ func loadAssociations(ctx context.Context, ds sqlutil.DataSource, runs []*Run) e
 	return nil
 }
 
+// retention returns the RunRetention of the job. It is not cached, as jobs are updated by re-creating them with the
+// same ID.
+func (o *orm) retention(ctx context.Context, ds sqlutil.DataSource, jobID int32) RunRetention {
+	if jobID == 0 {
+		return RunRetention{}
+	}
+	var r RunRetention
+	if err := ds.GetContext(ctx, &r, `SELECT retention FROM jobs WHERE id = $1`, jobID); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			o.lggr.Warnw("Failed to load run retention of job, using defaults", "err", err, "jobID", jobID)
+		}
+		return RunRetention{}
+	}
+	return r
+}
+
 func (o *orm) loadCount(jobID int32) *atomic.Uint64 {
 	// fast path; avoids allocation
 	actual, exists := o.pm.Load(jobID)
@@ -695,7 +848,9 @@ func (o *orm) loadCount(jobID int32) *atomic.Uint64 {
 const syncLimit = 1000
 
 // prune attempts to keep the pipeline_runs table capped close to the
-// maxSuccessfulRuns length for each job_id.
+// maxSuccessfulRuns length for each job_id, or to the MaxSuccessfulRuns of
+// the RunRetention of the job if it overrides it. Completed and rejected runs
+// count towards it.
 //
 // It does this synchronously for small values and async/sampled for large
 // values.
@@ -706,26 +861,31 @@ func (o *orm) prune(ctx context.Context, tx sqlutil.DataSource, jobID int32) {
 	if jobID == 0 {
 		o.lggr.Panic("expected a non-zero job ID")
 	}
+	if o.archiver != nil {
+		// tx could still be rolled back once the runs are archived, so they are pruned by the reaper instead
+		return
+	}
+	maxSuccessfulRuns := o.retention(ctx, tx, jobID).maxSuccessfulRuns(o.maxSuccessfulRuns)
 	// For small maxSuccessfulRuns its fast enough to prune every time
-	if o.maxSuccessfulRuns < syncLimit {
-		o.withDataSource(tx).execPrune(ctx, jobID)
+	if maxSuccessfulRuns < syncLimit {
+		o.withDataSource(tx).execPrune(ctx, jobID, maxSuccessfulRuns)
 		return
 	}
 	// for large maxSuccessfulRuns we do it async on a sampled basis
-	every := o.maxSuccessfulRuns / 20 // it can get up to 5% larger than maxSuccessfulRuns before a prune
+	every := maxSuccessfulRuns / 20 // it can get up to 5% larger than maxSuccessfulRuns before a prune
 	cnt := o.loadCount(jobID)
 	val := cnt.Add(1)
 	if val%every == 0 {
 		ok := o.IfStarted(func() {
 			o.wg.Add(1)
 			go func(ctx context.Context) {
-				o.lggr.Debugw("Pruning runs", "jobID", jobID, "count", val, "every", every, "maxSuccessfulRuns", o.maxSuccessfulRuns)
+				o.lggr.Debugw("Pruning runs", "jobID", jobID, "count", val, "every", every, "maxSuccessfulRuns", maxSuccessfulRuns)
 				defer o.wg.Done()
 				ctx, cancel := o.stopCh.CtxCancel(context.WithTimeout(sqlutil.WithoutDefaultTimeout(ctx), time.Minute))
 				defer cancel()
 
 				// Must not use tx here since it could be stale by the time we execute async.
-				o.execPrune(ctx, jobID)
+				o.execPrune(ctx, jobID, maxSuccessfulRuns)
 			}(context.WithoutCancel(ctx)) // don't propagate cancellation
 		})
 		if !ok {
@@ -735,22 +895,21 @@ func (o *orm) prune(ctx context.Context, tx sqlutil.DataSource, jobID int32) {
 	}
 }
 
-func (o *orm) execPrune(ctx context.Context, jobID int32) {
-	res, err := o.ds.ExecContext(ctx, `DELETE FROM pipeline_runs WHERE pruning_key = $1 AND state = $2 AND id NOT IN (
+// pruneCondition matches the completed and rejected runs of a job above its maximum number of successful runs.
+// Rejected runs count towards it, as they are saved for every run refused by the limits of the job.
+const pruneCondition = `pruning_key = $1 AND state IN ($2, $3) AND id NOT IN (
 SELECT id FROM pipeline_runs
-WHERE pruning_key = $1 AND state = $2
+WHERE pruning_key = $1 AND state IN ($2, $3)
 ORDER BY id DESC
-LIMIT $3
-)`, jobID, RunStatusCompleted, o.maxSuccessfulRuns)
+LIMIT $4
+)`
+
+func (o *orm) execPrune(ctx context.Context, jobID int32, maxSuccessfulRuns uint64) {
+	rowsAffected, err := o.deleteRuns(ctx, pruneCondition, jobID, RunStatusCompleted, RunStatusRejected, maxSuccessfulRuns)
 	if err != nil {
 		o.lggr.Errorw("Failed to prune runs", "err", err, "jobID", jobID)
 		return
 	}
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
-		o.lggr.Errorw("Failed to get RowsAffected while pruning runs", "err", err, "jobID", jobID)
-		return
-	}
 	if rowsAffected == 0 {
 		// check the spec still exists and garbage collect if necessary
 		var exists bool
@@ -762,9 +921,29 @@ LIMIT $3
 			o.lggr.Debugw("Pipeline spec no longer exists, removing prune count", "jobID", jobID)
 			o.pm.Delete(jobID)
 		}
-	} else if o.maxSuccessfulRuns < syncLimit {
+	} else if maxSuccessfulRuns < syncLimit {
 		o.lggr.Tracew("Pruned runs", "rowsAffected", rowsAffected, "jobID", jobID)
 	} else {
 		o.lggr.Debugw("Pruned runs", "rowsAffected", rowsAffected, "jobID", jobID)
 	}
 }
+
+// pruneAll prunes the completed and rejected runs of each job above its maximum number of successful runs, outside of any
+// transaction, and returns the number of runs deleted.
+func (o *orm) pruneAll(ctx context.Context) (rowsDeleted int64, err error) {
+	var jobs []struct {
+		ID        int32
+		Retention RunRetention
+	}
+	if err = o.ds.SelectContext(ctx, &jobs, `SELECT id, retention FROM jobs`); err != nil {
+		return 0, errors.Wrap(err, "failed to load job retentions")
+	}
+	for _, jb := range jobs {
+		n, err := o.deleteRuns(ctx, pruneCondition, jb.ID, RunStatusCompleted, RunStatusRejected, jb.Retention.maxSuccessfulRuns(o.maxSuccessfulRuns))
+		if err != nil {
+			return rowsDeleted, errors.Wrapf(err, "failed to prune runs of job %d", jb.ID)
+		}
+		rowsDeleted += n
+	}
+	return rowsDeleted, nil
+}
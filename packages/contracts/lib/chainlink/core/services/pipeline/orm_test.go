@@ -2,10 +2,13 @@ package pipeline_test
 
 import (
 	"context"
+	"database/sql"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zapcore"
@@ -241,6 +244,34 @@ func TestInsertFinishedRuns(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestInsertFinishedRun_Rejected(t *testing.T) {
+	ctx := testutils.Context(t)
+	db, orm, _ := setupLiteORM(t)
+
+	_, err := db.Exec(`SET CONSTRAINTS fk_pipeline_runs_pruning_key DEFERRED`)
+	require.NoError(t, err)
+
+	ps := mustInsertPipelineSpec(t, db)
+	now := time.Now()
+	run := pipeline.Run{
+		PipelineSpecID: ps.ID,
+		PruningKey:     ps.ID,
+		State:          pipeline.RunStatusRejected,
+		AllErrors:      pipeline.RunErrors{null.StringFrom("run rejected by job limits")},
+		FatalErrors:    pipeline.RunErrors{null.StringFrom("run rejected by job limits")},
+		CreatedAt:      now,
+		FinishedAt:     null.TimeFrom(now),
+	}
+	require.NoError(t, orm.InsertFinishedRun(ctx, &run, false))
+	require.NotZero(t, run.ID)
+
+	saved, err := orm.FindRun(ctx, run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, pipeline.RunStatusRejected, saved.State)
+	assert.Equal(t, run.FatalErrors, saved.FatalErrors)
+	assert.Empty(t, saved.PipelineTaskRuns)
+}
+
 func Test_PipelineORM_InsertFinishedRunWithSpec(t *testing.T) {
 	ctx := testutils.Context(t)
 	db, orm, jorm := setupLiteORM(t)
@@ -841,6 +872,65 @@ func Test_GetUnfinishedRuns_DirectRequest(t *testing.T) {
 	require.Equal(t, 1, counter)
 }
 
+func Test_PipelineORM_Fragments(t *testing.T) {
+	ctx := testutils.Context(t)
+	_, orm, _ := setupLiteORM(t)
+
+	const median3 = `
+ds1 [type=http method=GET url="$(params.url1)"];
+ds2 [type=http method=GET url="$(params.url2)"];
+ds3 [type=http method=GET url="$(params.url3)"];
+median [type=median values=<[ $(ds1), $(ds2), $(ds3) ]>];
+`
+
+	t.Run("creates incrementing versions", func(t *testing.T) {
+		v1, err := orm.CreateFragment(ctx, "median3", median3)
+		require.NoError(t, err)
+		assert.Equal(t, "median3", v1.Name)
+		assert.Equal(t, int32(1), v1.Version)
+		assert.Equal(t, median3, v1.DotDagSource)
+
+		v2, err := orm.CreateFragment(ctx, "median3", median3)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), v2.Version)
+
+		found, err := orm.FindFragment(ctx, "median3", 1)
+		require.NoError(t, err)
+		assert.Equal(t, v1.ID, found.ID)
+	})
+
+	t.Run("validates references to other fragments", func(t *testing.T) {
+		_, err := orm.CreateFragment(ctx, "wrapper", `f [type=fragment name=median3 version=3];`)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		f, err := orm.CreateFragment(ctx, "wrapper", `f [type=fragment name=median3 version=2];`)
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), f.Version)
+	})
+
+	t.Run("rejects invalid fragments", func(t *testing.T) {
+		_, err := orm.CreateFragment(ctx, "not a name", median3)
+		require.Error(t, err)
+
+		_, err = orm.CreateFragment(ctx, "broken", `ds1 [type=http`)
+		require.Error(t, err)
+	})
+
+	t.Run("finds all fragments", func(t *testing.T) {
+		fragments, err := orm.FindFragments(ctx)
+		require.NoError(t, err)
+		require.Len(t, fragments, 3)
+		assert.Equal(t, "median3", fragments[0].Name)
+		assert.Equal(t, int32(1), fragments[0].Version)
+		assert.Equal(t, "median3", fragments[1].Name)
+		assert.Equal(t, int32(2), fragments[1].Version)
+		assert.Equal(t, "wrapper", fragments[2].Name)
+
+		_, err = orm.FindFragment(ctx, "median3", 3)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
 func Test_Prune(t *testing.T) {
 	t.Parallel()
 
@@ -915,6 +1005,182 @@ func Test_Prune(t *testing.T) {
 	assert.Equal(t, 3, cnt)
 }
 
+func mustCreateJobWithRetention(t *testing.T, jorm job.ORM, retention pipeline.RunRetention) job.Job {
+	t.Helper()
+	jb := job.Job{
+		Type:            job.DirectRequest,
+		SchemaVersion:   1,
+		MaxTaskDuration: models.Interval(1 * time.Minute),
+		DirectRequestSpec: &job.DirectRequestSpec{
+			ContractAddress: cltest.NewEIP55Address(),
+			EVMChainID:      (*big.Big)(&cltest.FixtureChainID),
+		},
+		PipelineSpec: &pipeline.Spec{
+			DotDagSource: `answer [type=memo value="1"];`,
+		},
+		Retention: retention,
+	}
+	require.NoError(t, jorm.CreateJob(testutils.Context(t), &jb))
+	return jb
+}
+
+func mustSetRunFinishedAt(t *testing.T, db *sqlx.DB, runID int64, age time.Duration) {
+	t.Helper()
+	pgtest.MustExec(t, db, `UPDATE pipeline_runs SET finished_at = $1 WHERE id = $2`, time.Now().Add(-age), runID)
+}
+
+func Test_Prune_RetentionOverride(t *testing.T) {
+	t.Parallel()
+
+	db, _, jorm := setupLiteORM(t)
+	porm := pipeline.NewORM(db, logger.TestLogger(t), 2)
+
+	jbDefault := mustCreateJobWithRetention(t, jorm, pipeline.RunRetention{})
+	jbOverride := mustCreateJobWithRetention(t, jorm, pipeline.RunRetention{MaxSuccessfulRuns: ptr[uint64](5)})
+
+	for i := 0; i < 10; i++ {
+		mustInsertPipelineRunWithStatus(t, db, jbDefault.PipelineSpecID, pipeline.RunStatusCompleted, jbDefault.ID)
+		mustInsertPipelineRunWithStatus(t, db, jbOverride.PipelineSpecID, pipeline.RunStatusCompleted, jbOverride.ID)
+	}
+
+	porm.Prune(t.Context(), jbDefault.ID)
+	porm.Prune(t.Context(), jbOverride.ID)
+
+	cnt := pgtest.MustCount(t, db, "SELECT count(*) FROM pipeline_runs WHERE pruning_key = $1", jbDefault.ID)
+	assert.Equal(t, 2, cnt)
+	cnt = pgtest.MustCount(t, db, "SELECT count(*) FROM pipeline_runs WHERE pruning_key = $1", jbOverride.ID)
+	assert.Equal(t, 5, cnt)
+
+	// an updated retention applies right away, as jobs are updated by re-creating them with the same ID
+	pgtest.MustExec(t, db, `UPDATE jobs SET retention = '{"maxSuccessfulRuns": 3}' WHERE id = $1`, jbOverride.ID)
+	mustInsertPipelineRunWithStatus(t, db, jbOverride.PipelineSpecID, pipeline.RunStatusCompleted, jbOverride.ID)
+	porm.Prune(t.Context(), jbOverride.ID)
+	cnt = pgtest.MustCount(t, db, "SELECT count(*) FROM pipeline_runs WHERE pruning_key = $1", jbOverride.ID)
+	assert.Equal(t, 3, cnt)
+}
+
+func Test_Prune_RejectedRuns(t *testing.T) {
+	t.Parallel()
+
+	db, _, jorm := setupLiteORM(t)
+	porm := pipeline.NewORM(db, logger.TestLogger(t), 2)
+
+	jb := mustCreateJobWithRetention(t, jorm, pipeline.RunRetention{})
+	errored := mustInsertPipelineRunWithStatus(t, db, jb.PipelineSpecID, pipeline.RunStatusErrored, jb.ID)
+	mustInsertPipelineRunWithStatus(t, db, jb.PipelineSpecID, pipeline.RunStatusCompleted, jb.ID)
+	for i := 0; i < 5; i++ {
+		mustInsertPipelineRunWithStatus(t, db, jb.PipelineSpecID, pipeline.RunStatusRejected, jb.ID)
+	}
+
+	// rejected runs count towards the completed runs kept, errored runs are left to the reaper
+	porm.Prune(t.Context(), jb.ID)
+	assert.Equal(t, 2, pgtest.MustCount(t, db, "SELECT count(*) FROM pipeline_runs WHERE pruning_key = $1 AND state = $2", jb.ID, pipeline.RunStatusRejected))
+	assert.Equal(t, 0, pgtest.MustCount(t, db, "SELECT count(*) FROM pipeline_runs WHERE pruning_key = $1 AND state = $2", jb.ID, pipeline.RunStatusCompleted))
+	assert.Equal(t, 1, pgtest.MustCount(t, db, "SELECT count(*) FROM pipeline_runs WHERE id = $1", errored))
+}
+
+func Test_PipelineORM_DeleteRunsOlderThan_RetentionOverride(t *testing.T) {
+	t.Parallel()
+
+	db, porm, jorm := setupLiteORM(t)
+
+	jbDefault := mustCreateJobWithRetention(t, jorm, pipeline.RunRetention{})
+	jbOverride := mustCreateJobWithRetention(t, jorm, pipeline.RunRetention{
+		MaxAge:         models.NewInterval(time.Hour),
+		KeepErroredFor: models.NewInterval(72 * time.Hour),
+	})
+
+	insert := func(jb job.Job, status pipeline.RunStatus, age time.Duration) int64 {
+		id := mustInsertPipelineRunWithStatus(t, db, jb.PipelineSpecID, status, jb.ID)
+		mustSetRunFinishedAt(t, db, id, age)
+		return id
+	}
+	reaped := []int64{
+		insert(jbDefault, pipeline.RunStatusCompleted, 25*time.Hour),
+		insert(jbDefault, pipeline.RunStatusErrored, 25*time.Hour),
+		insert(jbOverride, pipeline.RunStatusCompleted, 2*time.Hour),
+		insert(jbOverride, pipeline.RunStatusErrored, 73*time.Hour),
+	}
+	kept := []int64{
+		insert(jbDefault, pipeline.RunStatusCompleted, 2*time.Hour),
+		insert(jbOverride, pipeline.RunStatusCompleted, 30*time.Minute),
+		insert(jbOverride, pipeline.RunStatusErrored, 25*time.Hour),
+		mustInsertPipelineRunWithStatus(t, db, jbOverride.PipelineSpecID, pipeline.RunStatusRunning, jbOverride.ID),
+	}
+
+	require.NoError(t, porm.DeleteRunsOlderThan(testutils.Context(t), 24*time.Hour))
+
+	for _, id := range reaped {
+		assert.Equal(t, 0, pgtest.MustCount(t, db, "SELECT count(*) FROM pipeline_runs WHERE id = $1", id), "run %d", id)
+	}
+	for _, id := range kept {
+		assert.Equal(t, 1, pgtest.MustCount(t, db, "SELECT count(*) FROM pipeline_runs WHERE id = $1", id), "run %d", id)
+	}
+}
+
+func Test_PipelineORM_ArchiveAndImportRuns(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db, _, jorm := setupLiteORM(t)
+	dir := t.TempDir()
+	porm := pipeline.NewORM(db, logger.TestLogger(t), 2)
+	porm.SetArchiver(pipeline.NewRunArchiver(dir))
+
+	jb := mustCreateJobWithRetention(t, jorm, pipeline.RunRetention{})
+	var ids []int64
+	for i := 0; i < 5; i++ {
+		id := mustInsertPipelineRunWithStatus(t, db, jb.PipelineSpecID, pipeline.RunStatusCompleted, jb.ID)
+		mustSetRunFinishedAt(t, db, id, time.Duration(5-i)*time.Hour)
+		pgtest.MustExec(t, db, `INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, dot_id, created_at, finished_at)
+VALUES ($1, $2, 'memo', 0, '"1"', 'answer', NOW(), NOW())`, id, uuid.New())
+		ids = append(ids, id)
+	}
+
+	// runs are not archived as they are inserted, as the transaction inserting them could still be rolled back
+	porm.Prune(ctx, jb.ID)
+	assert.Equal(t, 5, pgtest.MustCount(t, db, "SELECT count(*) FROM pipeline_runs WHERE pruning_key = $1", jb.ID))
+
+	// the reaper prunes them instead
+	require.NoError(t, porm.DeleteRunsOlderThan(ctx, 24*time.Hour))
+	assert.Equal(t, 2, pgtest.MustCount(t, db, "SELECT count(*) FROM pipeline_runs WHERE pruning_key = $1", jb.ID))
+	assert.Equal(t, 2, pgtest.MustCount(t, db, "SELECT count(*) FROM pipeline_task_runs WHERE pipeline_run_id = ANY($1)", pq.Array(ids)))
+	files, err := filepath.Glob(filepath.Join(dir, "pipeline_runs_*.jsonl.gz"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	t.Run("filters by job", func(t *testing.T) {
+		result, err := pipeline.ImportRuns(ctx, db, dir, pipeline.ImportRunsFilter{JobID: jb.ID + 1})
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.ImportRunsResult{}, result)
+	})
+
+	t.Run("filters by finish time", func(t *testing.T) {
+		// the oldest run finished 5 hours ago, outside of the range
+		result, err := pipeline.ImportRuns(ctx, db, dir, pipeline.ImportRunsFilter{
+			From: time.Now().Add(-4*time.Hour - 30*time.Minute),
+			To:   time.Now(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.ImportRunsResult{Imported: 2}, result)
+		assert.Equal(t, 0, pgtest.MustCount(t, db, "SELECT count(*) FROM pipeline_runs WHERE id = $1", ids[0]))
+	})
+
+	t.Run("imports runs with their task runs once", func(t *testing.T) {
+		result, err := pipeline.ImportRuns(ctx, db, dir, pipeline.ImportRunsFilter{})
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.ImportRunsResult{Imported: 1, Existing: 2}, result)
+		assert.Equal(t, 5, pgtest.MustCount(t, db, "SELECT count(*) FROM pipeline_runs WHERE pruning_key = $1", jb.ID))
+		assert.Equal(t, 5, pgtest.MustCount(t, db, "SELECT count(*) FROM pipeline_task_runs WHERE pipeline_run_id = ANY($1)", pq.Array(ids)))
+
+		run, err := porm.FindRun(ctx, ids[0])
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+		require.Len(t, run.PipelineTaskRuns, 1)
+		assert.Equal(t, "answer", run.PipelineTaskRuns[0].DotID)
+	})
+}
+
 func mustInsertPipelineRunWithStatus(t *testing.T, db *sqlx.DB, pipelineSpecID int32, status pipeline.RunStatus, jobID int32) (runID int64) {
 	var finishedAt *time.Time
 	var outputs jsonserializable.JSONSerializable
@@ -928,7 +1194,7 @@ func mustInsertPipelineRunWithStatus(t *testing.T, db *sqlx.DB, pipelineSpecID i
 			Val:   "foo",
 			Valid: true,
 		}
-	case pipeline.RunStatusErrored:
+	case pipeline.RunStatusErrored, pipeline.RunStatusRejected:
 		finishedAt = &now
 		allErrors = []null.String{null.StringFrom("oh no!")}
 		fatalErrors = []null.String{null.StringFrom("oh no!")}
@@ -0,0 +1,73 @@
+package pipeline
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+// RunRetention overrides, for the runs of a single job, the retention of pipeline runs configured for all jobs by
+// JobPipeline.MaxSuccessfulRuns and JobPipeline.ReaperThreshold. Unset fields fall back to the node configuration.
+type RunRetention struct {
+	// MaxSuccessfulRuns is the number of completed runs kept for the job, along with the runs rejected by its
+	// RunLimits.
+	MaxSuccessfulRuns *uint64 `json:"maxSuccessfulRuns,omitempty" toml:"maxSuccessfulRuns"`
+	// MaxAge is the time finished runs are kept for.
+	MaxAge *models.Interval `json:"maxAge,omitempty" toml:"maxAge"`
+	// KeepErroredFor is the time errored runs are kept for, instead of MaxAge, so that failures can be investigated
+	// after successful runs are reaped.
+	KeepErroredFor *models.Interval `json:"keepErroredFor,omitempty" toml:"keepErroredFor"`
+}
+
+// Validate returns an error if the durations of r are not positive.
+func (r RunRetention) Validate() error {
+	if r.MaxAge != nil && r.MaxAge.Duration() <= 0 {
+		return errors.Errorf("maxAge must be positive, got %s", r.MaxAge.Duration())
+	}
+	if r.KeepErroredFor != nil && r.KeepErroredFor.Duration() <= 0 {
+		return errors.Errorf("keepErroredFor must be positive, got %s", r.KeepErroredFor.Duration())
+	}
+	return nil
+}
+
+// maxSuccessfulRuns returns the number of completed runs kept, where def is the node default.
+func (r RunRetention) maxSuccessfulRuns(def uint64) uint64 {
+	if r.MaxSuccessfulRuns != nil {
+		return *r.MaxSuccessfulRuns
+	}
+	return def
+}
+
+// thresholds returns the times before which finished and errored runs are reaped, where def is the node default age.
+func (r RunRetention) thresholds(now time.Time, def time.Duration) (finished time.Time, errored time.Time) {
+	maxAge := def
+	if r.MaxAge != nil {
+		maxAge = r.MaxAge.Duration()
+	}
+	keepErroredFor := maxAge
+	if r.KeepErroredFor != nil {
+		keepErroredFor = r.KeepErroredFor.Duration()
+	}
+	return now.Add(-maxAge), now.Add(-keepErroredFor)
+}
+
+// Value returns r serialized for database storage.
+func (r RunRetention) Value() (driver.Value, error) {
+	return json.Marshal(r)
+}
+
+// Scan reads r from the database.
+func (r *RunRetention) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("RunRetention#Scan received a value of type %T", value)
+	}
+	return json.Unmarshal(b, r)
+}
@@ -0,0 +1,50 @@
+package pipeline_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pelletier/go-toml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+func TestRunRetention_TOML(t *testing.T) {
+	t.Parallel()
+
+	var spec struct {
+		Retention pipeline.RunRetention `toml:"retention"`
+	}
+	require.NoError(t, toml.Unmarshal([]byte(`
+[retention]
+maxSuccessfulRuns = 10
+maxAge = "6h"
+keepErroredFor = "168h"
+`), &spec))
+	require.NotNil(t, spec.Retention.MaxSuccessfulRuns)
+	assert.Equal(t, uint64(10), *spec.Retention.MaxSuccessfulRuns)
+	assert.Equal(t, models.NewInterval(6*time.Hour), spec.Retention.MaxAge)
+	assert.Equal(t, models.NewInterval(168*time.Hour), spec.Retention.KeepErroredFor)
+
+	b, err := spec.Retention.Value()
+	require.NoError(t, err)
+	var scanned pipeline.RunRetention
+	require.NoError(t, scanned.Scan(b))
+	assert.Equal(t, spec.Retention, scanned)
+
+	var empty pipeline.RunRetention
+	require.NoError(t, empty.Scan([]byte(`{}`)))
+	assert.Equal(t, pipeline.RunRetention{}, empty)
+}
+
+func TestRunRetention_Validate(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, pipeline.RunRetention{}.Validate())
+	assert.NoError(t, pipeline.RunRetention{MaxAge: models.NewInterval(time.Hour), KeepErroredFor: models.NewInterval(time.Minute)}.Validate())
+	assert.EqualError(t, pipeline.RunRetention{MaxAge: models.NewInterval(0)}.Validate(), "maxAge must be positive, got 0s")
+	assert.EqualError(t, pipeline.RunRetention{KeepErroredFor: models.NewInterval(-time.Hour)}.Validate(), "keepErroredFor must be positive, got -1h0m0s")
+}
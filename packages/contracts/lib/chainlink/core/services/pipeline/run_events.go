@@ -0,0 +1,150 @@
+package pipeline
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// RunEventsBufferSize is the number of events buffered for each subscriber. Events are dropped for subscribers which
+// fall behind, so that they never block the execution of runs.
+const RunEventsBufferSize = 100
+
+// RunEventType is the type of a RunEvent.
+type RunEventType string
+
+const (
+	// RunEventCreated is published when the runner starts executing a run.
+	RunEventCreated RunEventType = "runCreated"
+	// RunEventTaskFinished is published when a task of a run finishes, with its output or error.
+	RunEventTaskFinished RunEventType = "taskFinished"
+	// RunEventFinished is published when a finished run is saved.
+	RunEventFinished RunEventType = "runFinished"
+	// RunEventQueued is published when a run waits for the concurrency limit of its job.
+	RunEventQueued RunEventType = "runQueued"
+	// RunEventRejected is published when a run is rejected by the limits of its job, it is not executed.
+	RunEventRejected RunEventType = "runRejected"
+)
+
+// RunEvent is a lifecycle event of a pipeline run.
+//
+// Runs which are not saved before being executed only get a RunID when they finish. The events of an execution can
+// be correlated with the ExecutionID.
+type RunEvent struct {
+	Type        RunEventType `json:"type"`
+	JobID       int32        `json:"jobID"`
+	RunID       int64        `json:"runID"`
+	ExecutionID uuid.UUID    `json:"executionID"`
+	State       RunStatus    `json:"state"`
+	Timestamp   time.Time    `json:"timestamp"`
+
+	// Task is set for RunEventTaskFinished events.
+	Task *TaskRunEvent `json:"task,omitempty"`
+	// Outputs, AllErrors and FatalErrors are set for RunEventFinished events.
+	Outputs     jsonserializable.JSONSerializable `json:"outputs"`
+	AllErrors   RunErrors                         `json:"allErrors"`
+	FatalErrors RunErrors                         `json:"fatalErrors"`
+}
+
+// TaskRunEvent is the result of a finished task.
+type TaskRunEvent struct {
+	ID     uuid.UUID                         `json:"id"`
+	DotID  string                            `json:"dotID"`
+	Type   TaskType                          `json:"type"`
+	Output jsonserializable.JSONSerializable `json:"output"`
+	Error  null.String                       `json:"error"`
+}
+
+func newRunEvent(eventType RunEventType, run *Run) RunEvent {
+	return RunEvent{
+		Type:        eventType,
+		JobID:       run.PipelineSpec.JobID,
+		RunID:       run.ID,
+		ExecutionID: run.executionID,
+		State:       run.State,
+		Timestamp:   time.Now(),
+	}
+}
+
+func newTaskFinishedEvent(run *Run, result TaskRunResult) RunEvent {
+	event := newRunEvent(RunEventTaskFinished, run)
+	event.State = RunStatusRunning
+	event.Task = &TaskRunEvent{
+		ID:     result.ID,
+		DotID:  result.Task.DotID(),
+		Type:   result.Task.Type(),
+		Output: result.Result.OutputDB(),
+		Error:  result.Result.ErrorDB(),
+	}
+	return event
+}
+
+func newRunFinishedEvent(run *Run) RunEvent {
+	event := newRunEvent(RunEventFinished, run)
+	event.Outputs = run.Outputs
+	event.AllErrors = run.AllErrors
+	event.FatalErrors = run.FatalErrors
+	return event
+}
+
+type runEventSubscription struct {
+	jobID int32
+	ch    chan RunEvent
+}
+
+// runEvents broadcasts RunEvents to subscribers.
+type runEvents struct {
+	lggr logger.Logger
+	mu   sync.RWMutex
+	subs map[*runEventSubscription]struct{}
+}
+
+func newRunEvents(lggr logger.Logger) *runEvents {
+	return &runEvents{
+		lggr: lggr,
+		subs: map[*runEventSubscription]struct{}{},
+	}
+}
+
+// subscribe returns a channel of the events of the runs of jobID, or of all jobs if jobID is 0.
+// The channel is closed by unsubscribe.
+func (e *runEvents) subscribe(jobID int32) (<-chan RunEvent, func()) {
+	sub := &runEventSubscription{jobID: jobID, ch: make(chan RunEvent, RunEventsBufferSize)}
+	e.mu.Lock()
+	e.subs[sub] = struct{}{}
+	e.mu.Unlock()
+
+	var once sync.Once
+	return sub.ch, func() {
+		once.Do(func() {
+			e.mu.Lock()
+			delete(e.subs, sub)
+			close(sub.ch)
+			e.mu.Unlock()
+		})
+	}
+}
+
+func (e *runEvents) publish(ctx context.Context, event RunEvent) {
+	if isSimulation(ctx) {
+		return
+	}
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	for sub := range e.subs {
+		if sub.jobID != 0 && sub.jobID != event.JobID {
+			continue
+		}
+		select {
+		case sub.ch <- event:
+		default:
+			e.lggr.Warnw("Dropping pipeline run event for slow subscriber", "jobID", event.JobID, "runID", event.RunID, "type", event.Type)
+		}
+	}
+}
@@ -15,6 +15,7 @@ import (
 	pkgerrors "github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/multierr"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
@@ -28,6 +29,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/recovery"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	clhttp "github.com/smartcontractkit/chainlink/v2/core/utils/http"
 )
 
 type Runner interface {
@@ -42,10 +44,16 @@ type Runner interface {
 	// ExecuteRun executes a new run in-memory according to a spec and returns the results.
 	// We expect spec.JobID and spec.JobName to be set for logging/prometheus.
 	ExecuteRun(ctx context.Context, spec Spec, vars Vars) (run *Run, trrs TaskRunResults, err error)
+	// SimulateRun executes a new run in-memory according to a spec, replacing the given tasks by their stubbed
+	// results and without executing tasks with side effects. The results are never persisted.
+	SimulateRun(ctx context.Context, spec Spec, vars Vars, stubs map[string]TaskStub) (run *Run, trrs TaskRunResults, err error)
 	// InsertFinishedRun saves the run results in the database.
-	// ds is an optional override, for example when executing a transaction.
+	// ds is an optional override, for example when executing a transaction. The RunEventFinished events of runs
+	// inserted with ds are not published, the caller must call PublishFinishedRuns once ds is committed.
 	InsertFinishedRun(ctx context.Context, ds sqlutil.DataSource, run *Run, saveSuccessfulTaskRuns bool) error
 	InsertFinishedRuns(ctx context.Context, ds sqlutil.DataSource, runs []*Run, saveSuccessfulTaskRuns bool) error
+	// PublishFinishedRuns publishes the RunEventFinished events of runs inserted with a ds override, once committed.
+	PublishFinishedRuns(ctx context.Context, runs []*Run)
 
 	// ExecuteAndInsertFinishedRun executes a new run in-memory according to a spec, persists and saves the results.
 	// It is a combination of ExecuteRun and InsertFinishedRun.
@@ -54,6 +62,15 @@ type Runner interface {
 	ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, vars Vars, saveSuccessfulTaskRuns bool) (runID int64, results TaskRunResults, err error)
 
 	OnRunFinished(func(*Run))
+	// BridgeHealth returns the monitor of the health of the bridges requested by the runs.
+	BridgeHealth() *bridges.HealthMonitor
+	// SubscribeRunEvents returns a channel of the lifecycle events of the runs of jobID, or of all jobs if jobID is 0.
+	// Events are dropped if the channel is not drained fast enough. unsubscribe must be called to release the
+	// subscription, it closes the channel.
+	SubscribeRunEvents(jobID int32) (events <-chan RunEvent, unsubscribe func())
+	// ForgetJob releases the state held for the runs of jobID, such as the limiter of its RunLimits, once the services
+	// of the job are stopped.
+	ForgetJob(jobID int32)
 	InitializePipeline(spec Spec) (*Pipeline, error)
 }
 
@@ -61,19 +78,29 @@ type runner struct {
 	services.StateMachine
 	orm                    ORM
 	btORM                  bridges.ORM
+	bridgeHealth           *bridges.HealthMonitor
 	config                 Config
 	bridgeConfig           BridgeConfig
 	legacyEVMChains        legacyevm.LegacyChainContainer
 	ethKeyStore            ETHKeyStore
 	vrfKeyStore            VRFKeyStore
+	csaKeyStore            CSAKeyStore
 	runReaperWorker        *commonutils.SleeperTask
 	lggr                   logger.Logger
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	bridgeHTTPClient       *http.Client
+
+	// fragment name and version => source, versions are immutable
+	fragments sync.Map
+
+	limiters runLimiters
 
 	// test helper
 	runFinished func(*Run)
 
+	events *runEvents
+
 	chStop services.StopChan
 	wgDone sync.WaitGroup
 }
@@ -106,6 +133,24 @@ var (
 	},
 		[]string{"job_id", "job_name", "task_id", "task_type", "bridge_name", "status"},
 	)
+	PromPipelineRunsQueued = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pipeline_runs_queued",
+		Help: "The number of runs waiting for the concurrency limit of their job",
+	},
+		[]string{"job_id", "job_name"},
+	)
+	PromPipelineRunsRunning = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pipeline_runs_running",
+		Help: "The number of runs executing, for jobs with run limits",
+	},
+		[]string{"job_id", "job_name"},
+	)
+	PromPipelineRunsRejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_runs_rejected",
+		Help: "The total number of runs rejected by the limits of their job",
+	},
+		[]string{"job_id", "job_name", "reason"},
+	)
 )
 
 func NewRunner(
@@ -116,25 +161,34 @@ func NewRunner(
 	legacyChains legacyevm.LegacyChainContainer,
 	ethks ETHKeyStore,
 	vrfks VRFKeyStore,
+	csaks CSAKeyStore,
 	lggr logger.Logger,
 	httpClient, unrestrictedHTTPClient *http.Client,
 ) *runner {
 	lggr = lggr.Named("PipelineRunner")
 
+	btCache := bridges.NewCache(btORM, lggr, bridges.DefaultUpsertInterval)
 	r := &runner{
 		orm:                    orm,
-		btORM:                  bridges.NewCache(btORM, lggr, bridges.DefaultUpsertInterval),
+		btORM:                  btCache,
+		bridgeHealth:           bridges.NewHealthMonitor(btCache, bridgeCfg.BridgeHealth(), unrestrictedHTTPClient, lggr),
 		config:                 cfg,
 		bridgeConfig:           bridgeCfg,
 		legacyEVMChains:        legacyChains,
 		ethKeyStore:            ethks,
 		vrfKeyStore:            vrfks,
+		csaKeyStore:            csaks,
 		chStop:                 make(chan struct{}),
 		wgDone:                 sync.WaitGroup{},
 		runFinished:            func(*Run) {},
+		events:                 newRunEvents(lggr),
 		lggr:                   lggr,
 		httpClient:             httpClient,
 		unrestrictedHTTPClient: unrestrictedHTTPClient,
+		bridgeHTTPClient:       unrestrictedHTTPClient,
+	}
+	if tlsCfg := bridgeCfg.BridgeClientTLS(); tlsCfg.CertPath() != "" {
+		r.bridgeHTTPClient = clhttp.NewClientWithCertificate(unrestrictedHTTPClient, tlsCfg.CertPath(), tlsCfg.KeyPath())
 	}
 
 	r.runReaperWorker = commonutils.NewSleeperTask(
@@ -157,10 +211,12 @@ func (r *runner) Start(ctx context.Context) error {
 		// the btORM can be a cache service or a static ORM if the constructor changes
 		service, isService := r.btORM.(services.Service)
 		if isService {
-			return service.Start(ctx)
+			if err := service.Start(ctx); err != nil {
+				return err
+			}
 		}
 
-		return nil
+		return r.bridgeHealth.Start(ctx)
 	})
 }
 
@@ -169,12 +225,14 @@ func (r *runner) Close() error {
 		close(r.chStop)
 		r.wgDone.Wait()
 
+		err := r.bridgeHealth.Close()
+
 		// the btORM can be a cache service or a static ORM if the constructor changes
 		if closer, isCloser := r.btORM.(io.Closer); isCloser {
-			return closer.Close()
+			err = multierr.Append(err, closer.Close())
 		}
 
-		return nil
+		return err
 	})
 }
 
@@ -184,6 +242,7 @@ func (r *runner) Name() string {
 
 func (r *runner) HealthReport() map[string]error {
 	runnerHealth := map[string]error{r.Name(): r.Healthy()}
+	services.CopyHealth(runnerHealth, r.bridgeHealth.HealthReport())
 
 	service, isService := r.btORM.(services.HealthReporter)
 	if !isService {
@@ -255,6 +314,71 @@ func (r *runner) OnRunFinished(fn func(*Run)) {
 	r.runFinished = fn
 }
 
+func (r *runner) BridgeHealth() *bridges.HealthMonitor {
+	return r.bridgeHealth
+}
+
+func (r *runner) SubscribeRunEvents(jobID int32) (<-chan RunEvent, func()) {
+	return r.events.subscribe(jobID)
+}
+
+func (r *runner) ForgetJob(jobID int32) {
+	r.limiters.forget(jobID)
+}
+
+// admitRun waits for run to be admitted by the limits of its job, and returns the function to call once it is
+// finished. Queued runs are published with the RunStatusQueued state, and rejected runs are saved and published with
+// the RunStatusRejected state. Simulated runs are not limited.
+func (r *runner) admitRun(ctx context.Context, run *Run) (release func(), err error) {
+	limiter := r.limiters.get(run.PipelineSpec)
+	if limiter == nil || isSimulation(ctx) {
+		return func() {}, nil
+	}
+	release, err = limiter.admit(ctx, time.Now(), func() {
+		r.publishAdmission(ctx, run, RunEventQueued, RunStatusQueued)
+	})
+	if err != nil {
+		if pkgerrors.Is(err, ErrRunRejected) {
+			r.rejectRun(ctx, run, err)
+		}
+		return nil, err
+	}
+	run.State = RunStatusRunning
+	return release, nil
+}
+
+// rejectRun saves run as rejected with err, so that it is listed with the runs of its job, and publishes it.
+func (r *runner) rejectRun(ctx context.Context, run *Run, err error) {
+	r.lggr.Warnw("Pipeline run rejected", "jobID", run.PipelineSpec.JobID, "jobName", run.PipelineSpec.JobName, "err", err)
+	run.State = RunStatusRejected
+	run.FinishedAt = null.TimeFrom(time.Now())
+	run.FatalErrors = RunErrors{null.StringFrom(err.Error())}
+	run.AllErrors = RunErrors{null.StringFrom(err.Error())}
+	if insertErr := r.orm.InsertFinishedRun(ctx, run, false); insertErr != nil {
+		r.lggr.Errorw("Failed to save rejected pipeline run", "jobID", run.PipelineSpec.JobID, "err", insertErr)
+	}
+	r.publishAdmission(ctx, run, RunEventRejected, RunStatusRejected)
+}
+
+// publishAdmission publishes an eventType event of run in state, preceded by the RunEventCreated event of run.
+func (r *runner) publishAdmission(ctx context.Context, run *Run, eventType RunEventType, state RunStatus) {
+	if run.executionID == uuid.Nil {
+		run.executionID = uuid.New()
+		r.events.publish(ctx, newRunEvent(RunEventCreated, run))
+	}
+	run.State = state
+	r.events.publish(ctx, newRunEvent(eventType, run))
+}
+
+// publishRunFinished publishes a RunEventFinished event for a saved run, if it is finished.
+func (r *runner) publishRunFinished(ctx context.Context, runs ...*Run) {
+	for _, run := range runs {
+		if run.FinishedAt.Valid {
+			r.events.publish(ctx, newRunFinishedEvent(run))
+		}
+	}
+}
+
 var (
 	// github.com/smartcontractkit/libocr/offchainreporting2plus/internal/protocol.ReportingPluginTimeoutWarningGracePeriod
 	overtime           = 100 * time.Millisecond
@@ -315,6 +439,11 @@ func (r *runner) ExecuteRun(ctx context.Context, spec Spec, vars Vars) (*Run, Ta
 	}
 
 	run := NewRun(spec, vars)
+	release, err := r.admitRun(ctx, run)
+	if err != nil {
+		return run, nil, err
+	}
+	defer release()
 	taskRunResults := r.run(ctx, pipeline, run, vars)
 
 	if run.Pending {
@@ -330,25 +459,33 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 		return
 	}
 
+	config := r.config
+	if spec.Limits.MaxHTTPResponseSize != nil {
+		config = limitedConfig{Config: r.config, maxHTTPResponseSize: int64(*spec.Limits.MaxHTTPResponseSize)}
+	}
+
 	// initialize certain task params
 	for _, task := range pipeline.Tasks {
 		task.Base().uuid = uuid.New()
 
 		switch task.Type() {
 		case TaskTypeHTTP:
-			task.(*HTTPTask).config = r.config
+			task.(*HTTPTask).config = config
 			task.(*HTTPTask).httpClient = r.httpClient
 			task.(*HTTPTask).unrestrictedHTTPClient = r.unrestrictedHTTPClient
 		case TaskTypeBridge:
-			task.(*BridgeTask).config = r.config
+			task.(*BridgeTask).config = config
 			task.(*BridgeTask).bridgeConfig = r.bridgeConfig
 			// orm added to BridgeTask
 			task.(*BridgeTask).orm = r.btORM
+			task.(*BridgeTask).health = r.bridgeHealth
+			task.(*BridgeTask).csaKeyStore = r.csaKeyStore
 			task.(*BridgeTask).specId = spec.ID
 			// URL is "safe" because it comes from the node's own database. We
 			// must use the unrestrictedHTTPClient because some node operators
-			// may run external adapters on their own hardware
-			task.(*BridgeTask).httpClient = r.unrestrictedHTTPClient
+			// may run external adapters on their own hardware. It presents the
+			// bridge client certificate, if any.
+			task.(*BridgeTask).httpClient = r.bridgeHTTPClient
 		case TaskTypeETHCall:
 			task.(*ETHCallTask).legacyChains = r.legacyEVMChains
 			task.(*ETHCallTask).config = r.config
@@ -370,6 +507,12 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*ETHTxTask).specGasLimit = spec.GasLimit
 			task.(*ETHTxTask).jobType = spec.JobType
 			task.(*ETHTxTask).forwardingAllowed = spec.ForwardingAllowed
+		case TaskTypeFragment:
+			task.(*FragmentTask).runner = r
+			task.(*FragmentTask).spec = spec
+		case TaskTypeForEach:
+			task.(*ForEachTask).runner = r
+			task.(*ForEachTask).spec = spec
 		default:
 		}
 	}
@@ -383,39 +526,29 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 		l.Debug("Initiating tasks for pipeline run of spec")
 	}
 
+	if run.executionID == uuid.Nil {
+		run.executionID = uuid.New()
+		r.events.publish(ctx, newRunEvent(RunEventCreated, run))
+	}
+
 	scheduler := newScheduler(pipeline, run, vars, l)
 	go scheduler.Run()
 
-	// This is "just in case" for cleaning up any stray reports.
-	// Normally the scheduler loop doesn't stop until all in progress runs report back
-	reportCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
-	defer cancel()
-
-	if pipelineTimeout := r.config.MaxRunDuration(); pipelineTimeout != 0 {
+	pipelineTimeout := r.config.MaxRunDuration()
+	if maxRunDuration := run.PipelineSpec.Limits.MaxRunDuration; maxRunDuration != nil {
+		pipelineTimeout = maxRunDuration.Duration()
+	}
+	if pipelineTimeout != 0 {
+		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(ctx, pipelineTimeout)
 		defer cancel()
 	}
 
-	for taskRun := range scheduler.taskCh {
-		taskRun := taskRun
-		// execute
-		go recovery.WrapRecoverHandle(l, func() {
-			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l)
-
-			logTaskRunToPrometheus(result, run.PipelineSpec)
-
-			scheduler.report(reportCtx, result)
-		}, func(err interface{}) {
-			t := time.Now()
-			scheduler.report(reportCtx, TaskRunResult{
-				ID:         uuid.New(),
-				Task:       taskRun.task,
-				Result:     Result{Error: ErrRunPanicked{err}},
-				FinishedAt: null.TimeFrom(t),
-				CreatedAt:  t, // TODO: more accurate start time
-			})
-		})
-	}
+	r.executeScheduled(ctx, run.PipelineSpec, scheduler, l, func(result TaskRunResult) {
+		if result.FinishedAt.Valid {
+			r.events.publish(ctx, newTaskFinishedEvent(run, result))
+		}
+	})
 
 	// if the run is suspended, awaiting resumption
 	run.Pending = scheduler.pending
@@ -433,8 +566,9 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 	}
 
 	// Update run results
+	results := scheduler.allResults()
 	run.PipelineTaskRuns = nil
-	for _, result := range scheduler.results {
+	for _, result := range results {
 		output := result.Result.OutputDB()
 		run.PipelineTaskRuns = append(run.PipelineTaskRuns, TaskRun{
 			ID:            result.ID,
@@ -486,10 +620,7 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 	}
 
 	// TODO: drop this once we stop using TaskRunResults
-	var taskRunResults TaskRunResults
-	for _, result := range scheduler.results {
-		taskRunResults = append(taskRunResults, result)
-	}
+	taskRunResults := results
 
 	var idxs []int32
 	for i := range taskRunResults {
@@ -537,6 +668,79 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 	return taskRunResults
 }
 
+// executeScheduled executes the task runs scheduled by scheduler until the scheduler is done.
+// onResult is optional, and called with the result of each task run before it is reported to the scheduler.
+func (r *runner) executeScheduled(ctx context.Context, spec Spec, scheduler *scheduler, l logger.Logger, onResult func(TaskRunResult)) {
+	// This is "just in case" for cleaning up any stray reports.
+	// Normally the scheduler loop doesn't stop until all in progress runs report back
+	reportCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancel()
+
+	for taskRun := range scheduler.taskCh {
+		taskRun := taskRun
+		// execute
+		go recovery.WrapRecoverHandle(l, func() {
+			result := r.executeTaskRun(ctx, spec, taskRun, l)
+
+			logTaskRunToPrometheus(result, spec)
+
+			if onResult != nil {
+				onResult(result)
+			}
+			scheduler.report(reportCtx, result)
+		}, func(err interface{}) {
+			t := time.Now()
+			result := TaskRunResult{
+				ID:         uuid.New(),
+				Task:       taskRun.task,
+				Result:     Result{Error: ErrRunPanicked{err}},
+				FinishedAt: null.TimeFrom(t),
+				CreatedAt:  t, // TODO: more accurate start time
+			}
+			if onResult != nil {
+				onResult(result)
+			}
+			scheduler.report(reportCtx, result)
+		})
+	}
+}
+
+// fragmentSource returns the source of a fragment version.
+func (r *runner) fragmentSource(ctx context.Context, name string, version int32) (string, error) {
+	key := fmt.Sprintf("%s@%d", name, version)
+	if source, ok := r.fragments.Load(key); ok {
+		return source.(string), nil
+	}
+	fragment, err := r.orm.FindFragment(ctx, name, version)
+	if err != nil {
+		return "", err
+	}
+	r.fragments.Store(key, fragment.DotDagSource)
+	return fragment.DotDagSource, nil
+}
+
+// runFragment executes the pipeline of a fragment to completion, as part of the run of a fragment task.
+func (r *runner) runFragment(ctx context.Context, spec Spec, vars Vars) (TaskRunResults, error) {
+	pipeline, err := r.InitializePipeline(spec)
+	if err != nil {
+		return nil, err
+	}
+	if isSimulation(ctx) {
+		stubSideEffects(pipeline)
+	}
+	run := NewRun(spec, vars)
+	l := r.lggr.With("jobID", spec.JobID, "jobName", spec.JobName)
+
+	scheduler := newScheduler(pipeline, run, vars, l)
+	go scheduler.Run()
+	r.executeScheduled(ctx, spec, scheduler, l, nil)
+
+	if scheduler.pending {
+		return nil, ErrFragmentAsync
+	}
+	return scheduler.allResults(), nil
+}
+
 func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryTaskRun, l logger.Logger) TaskRunResult {
 	start := time.Now()
 	l = l.With("taskName", taskRun.task.DotID(),
@@ -547,6 +751,7 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 	// - Pipeline-level timeout
 	// - Specific task timeout (task.TaskTimeout)
 	// - Job level task timeout (spec.MaxTaskDuration)
+	// - Job limits task timeout (spec.Limits.MaxTaskDuration)
 	// - Passed in context
 
 	// CAUTION: Think twice before changing any of the context handling code
@@ -563,6 +768,10 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		ctx, cancel = context.WithTimeout(ctx, time.Duration(spec.MaxTaskDuration))
 		defer cancel()
 	}
+	if maxTaskDuration := spec.Limits.MaxTaskDuration; maxTaskDuration != nil {
+		ctx, cancel = context.WithTimeout(ctx, maxTaskDuration.Duration())
+		defer cancel()
+	}
 
 	result, runInfo := taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
 	loggerFields := []interface{}{"runInfo", runInfo,
@@ -634,6 +843,7 @@ func (r *runner) ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, var
 	if err != nil {
 		return 0, trrs, pkgerrors.Wrapf(err, "error inserting finished results for spec ID %v", run.PipelineSpecID)
 	}
+	r.publishRunFinished(ctx, run)
 	return run.ID, trrs, nil
 }
 
@@ -652,6 +862,16 @@ func (r *runner) Run(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool,
 		task.Base().uuid = taskRun.ID
 	}
 
+	// resumed runs were already admitted when they started
+	if run.ID == 0 {
+		var release func()
+		release, err = r.admitRun(ctx, run)
+		if err != nil {
+			return false, err
+		}
+		defer release()
+	}
+
 	preinsert := pipeline.RequiresPreInsert()
 
 	err = r.orm.Transact(ctx, func(tx ORM) error {
@@ -724,6 +944,7 @@ func (r *runner) Run(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool,
 			}
 		}
 
+		r.publishRunFinished(ctx, run)
 		r.runFinished(run)
 
 		return run.Pending, err
@@ -756,19 +977,31 @@ func (r *runner) ResumeRun(ctx context.Context, taskID uuid.UUID, value interfac
 }
 
 func (r *runner) InsertFinishedRun(ctx context.Context, ds sqlutil.DataSource, run *Run, saveSuccessfulTaskRuns bool) error {
-	orm := r.orm
 	if ds != nil {
-		orm = orm.WithDataSource(ds)
+		// published by the caller once ds is committed
+		return r.orm.WithDataSource(ds).InsertFinishedRun(ctx, run, saveSuccessfulTaskRuns)
+	}
+	if err := r.orm.InsertFinishedRun(ctx, run, saveSuccessfulTaskRuns); err != nil {
+		return err
 	}
-	return orm.InsertFinishedRun(ctx, run, saveSuccessfulTaskRuns)
+	r.publishRunFinished(ctx, run)
+	return nil
 }
 
 func (r *runner) InsertFinishedRuns(ctx context.Context, ds sqlutil.DataSource, runs []*Run, saveSuccessfulTaskRuns bool) error {
-	orm := r.orm
 	if ds != nil {
-		orm = orm.WithDataSource(ds)
+		// published by the caller once ds is committed
+		return r.orm.WithDataSource(ds).InsertFinishedRuns(ctx, runs, saveSuccessfulTaskRuns)
+	}
+	if err := r.orm.InsertFinishedRuns(ctx, runs, saveSuccessfulTaskRuns); err != nil {
+		return err
 	}
-	return orm.InsertFinishedRuns(ctx, runs, saveSuccessfulTaskRuns)
+	r.publishRunFinished(ctx, runs...)
+	return nil
+}
+
+func (r *runner) PublishFinishedRuns(ctx context.Context, runs []*Run) {
+	r.publishRunFinished(ctx, runs...)
 }
 
 func (r *runner) runReaper() {
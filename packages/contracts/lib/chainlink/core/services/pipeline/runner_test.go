@@ -2,6 +2,7 @@ package pipeline_test
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -34,6 +35,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
@@ -49,7 +51,7 @@ func newRunner(t testing.TB, db *sqlx.DB, bridgeORM bridges.ORM, cfg chainlink.G
 	})
 	orm := mocks.NewORM(t)
 	c := clhttptest.NewTestLocalOnlyHTTPClient()
-	r := pipeline.NewRunner(orm, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, logger.TestLogger(t), c, c)
+	r := pipeline.NewRunner(orm, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, nil, logger.TestLogger(t), c, c)
 	return r, orm
 }
 
@@ -524,7 +526,7 @@ func Test_PipelineRunner_HandleFaultsPersistRun(t *testing.T) {
 		KeyStore:       ethKeyStore,
 	})
 	lggr := logger.TestLogger(t)
-	r := pipeline.NewRunner(orm, btORM, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, lggr, nil, nil)
+	r := pipeline.NewRunner(orm, btORM, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, nil, lggr, nil, nil)
 
 	spec := pipeline.Spec{
 		ID: 1,
@@ -570,7 +572,7 @@ func Test_PipelineRunner_ExecuteAndInsertFinishedRun_SavingTheSpec(t *testing.T)
 		KeyStore:       ethKeyStore,
 	})
 	lggr := logger.TestLogger(t)
-	r := pipeline.NewRunner(orm, btORM, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, lggr, nil, nil)
+	r := pipeline.NewRunner(orm, btORM, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, nil, lggr, nil, nil)
 
 	spec := pipeline.Spec{
 		DotDagSource: `
@@ -1046,7 +1048,7 @@ func Test_PipelineRunner_ExecuteRun(t *testing.T) {
 			KeyStore:       ethKeyStore,
 		})
 		lggr := logger.TestLogger(t)
-		r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, lggr, nil, nil)
+		r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, nil, lggr, nil, nil)
 
 		template := `
 succeed             [type=memo value=%d]
@@ -1077,3 +1079,525 @@ succeed;
 		assert.Equal(t, "1", trrs[0].Result.Value.(pipeline.ObjectParam).DecimalValue.Decimal().String())
 	})
 }
+
+func Test_PipelineRunner_SimulateRun(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	btORM := bridgesMocks.NewORM(t)
+	r, orm := newRunner(t, pgtest.NewSqlxDB(t), btORM, cfg)
+	ctx := testutils.Context(t)
+	spec := pipeline.Spec{
+		DotDagSource: `
+ds   [type=http method=GET url="http://example.invalid"]
+mul  [type=multiply input="$(ds)" times=2]
+tx   [type=ethtx to="0x0000000000000000000000000000000000000001" data="0x"]
+ds->mul->tx;`,
+	}
+
+	t.Run("uses stubbed outputs", func(t *testing.T) {
+		_, trrs, err := r.SimulateRun(ctx, spec, pipeline.NewVarsFrom(nil), map[string]pipeline.TaskStub{
+			"ds": {Value: 21},
+			"tx": {Value: "0xabcd"},
+		})
+		require.NoError(t, err)
+		require.Len(t, trrs, 3)
+		assert.False(t, trrs.FinalResult().HasFatalErrors())
+
+		byID := map[string]pipeline.TaskRunResult{}
+		for _, trr := range trrs {
+			byID[trr.Task.DotID()] = trr
+		}
+		assert.True(t, pipeline.IsStubbed(byID["ds"].Task))
+		assert.False(t, pipeline.IsStubbed(byID["mul"].Task))
+		assert.Equal(t, mustDecimal(t, "42").String(), byID["mul"].Result.Value.(decimal.Decimal).String())
+		assert.Equal(t, "0xabcd", byID["tx"].Result.Value)
+	})
+
+	t.Run("never executes unstubbed tasks with side effects", func(t *testing.T) {
+		_, trrs, err := r.SimulateRun(ctx, spec, pipeline.NewVarsFrom(nil), map[string]pipeline.TaskStub{
+			"ds": {Value: 21},
+		})
+		require.NoError(t, err)
+		require.Len(t, trrs, 3)
+		result := trrs.FinalResult()
+		require.True(t, result.HasFatalErrors())
+		assert.ErrorIs(t, result.AllErrors[0], pipeline.ErrSideEffectNotSimulated)
+	})
+
+	t.Run("stubs errors", func(t *testing.T) {
+		_, trrs, err := r.SimulateRun(ctx, spec, pipeline.NewVarsFrom(nil), map[string]pipeline.TaskStub{
+			"ds": {Error: "boom"},
+			"tx": {Value: "0xabcd"},
+		})
+		require.NoError(t, err)
+		for _, trr := range trrs {
+			if trr.Task.DotID() == "ds" {
+				assert.EqualError(t, trr.Result.Error, "boom")
+			}
+		}
+	})
+
+	t.Run("does not cache bridge responses", func(t *testing.T) {
+		adapter := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = io.WriteString(w, `{"data": {"result": 21}}`)
+		}))
+		defer adapter.Close()
+		_, bt := cltest.MustCreateBridge(t, pgtest.NewSqlxDB(t), cltest.BridgeOpts{URL: adapter.URL})
+		btORM.On("FindBridge", mock.Anything, bt.Name).Return(*bt, nil).Once()
+
+		// btORM fails the test on UpsertBridgeResponse
+		_, trrs, err := r.SimulateRun(ctx, pipeline.Spec{
+			DotDagSource: fmt.Sprintf(`ds [type=bridge name="%s" cacheTTL="30s"]`, bt.Name),
+		}, pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, err)
+		assert.False(t, trrs.FinalResult().HasFatalErrors())
+	})
+
+	t.Run("errors on unknown stubs", func(t *testing.T) {
+		_, _, err := r.SimulateRun(ctx, spec, pipeline.NewVarsFrom(nil), map[string]pipeline.TaskStub{
+			"nope": {Value: 1},
+		})
+		require.EqualError(t, err, `cannot stub task "nope": no such task in the pipeline`)
+	})
+
+	orm.AssertNotCalled(t, "InsertFinishedRun", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_PipelineRunner_Fragment(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	btORM := bridgesMocks.NewORM(t)
+	r, orm := newRunner(t, pgtest.NewSqlxDB(t), btORM, cfg)
+	ctx := testutils.Context(t)
+
+	orm.On("FindFragment", mock.Anything, "double", int32(1)).Return(pipeline.Fragment{
+		Name:    "double",
+		Version: 1,
+		DotDagSource: `
+mul [type=multiply input="$(params.x)" times=2]
+`,
+	}, nil).Once()
+	orm.On("FindFragment", mock.Anything, "quadruple", int32(1)).Return(pipeline.Fragment{
+		Name:    "quadruple",
+		Version: 1,
+		DotDagSource: `
+first  [type=fragment name="double" version=1 params=<{"x": $(params.x)}>]
+second [type=fragment name="double" version=1 params=<{"x": $(first)}>]
+first -> second
+`,
+	}, nil).Once()
+	orm.On("FindFragment", mock.Anything, "missing", int32(1)).Return(pipeline.Fragment{}, sql.ErrNoRows)
+
+	t.Run("executes the fragment and includes its task runs", func(t *testing.T) {
+		run, trrs, err := r.ExecuteRun(ctx, pipeline.Spec{
+			DotDagSource: `
+f [type=fragment name="quadruple" version=1 params=<{"x": $(x)}>]
+`,
+		}, pipeline.NewVarsFrom(map[string]interface{}{"x": 3}))
+		require.NoError(t, err)
+
+		result := trrs.FinalResult()
+		require.False(t, result.HasFatalErrors())
+		require.Len(t, result.Values, 1)
+		assert.Equal(t, "12", result.Values[0].(decimal.Decimal).String())
+
+		byDotID := map[string]pipeline.TaskRunResult{}
+		for _, trr := range trrs {
+			byDotID[trr.Task.DotID()] = trr
+		}
+		require.Len(t, byDotID, 5)
+		for dotID, parent := range map[string]string{
+			"f.first":      "f",
+			"f.second":     "f",
+			"f.first.mul":  "f.first",
+			"f.second.mul": "f.second",
+		} {
+			require.Contains(t, byDotID, dotID)
+			trr := byDotID[dotID]
+			assert.Equal(t, parent, pipeline.ParentTask(trr.Task).DotID())
+			assert.False(t, trr.IsTerminal())
+		}
+		assert.Equal(t, "6", byDotID["f.first.mul"].Result.Value.(decimal.Decimal).String())
+		assert.Nil(t, pipeline.ParentTask(byDotID["f"].Task))
+		assert.Len(t, run.PipelineTaskRuns, 5)
+	})
+
+	t.Run("fails when the fragment does not exist", func(t *testing.T) {
+		_, trrs, err := r.ExecuteRun(ctx, pipeline.Spec{
+			DotDagSource: `
+f [type=fragment name="missing" version=1]
+`,
+		}, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+		result := trrs.FinalResult()
+		require.True(t, result.HasFatalErrors())
+		assert.ErrorIs(t, result.FatalErrors[0], sql.ErrNoRows)
+	})
+}
+
+func Test_PipelineRunner_ForEach(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	btORM := bridgesMocks.NewORM(t)
+	r, orm := newRunner(t, pgtest.NewSqlxDB(t), btORM, cfg)
+	ctx := testutils.Context(t)
+
+	orm.On("FindFragment", mock.Anything, "scale", int32(1)).Return(pipeline.Fragment{
+		Name:    "scale",
+		Version: 1,
+		DotDagSource: `
+mul [type=multiply input="$(item.price)" times="$(params.times)"]
+`,
+	}, nil).Once()
+	orm.On("FindFragment", mock.Anything, "failing", int32(1)).Return(pipeline.Fragment{
+		Name:    "failing",
+		Version: 1,
+		DotDagSource: `
+div [type=divide input=1 divisor="$(item)"]
+`,
+	}, nil).Once()
+
+	t.Run("executes the fragment per element and collects the results", func(t *testing.T) {
+		run, trrs, err := r.ExecuteRun(ctx, pipeline.Spec{
+			DotDagSource: `
+ds     [type=jsonparse path="prices" data="$(body)"]
+prices [type=foreach fragment="scale" version=1 params=<{"times": 10}> maxConcurrency=2]
+ds -> prices
+`,
+		}, pipeline.NewVarsFrom(map[string]interface{}{"body": `{"prices": [{"price": 1}, {"price": 2}, {"price": 3}]}`}))
+		require.NoError(t, err)
+
+		result := trrs.FinalResult()
+		require.False(t, result.HasFatalErrors())
+		require.Len(t, result.Values, 1)
+		values := result.Values[0].([]interface{})
+		require.Len(t, values, 3)
+		for i, expected := range []string{"10", "20", "30"} {
+			assert.Equal(t, expected, values[i].(decimal.Decimal).String())
+		}
+
+		byDotID := map[string]pipeline.TaskRunResult{}
+		for _, trr := range trrs {
+			byDotID[trr.Task.DotID()] = trr
+		}
+		require.Len(t, byDotID, 5)
+		for i := 0; i < 3; i++ {
+			dotID := fmt.Sprintf("prices.%d.mul", i)
+			require.Contains(t, byDotID, dotID)
+			assert.Equal(t, "prices", pipeline.ParentTask(byDotID[dotID].Task).DotID())
+		}
+		assert.Len(t, run.PipelineTaskRuns, 5)
+	})
+
+	t.Run("fails when an iteration fails", func(t *testing.T) {
+		_, trrs, err := r.ExecuteRun(ctx, pipeline.Spec{
+			DotDagSource: `
+each [type=foreach input=<[1, 0, 2]> fragment="failing" version=1]
+`,
+		}, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+		result := trrs.FinalResult()
+		require.True(t, result.HasFatalErrors())
+		assert.Contains(t, result.FatalErrors[0].Error(), "item 1")
+		assert.Len(t, trrs, 4)
+	})
+
+	t.Run("rejects too many elements", func(t *testing.T) {
+		items := make([]interface{}, pipeline.MaxForEachItems+1)
+		_, trrs, err := r.ExecuteRun(ctx, pipeline.Spec{
+			DotDagSource: `
+each [type=foreach input="$(items)" fragment="scale" version=1]
+`,
+		}, pipeline.NewVarsFrom(map[string]interface{}{"items": items}))
+		require.NoError(t, err)
+		result := trrs.FinalResult()
+		require.True(t, result.HasFatalErrors())
+		assert.ErrorIs(t, result.FatalErrors[0], pipeline.ErrBadInput)
+	})
+}
+
+func Test_PipelineRunner_RunEvents(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	btORM := bridgesMocks.NewORM(t)
+	db := pgtest.NewSqlxDB(t)
+	r, orm := newRunner(t, db, btORM, cfg)
+	ctx := testutils.Context(t)
+
+	events, unsubscribe := r.SubscribeRunEvents(7)
+	defer unsubscribe()
+	otherEvents, unsubscribeOther := r.SubscribeRunEvents(8)
+	defer unsubscribeOther()
+
+	orm.On("InsertFinishedRun", mock.Anything, mock.Anything, false).Run(func(args mock.Arguments) {
+		args.Get(1).(*pipeline.Run).ID = 42
+	}).Return(nil).Once()
+
+	spec := pipeline.Spec{
+		ID:    1,
+		JobID: 7,
+		DotDagSource: `
+a   [type=memo value=1]
+b   [type=fail msg="boom"]
+a->b;`,
+	}
+	runID, _, err := r.ExecuteAndInsertFinishedRun(ctx, spec, pipeline.NewVarsFrom(nil), false)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), runID)
+
+	next := func() pipeline.RunEvent {
+		select {
+		case event := <-events:
+			return event
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for run event")
+		}
+		return pipeline.RunEvent{}
+	}
+
+	created := next()
+	assert.Equal(t, pipeline.RunEventCreated, created.Type)
+	assert.Equal(t, int32(7), created.JobID)
+	assert.Equal(t, pipeline.RunStatusRunning, created.State)
+
+	taskA := next()
+	require.Equal(t, pipeline.RunEventTaskFinished, taskA.Type)
+	assert.Equal(t, created.ExecutionID, taskA.ExecutionID)
+	assert.Equal(t, "a", taskA.Task.DotID)
+	assert.False(t, taskA.Task.Error.Valid)
+
+	taskB := next()
+	require.Equal(t, pipeline.RunEventTaskFinished, taskB.Type)
+	assert.Equal(t, "b", taskB.Task.DotID)
+	assert.Equal(t, "boom", taskB.Task.Error.String)
+
+	finished := next()
+	assert.Equal(t, pipeline.RunEventFinished, finished.Type)
+	assert.Equal(t, created.ExecutionID, finished.ExecutionID)
+	assert.Equal(t, int64(42), finished.RunID)
+	assert.Equal(t, pipeline.RunStatusErrored, finished.State)
+	require.Len(t, finished.FatalErrors, 1)
+
+	// simulations are not published
+	_, _, err = r.SimulateRun(ctx, spec, pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, err)
+
+	// runs inserted in a transaction of the caller are published once it is committed
+	runs := []*pipeline.Run{{ID: 43, PipelineSpec: pipeline.Spec{JobID: 7}, State: pipeline.RunStatusCompleted, FinishedAt: null.TimeFrom(time.Now())}}
+	orm.On("WithDataSource", db).Return(orm).Once()
+	orm.On("InsertFinishedRuns", mock.Anything, runs, true).Return(nil).Once()
+	require.NoError(t, r.InsertFinishedRuns(ctx, db, runs, true))
+	assert.Empty(t, events)
+	r.PublishFinishedRuns(ctx, runs)
+	finished = next()
+	assert.Equal(t, pipeline.RunEventFinished, finished.Type)
+	assert.Equal(t, int64(43), finished.RunID)
+
+	assert.Empty(t, events)
+	assert.Empty(t, otherEvents)
+
+	unsubscribe()
+	_, ok := <-events
+	assert.False(t, ok)
+}
+
+func Test_PipelineRunner_Limits(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	btORM := bridgesMocks.NewORM(t)
+	r, orm := newRunner(t, pgtest.NewSqlxDB(t), btORM, cfg)
+	ctx := testutils.Context(t)
+
+	var rejectedRuns []*pipeline.Run
+	orm.On("InsertFinishedRun", mock.Anything, mock.MatchedBy(func(run *pipeline.Run) bool {
+		return run.State == pipeline.RunStatusRejected
+	}), false).Run(func(args mock.Arguments) {
+		run := args.Get(1).(*pipeline.Run)
+		run.ID = int64(len(rejectedRuns) + 1)
+		rejectedRuns = append(rejectedRuns, run)
+	}).Return(nil)
+
+	t.Run("rejects runs over the rate limit", func(t *testing.T) {
+		events, unsubscribe := r.SubscribeRunEvents(11)
+		defer unsubscribe()
+
+		spec := pipeline.Spec{
+			JobID:        11,
+			DotDagSource: `a [type=memo value=1]`,
+			Limits: pipeline.RunLimits{
+				MaxRunsPerWindow: ptr(uint32(2)),
+				Window:           models.NewInterval(time.Hour),
+			},
+		}
+		for i := 0; i < 2; i++ {
+			_, _, err := r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(nil))
+			require.NoError(t, err)
+		}
+		run, _, err := r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(nil))
+		require.ErrorIs(t, err, pipeline.ErrRunRejected)
+		assert.Equal(t, pipeline.RunStatusRejected, run.State)
+
+		var rejected []pipeline.RunEvent
+		for len(events) > 0 {
+			if event := <-events; event.Type == pipeline.RunEventRejected {
+				rejected = append(rejected, event)
+			}
+		}
+		require.Len(t, rejected, 1)
+		assert.Equal(t, pipeline.RunStatusRejected, rejected[0].State)
+
+		// rejected runs are saved
+		require.Len(t, rejectedRuns, 1)
+		assert.Equal(t, rejectedRuns[0].ID, rejected[0].RunID)
+		assert.True(t, rejectedRuns[0].FinishedAt.Valid)
+		require.Len(t, rejectedRuns[0].FatalErrors, 1)
+		assert.Contains(t, rejectedRuns[0].FatalErrors[0].String, pipeline.ErrRunRejected.Error())
+
+		// simulations are not limited
+		_, _, err = r.SimulateRun(ctx, spec, pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("queues and rejects runs over the concurrency limit", func(t *testing.T) {
+		requested := make(chan struct{})
+		unblock := make(chan struct{})
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requested <- struct{}{}
+			<-unblock
+			_, _ = io.WriteString(w, `{"answer":1}`)
+		}))
+		defer s.Close()
+
+		events, unsubscribe := r.SubscribeRunEvents(12)
+		defer unsubscribe()
+
+		spec := pipeline.Spec{
+			JobID:        12,
+			DotDagSource: fmt.Sprintf(`a [type=http method=GET url="%s"]`, s.URL),
+			Limits: pipeline.RunLimits{
+				MaxConcurrentRuns: ptr(uint32(1)),
+				MaxQueuedRuns:     ptr(uint32(1)),
+			},
+		}
+		results := make(chan error, 2)
+		execute := func() {
+			_, trrs, err := r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(nil))
+			if err == nil && trrs.FinalResult().HasFatalErrors() {
+				err = trrs.FinalResult().FatalErrors[0]
+			}
+			results <- err
+		}
+		go execute()
+		<-requested
+
+		go execute()
+		for event := range events {
+			if event.Type == pipeline.RunEventQueued {
+				assert.Equal(t, pipeline.RunStatusQueued, event.State)
+				break
+			}
+		}
+
+		// the queue is full
+		run, _, err := r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(nil))
+		require.ErrorIs(t, err, pipeline.ErrRunRejected)
+		assert.Equal(t, pipeline.RunStatusRejected, run.State)
+
+		close(unblock)
+		<-requested
+		require.NoError(t, <-results)
+		require.NoError(t, <-results)
+	})
+
+	t.Run("only counts admitted runs towards the rate limit", func(t *testing.T) {
+		requested := make(chan struct{})
+		unblock := make(chan struct{})
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requested <- struct{}{}
+			<-unblock
+			_, _ = io.WriteString(w, `{"answer":1}`)
+		}))
+		defer s.Close()
+
+		spec := pipeline.Spec{
+			JobID:        14,
+			DotDagSource: fmt.Sprintf(`a [type=http method=GET url="%s"]`, s.URL),
+			Limits: pipeline.RunLimits{
+				MaxConcurrentRuns: ptr(uint32(1)),
+				MaxRunsPerWindow:  ptr(uint32(2)),
+				Window:            models.NewInterval(time.Hour),
+			},
+		}
+		results := make(chan error, 1)
+		go func() {
+			_, _, err := r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(nil))
+			results <- err
+		}()
+		<-requested
+
+		// rejected by the concurrency limit, without using the rate
+		_, _, err := r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(nil))
+		require.ErrorIs(t, err, pipeline.ErrRunRejected)
+		assert.ErrorContains(t, err, "runs are already running")
+
+		close(unblock)
+		require.NoError(t, <-results)
+
+		go func() { <-requested }()
+		_, _, err = r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+		_, _, err = r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(nil))
+		require.ErrorIs(t, err, pipeline.ErrRunRejected)
+		assert.ErrorContains(t, err, "runs already started within")
+	})
+
+	t.Run("forgets the limits of stopped jobs", func(t *testing.T) {
+		spec := pipeline.Spec{
+			JobID:        15,
+			DotDagSource: `a [type=memo value=1]`,
+			Limits: pipeline.RunLimits{
+				MaxRunsPerWindow: ptr(uint32(1)),
+				Window:           models.NewInterval(time.Hour),
+			},
+		}
+		_, _, err := r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+		_, _, err = r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(nil))
+		require.ErrorIs(t, err, pipeline.ErrRunRejected)
+
+		r.ForgetJob(spec.JobID)
+		_, _, err = r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+	})
+
+	t.Run("bounds the duration of each task", func(t *testing.T) {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(testutils.WaitTimeout(t)):
+			}
+		}))
+		defer s.Close()
+
+		_, trrs, err := r.ExecuteRun(ctx, pipeline.Spec{
+			JobID:        16,
+			DotDagSource: fmt.Sprintf(`a [type=http method=GET url="%s" timeout="1h"]`, s.URL),
+			Limits:       pipeline.RunLimits{MaxTaskDuration: models.NewInterval(100 * time.Millisecond)},
+		}, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+		require.True(t, trrs.FinalResult().HasFatalErrors())
+		assert.Contains(t, trrs.FinalResult().FatalErrors[0].Error(), "context deadline exceeded")
+	})
+
+	t.Run("overrides the maximum HTTP response size", func(t *testing.T) {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.WriteString(w, `{"answer":"too long for the limit"}`)
+		}))
+		defer s.Close()
+
+		size := utils.FileSize(10)
+		_, trrs, err := r.ExecuteRun(ctx, pipeline.Spec{
+			JobID:        13,
+			DotDagSource: fmt.Sprintf(`a [type=http method=GET url="%s"]`, s.URL),
+			Limits:       pipeline.RunLimits{MaxHTTPResponseSize: &size},
+		}, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+		require.True(t, trrs.FinalResult().HasFatalErrors())
+		assert.Contains(t, trrs.FinalResult().FatalErrors[0].Error(), "http: request body too large")
+	})
+}
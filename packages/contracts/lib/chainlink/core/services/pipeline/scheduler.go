@@ -54,6 +54,7 @@ type scheduler struct {
 	dependencies map[int]uint
 	waiting      uint
 	results      map[int]TaskRunResult
+	nested       map[int]TaskRunResults // results of the tasks executed by fragment tasks, by fragment task ID
 	vars         Vars
 	logger       logger.Logger
 
@@ -77,6 +78,7 @@ func newScheduler(p *Pipeline, run *Run, vars Vars, lggr logger.Logger) *schedul
 		run:          run,
 		dependencies: dependencies,
 		results:      make(map[int]TaskRunResult, len(p.Tasks)),
+		nested:       make(map[int]TaskRunResults),
 		vars:         vars,
 		logger:       lggr,
 
@@ -117,6 +119,10 @@ func (s *scheduler) reconstructResults() {
 		task := s.pipeline.ByDotID(r.DotID)
 
 		if task == nil {
+			if isFragmentChildDotID(s.pipeline, r.DotID) {
+				// the results of the fragment task itself are enough to resume the run
+				continue
+			}
 			panic("can't find task by dot id")
 		}
 
@@ -184,6 +190,9 @@ func (s *scheduler) Run() {
 
 		// store task run
 		s.results[result.Task.ID()] = result
+		if result.runInfo.nested != nil {
+			s.nested[result.Task.ID()] = result.runInfo.nested
+		}
 
 		// catch the pending state, we will keep the pipeline running until no more progress is made
 		if result.runInfo.IsPending {
@@ -274,6 +283,19 @@ func (s *scheduler) Run() {
 	close(s.taskCh)
 }
 
+// allResults returns the results of the tasks of the pipeline, followed by the results of the tasks executed by
+// fragment tasks.
+func (s *scheduler) allResults() TaskRunResults {
+	trrs := make(TaskRunResults, 0, len(s.results))
+	for _, result := range s.results {
+		trrs = append(trrs, result)
+	}
+	for _, nested := range s.nested {
+		trrs = append(trrs, nested...)
+	}
+	return trrs
+}
+
 func (s *scheduler) markRemaining(err error) {
 	now := time.Now()
 	for _, task := range s.pipeline.Tasks {
@@ -0,0 +1,98 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// ErrSideEffectNotSimulated is returned by tasks with side effects that were not stubbed in a simulation.
+var ErrSideEffectNotSimulated = errors.New("task has side effects and is not executed in a simulation, stub its output instead")
+
+// sideEffectTaskTypes are never executed during a simulation.
+var sideEffectTaskTypes = map[TaskType]struct{}{
+	TaskTypeETHTx: {},
+}
+
+type simulationCtxKey struct{}
+
+// TaskStub is the fake result of a task in a simulated run.
+// If Error is set, the task fails with it and Value is ignored.
+type TaskStub struct {
+	Value interface{} `json:"value"`
+	Error string      `json:"error"`
+}
+
+func (s TaskStub) result() Result {
+	if s.Error != "" {
+		return Result{Error: errors.New(s.Error)}
+	}
+	return Result{Value: s.Value}
+}
+
+// stubbedTask returns a fixed result instead of running the wrapped task.
+type stubbedTask struct {
+	Task
+	result Result
+}
+
+func (t *stubbedTask) Run(_ context.Context, _ logger.Logger, _ Vars, _ []Result) (Result, RunInfo) {
+	return t.result, RunInfo{}
+}
+
+// IsStubbed returns true if the task was not executed during a simulation.
+func IsStubbed(task Task) bool {
+	_, ok := task.(*stubbedTask)
+	return ok
+}
+
+// SimulateRun executes a new run in-memory like ExecuteRun, replacing the tasks listed in stubs by their dot ID with
+// fixed results. Tasks with side effects (ethtx) are never executed: they fail unless they are stubbed.
+// Nothing is persisted.
+func (r *runner) SimulateRun(ctx context.Context, spec Spec, vars Vars, stubs map[string]TaskStub) (*Run, TaskRunResults, error) {
+	// Always parse a fresh pipeline since its tasks are replaced below
+	spec.Pipeline = nil
+	p, err := r.InitializePipeline(spec)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	remaining := make(map[string]struct{}, len(stubs))
+	for dotID := range stubs {
+		remaining[dotID] = struct{}{}
+	}
+	for i, task := range p.Tasks {
+		if stub, ok := stubs[task.DotID()]; ok {
+			p.Tasks[i] = &stubbedTask{Task: task, result: stub.result()}
+			delete(remaining, task.DotID())
+		}
+	}
+	for dotID := range remaining {
+		return nil, nil, fmt.Errorf("cannot stub task %q: no such task in the pipeline", dotID)
+	}
+	stubSideEffects(p)
+
+	spec.Pipeline = p
+	// fragments invoked by the pipeline are simulated too
+	ctx = context.WithValue(ctx, simulationCtxKey{}, true)
+	return r.ExecuteRun(ctx, spec, vars)
+}
+
+// stubSideEffects replaces the tasks of p with side effects by tasks failing with ErrSideEffectNotSimulated.
+func stubSideEffects(p *Pipeline) {
+	for i, task := range p.Tasks {
+		if IsStubbed(task) {
+			continue
+		}
+		if _, ok := sideEffectTaskTypes[task.Type()]; ok {
+			p.Tasks[i] = &stubbedTask{Task: task, result: Result{Error: fmt.Errorf("%w: %s", ErrSideEffectNotSimulated, task.Type())}}
+		}
+	}
+}
+
+func isSimulation(ctx context.Context) bool {
+	simulation, _ := ctx.Value(simulationCtxKey{}).(bool)
+	return simulation
+}
@@ -17,7 +17,10 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/bridges/signing"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/eautils"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
 // NOTE: These metrics generate a new label per bridge, this should be safe
@@ -70,6 +73,12 @@ type BridgeTask struct {
 	config       Config
 	bridgeConfig BridgeConfig
 	httpClient   *http.Client
+	health       *bridges.HealthMonitor
+	csaKeyStore  CSAKeyStore
+}
+
+type CSAKeyStore interface {
+	GetAll() ([]csakey.KeyV2, error)
 }
 
 type BridgeTelemetry struct {
@@ -125,7 +134,12 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	overtimeCtx, cancel := overtimeContext(ctx)
 	defer cancel()
 
-	url, err := t.getBridgeURLFromName(overtimeCtx, name)
+	bt, err := t.orm.FindBridge(overtimeCtx, bridges.BridgeName(name))
+	if err != nil {
+		return Result{Error: errors.Wrapf(err, "could not find bridge with name '%s'", name)}, runInfo
+	}
+	url := URLParam(bt.URL)
+	signer, err := t.signer(bt)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
@@ -176,7 +190,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	defer cancel()
 
 	var cachedResponse bool
-	responseBytes, statusCode, headers, start, finish, err := makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+	url, responseBytes, statusCode, headers, start, finish, err := t.sendRequest(requestCtx, lggr, bt, signer, reqHeaders, requestData, requestDataJSON)
 	elapsed := finish.Sub(start)
 	promBridgeLatency.WithLabelValues(t.Name, statusCodeGroup(statusCode)).Set(elapsed.Seconds())
 
@@ -264,7 +278,8 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		}
 	}
 
-	if !cachedResponse && cacheTTL > 0 {
+	// simulated runs must not change the responses used by the real ones
+	if !cachedResponse && cacheTTL > 0 && !isSimulation(ctx) {
 		err := t.orm.UpsertBridgeResponse(overtimeCtx, t.dotID, t.specId, responseBytes)
 		if err != nil {
 			lggr.Errorw("Bridge task: failed to upsert response in bridge cache", "err", err)
@@ -289,12 +304,98 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	return result, runInfo
 }
 
-func (t *BridgeTask) getBridgeURLFromName(ctx context.Context, name StringParam) (URLParam, error) {
-	bt, err := t.orm.FindBridge(ctx, bridges.BridgeName(name))
-	if err != nil {
-		return URLParam{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+// sendRequest sends requestData to the URL of bt, and then to its fallback URLs in order, until one can be reached and
+// responds without a server error. URLs whose circuit breaker is open are skipped, and ErrCircuitOpen is returned if
+// all of them are. The outcome of each request is recorded to the health monitor. Each request is signed with signer,
+// unless it is nil.
+func (t *BridgeTask) sendRequest(ctx context.Context, lggr logger.Logger, bt bridges.BridgeType, signer signing.Signer, reqHeaders []string, requestData MapParam, requestDataJSON []byte) (
+	url URLParam,
+	responseBytes []byte,
+	statusCode int,
+	headers http.Header,
+	start, finish time.Time,
+	err error,
+) {
+	urls := append([]models.WebURL{bt.URL}, bt.FallbackURLs...)
+	t.health.SetURLs(bt.Name, bt.URLs())
+
+	url = URLParam(bt.URL)
+	err = errors.Wrapf(bridges.ErrCircuitOpen, "all URLs of bridge %s", bt.Name)
+	var attempted bool
+	for _, u := range urls {
+		raw := u.String()
+		if t.health.Allow(bt.Name, raw) != nil {
+			lggr.Debugw("Bridge task: skipping URL with open circuit breaker", "url", raw)
+			continue
+		}
+		if attempted {
+			lggr.Debugw("Bridge task: request failed, falling back to next URL",
+				"url", raw,
+				"failedURL", url.String(),
+				"status_code", statusCode,
+				"error", err,
+			)
+		}
+
+		url = URLParam(u)
+		attempted = true
+		urlHeaders := reqHeaders
+		if signer != nil {
+			// each request is signed with its own nonce and URL, as adapters reject replayed nonces and requests to other URLs
+			var sigHeaders http.Header
+			sigHeaders, err = signing.Sign(signer, bt.Name.String(), url.URL(), time.Now(), requestDataJSON)
+			if err != nil {
+				t.health.ReleaseTrial(bt.Name, raw)
+				return
+			}
+			urlHeaders = append([]string{}, reqHeaders...)
+			for k := range sigHeaders {
+				urlHeaders = append(urlHeaders, k, sigHeaders.Get(k))
+			}
+		}
+		responseBytes, statusCode, headers, start, finish, err = makeHTTPRequest(ctx, lggr, "POST", url, urlHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+		if err != nil && ctx.Err() != nil {
+			// the run ran out of time, which says nothing about the health of the URL
+			t.health.ReleaseTrial(bt.Name, raw)
+			return
+		}
+		// client errors are the fault of the request, so only transport and server errors count against the URL
+		var reqErr error
+		if statusCode >= http.StatusInternalServerError {
+			reqErr = errors.Errorf("bridge responded with status %d", statusCode)
+		} else if err != nil && statusCode == 0 {
+			reqErr = err
+		}
+		t.health.Record(bt.Name, raw, reqErr)
+		if reqErr == nil {
+			return
+		}
+	}
+	return
+}
+
+// signer returns the signer of the requests to bt, or nil if they are not signed.
+func (t *BridgeTask) signer(bt bridges.BridgeType) (signing.Signer, error) {
+	switch bt.SigningScheme {
+	case signing.SchemeNone:
+		return nil, nil
+	case signing.SchemeHMACSHA256:
+		return signing.NewHMACSigner([]byte(bt.OutgoingToken)), nil
+	case signing.SchemeCSAEd25519:
+		if t.csaKeyStore == nil {
+			return nil, errors.Errorf("bridge %s requires the CSA key to sign requests, but no CSA keystore is available", bt.Name)
+		}
+		keys, err := t.csaKeyStore.GetAll()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get CSA key to sign bridge request")
+		}
+		if len(keys) == 0 {
+			return nil, errors.Errorf("bridge %s requires the CSA key to sign requests, but there is none", bt.Name)
+		}
+		return signing.NewEd25519Signer(keys[0]), nil
+	default:
+		return nil, errors.Errorf("bridge %s has unknown signing scheme %q", bt.Name, bt.SigningScheme)
 	}
-	return URLParam(bt.URL), nil
 }
 
 func withRunInfo(request MapParam, meta MapParam) MapParam {
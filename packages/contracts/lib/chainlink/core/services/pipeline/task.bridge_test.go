@@ -2,6 +2,7 @@ package pipeline_test
 
 import (
 	"context"
+	"crypto/ed25519"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -25,6 +26,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/bridges/signing"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
@@ -32,6 +34,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/eautils"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
@@ -1246,3 +1249,222 @@ ds [type=bridge name="adapter-error-bridge" timeout="50ms" requestData="{\"data\
 	require.ErrorContains(t, finalResult.Result.Error, "AdapterLWBAError: bid ask violation detected")
 	require.Nil(t, finalResult.Result.Value)
 }
+
+type bridgeHealthConfig struct {
+	failureThreshold uint32
+}
+
+func (c bridgeHealthConfig) ProbeInterval() time.Duration { return 0 }
+func (c bridgeHealthConfig) ProbeTimeout() time.Duration  { return 5 * time.Second }
+func (c bridgeHealthConfig) FailureThreshold() uint32     { return c.failureThreshold }
+func (c bridgeHealthConfig) OpenTimeout() time.Duration   { return time.Hour }
+
+func TestBridgeTask_FallbackURLs(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	var primaryCalls atomic.Int32
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		primaryCalls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(primary.Close)
+	fallback := httptest.NewServer(fakePriceResponder(t, utils.MustUnmarshalToMap(btcUSDPairing), decimal.NewFromInt(9700), "", nil))
+	t.Cleanup(fallback.Close)
+
+	orm := bridges.NewORM(db)
+	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: primary.URL, FallbackURLs: []string{fallback.URL}})
+
+	trORM := pipeline.NewORM(db, logger.TestLogger(t), cfg.JobPipeline().MaxSuccessfulRuns())
+	specID, err := trORM.CreateSpec(ctx, pipeline.Pipeline{}, *models.NewInterval(5 * time.Minute))
+	require.NoError(t, err)
+
+	newTask := func(health *bridges.HealthMonitor) pipeline.BridgeTask {
+		task := pipeline.BridgeTask{
+			BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+			Name:        bridge.Name.String(),
+			RequestData: btcUSDPairing,
+		}
+		task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, specID, uuid.UUID{}, clhttptest.NewTestLocalOnlyHTTPClient())
+		task.HelperSetHealth(health)
+		return task
+	}
+
+	t.Run("falls back to the next URL on server errors", func(t *testing.T) {
+		task := newTask(nil)
+		result, runInfo := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		assert.False(t, runInfo.IsRetryable)
+		assert.Contains(t, result.Value, "9700")
+	})
+
+	t.Run("skips URLs with an open circuit breaker", func(t *testing.T) {
+		health := bridges.NewHealthMonitor(orm, bridgeHealthConfig{failureThreshold: 1}, nil, logger.TestLogger(t))
+		task := newTask(health)
+
+		primaryCalls.Store(0)
+		for i := 0; i < 3; i++ {
+			result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			require.NoError(t, result.Error)
+			assert.Contains(t, result.Value, "9700")
+		}
+		assert.Equal(t, int32(1), primaryCalls.Load())
+
+		h, ok := health.Health(bridge.Name)
+		require.True(t, ok)
+		assert.Equal(t, bridges.HealthStatusDegraded, h.Status)
+		require.Len(t, h.URLs, 2)
+		assert.Equal(t, bridges.BreakerOpen, h.URLs[0].Breaker)
+		assert.Equal(t, bridges.BreakerClosed, h.URLs[1].Breaker)
+	})
+
+	t.Run("fails fast when all circuit breakers are open", func(t *testing.T) {
+		health := bridges.NewHealthMonitor(orm, bridgeHealthConfig{failureThreshold: 1}, nil, logger.TestLogger(t))
+		health.SetURLs(bridge.Name, bridge.URLs())
+		for _, u := range bridge.URLs() {
+			health.Record(bridge.Name, u, errors.New("failed"))
+		}
+		task := newTask(health)
+
+		primaryCalls.Store(0)
+		result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorIs(t, result.Error, bridges.ErrCircuitOpen)
+		assert.Equal(t, int32(0), primaryCalls.Load())
+	})
+}
+
+func TestBridgeTask_ClientErrors(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	t.Cleanup(primary.Close)
+	var fallbackCalls atomic.Int32
+	fallback := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fallbackCalls.Add(1)
+	}))
+	t.Cleanup(fallback.Close)
+
+	orm := bridges.NewORM(db)
+	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: primary.URL, FallbackURLs: []string{fallback.URL}})
+
+	trORM := pipeline.NewORM(db, logger.TestLogger(t), cfg.JobPipeline().MaxSuccessfulRuns())
+	specID, err := trORM.CreateSpec(ctx, pipeline.Pipeline{}, *models.NewInterval(5 * time.Minute))
+	require.NoError(t, err)
+
+	health := bridges.NewHealthMonitor(orm, bridgeHealthConfig{failureThreshold: 1}, nil, logger.TestLogger(t))
+	task := pipeline.BridgeTask{
+		BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+		Name:        bridge.Name.String(),
+		RequestData: btcUSDPairing,
+	}
+	task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, specID, uuid.UUID{}, clhttptest.NewTestLocalOnlyHTTPClient())
+	task.HelperSetHealth(health)
+
+	// client errors are returned, without falling back or counting against the URL
+	for i := 0; i < 2; i++ {
+		result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorContains(t, result.Error, "status code 400")
+	}
+	assert.Equal(t, int32(0), fallbackCalls.Load())
+
+	h, ok := health.Health(bridge.Name)
+	require.True(t, ok)
+	assert.Equal(t, bridges.BreakerClosed, h.URLs[0].Breaker)
+	assert.Equal(t, uint32(0), h.URLs[0].ConsecutiveFailures)
+}
+
+type csaKeyStore []csakey.KeyV2
+
+func (ks csaKeyStore) GetAll() ([]csakey.KeyV2, error) { return ks, nil }
+
+func TestBridgeTask_SignedRequests(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	orm := bridges.NewORM(db)
+
+	trORM := pipeline.NewORM(db, logger.TestLogger(t), cfg.JobPipeline().MaxSuccessfulRuns())
+	specID, err := trORM.CreateSpec(ctx, pipeline.Pipeline{}, *models.NewInterval(5 * time.Minute))
+	require.NoError(t, err)
+
+	csaKey, err := csakey.NewV2()
+	require.NoError(t, err)
+
+	newTask := func(bridge *bridges.BridgeType, ks pipeline.CSAKeyStore) pipeline.BridgeTask {
+		task := pipeline.BridgeTask{
+			BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+			Name:        bridge.Name.String(),
+			RequestData: btcUSDPairing,
+		}
+		task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, specID, uuid.UUID{}, clhttptest.NewTestLocalOnlyHTTPClient())
+		task.HelperSetCSAKeyStore(ks)
+		return task
+	}
+
+	t.Run("hmac-sha256", func(t *testing.T) {
+		var verifier *signing.Verifier
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			verifier.Middleware(fakePriceResponder(t, utils.MustUnmarshalToMap(btcUSDPairing), decimal.NewFromInt(9700), "", nil)).ServeHTTP(w, r)
+		}))
+		t.Cleanup(s.Close)
+		_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: s.URL, SigningScheme: signing.SchemeHMACSHA256})
+		verifier = signing.NewHMACVerifier(bridge.Name.String(), bridge.OutgoingToken, 0)
+
+		task := newTask(bridge, nil)
+		result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		assert.Contains(t, result.Value, "9700")
+	})
+
+	t.Run("csa-ed25519", func(t *testing.T) {
+		var verifier *signing.Verifier
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			verifier.Middleware(fakePriceResponder(t, utils.MustUnmarshalToMap(btcUSDPairing), decimal.NewFromInt(9700), "", nil)).ServeHTTP(w, r)
+		}))
+		t.Cleanup(s.Close)
+		_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: s.URL + "/price?pair=BTC-USD", SigningScheme: signing.SchemeCSAEd25519})
+		verifier = signing.NewEd25519Verifier(bridge.Name.String(), []ed25519.PublicKey{csaKey.PublicKey}, 0)
+
+		task := newTask(bridge, csaKeyStore{csaKey})
+		result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		assert.Contains(t, result.Value, "9700")
+
+		task = newTask(bridge, csaKeyStore{})
+		result, _ = task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorContains(t, result.Error, "requires the CSA key to sign requests")
+	})
+
+	t.Run("requests to another bridge are rejected", func(t *testing.T) {
+		verifier := signing.NewEd25519Verifier("other-bridge", []ed25519.PublicKey{csaKey.PublicKey}, 0)
+		s := httptest.NewServer(verifier.Middleware(fakePriceResponder(t, utils.MustUnmarshalToMap(btcUSDPairing), decimal.NewFromInt(9700), "", nil)))
+		t.Cleanup(s.Close)
+		_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: s.URL, SigningScheme: signing.SchemeCSAEd25519})
+
+		task := newTask(bridge, csaKeyStore{csaKey})
+		result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.Error(t, result.Error)
+	})
+
+	t.Run("unsigned requests are rejected", func(t *testing.T) {
+		verifier := signing.NewHMACVerifier("bridge", "outgoing-token", 0)
+		s := httptest.NewServer(verifier.Middleware(fakePriceResponder(t, utils.MustUnmarshalToMap(btcUSDPairing), decimal.NewFromInt(9700), "", nil)))
+		t.Cleanup(s.Close)
+		_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: s.URL})
+
+		task := newTask(bridge, nil)
+		result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.Error(t, result.Error)
+	})
+}
@@ -0,0 +1,121 @@
+package pipeline
+
+import (
+	"context"
+	"math"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+const (
+	// DefaultForEachMaxConcurrency is the default number of iterations of a foreach task executed in parallel.
+	DefaultForEachMaxConcurrency = 4
+	// MaxForEachItems is the maximum number of elements a foreach task iterates over.
+	MaxForEachItems = 1000
+)
+
+// ForEachTask executes a version of a pipeline fragment once per element of its input list, and collects the
+// results into a list. The fragment's tasks can access the element with `$(item)`, its index with `$(index)` and the
+// task params with `$(params.<key>)`. At most maxConcurrency iterations are executed in parallel.
+//
+// The results of the fragment's tasks are included in the TaskRunResults of the run (see ParentTask), with dot IDs
+// prefixed by the dot ID of the foreach task and the iteration index, e.g. `prices.0.ds1`.
+//
+// The task fails if any iteration fails.
+//
+// Return types:
+//
+//	[]interface{} of the results of the iterations, in input order
+type ForEachTask struct {
+	BaseTask       `mapstructure:",squash"`
+	Input          string `json:"input"`
+	Fragment       string `json:"fragment"`
+	Version        string `json:"version"`
+	Params         string `json:"params"`
+	MaxConcurrency string `json:"maxConcurrency"`
+
+	runner *runner
+	spec   Spec
+}
+
+var _ Task = (*ForEachTask)(nil)
+
+func (t *ForEachTask) Type() TaskType {
+	return TaskTypeForEach
+}
+
+// staticRef returns the fragment referenced by the task if it does not depend on variables.
+func (t *ForEachTask) staticRef() (name string, version int32, ok bool) {
+	return staticFragmentRef(t.Fragment, t.Version)
+}
+
+func (t *ForEachTask) Run(ctx context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		items          SliceParam
+		fragment       StringParam
+		version        Uint64Param
+		params         MapParam
+		maxConcurrency Uint64Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&items, From(VarExpr(t.Input, vars), JSONWithVarExprs(t.Input, vars, false), Input(inputs, 0))), "input"),
+		errors.Wrap(ResolveParam(&fragment, From(VarExpr(t.Fragment, vars), NonemptyString(t.Fragment))), "fragment"),
+		errors.Wrap(ResolveParam(&version, From(VarExpr(t.Version, vars), NonemptyString(t.Version))), "version"),
+		errors.Wrap(ResolveParam(&params, From(VarExpr(t.Params, vars), JSONWithVarExprs(t.Params, vars, false), nil)), "params"),
+		errors.Wrap(ResolveParam(&maxConcurrency, From(VarExpr(t.MaxConcurrency, vars), NonemptyString(t.MaxConcurrency), DefaultForEachMaxConcurrency)), "maxConcurrency"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if version == 0 || version > math.MaxInt32 {
+		return Result{Error: errors.Wrapf(ErrBadInput, "invalid fragment version %d", version)}, runInfo
+	}
+	if maxConcurrency == 0 {
+		return Result{Error: errors.Wrap(ErrBadInput, "maxConcurrency must be greater than 0")}, runInfo
+	}
+	if len(items) > MaxForEachItems {
+		return Result{Error: errors.Wrapf(ErrBadInput, "input has %d elements, the maximum is %d", len(items), MaxForEachItems)}, runInfo
+	}
+
+	values := make([]interface{}, len(items))
+	iterations := make([]TaskRunResults, len(items))
+	errs := make([]error, len(items))
+
+	var g errgroup.Group
+	g.SetLimit(int(min(maxConcurrency, uint64(len(items)+1))))
+	for i, item := range items {
+		g.Go(func() error {
+			iterVars := NewVarsFrom(map[string]interface{}{
+				"item":   item,
+				"index":  i,
+				"params": params.Map(),
+			})
+			values[i], iterations[i], errs[i] = t.runner.invokeFragment(ctx, t.spec, string(fragment), int32(version), iterVars)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	for i, trrs := range iterations {
+		prefix := t.DotID() + fragmentDotIDSep + strconv.Itoa(i)
+		for j := range trrs {
+			trrs[j].Task = &fragmentChildTask{Task: trrs[j].Task, parent: t, dotIDPrefix: prefix}
+		}
+		runInfo.nested = append(runInfo.nested, trrs...)
+		err = multierr.Append(err, errors.Wrapf(errs[i], "item %d", i))
+	}
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	return Result{Value: values}, runInfo
+}
@@ -0,0 +1,201 @@
+package pipeline
+
+import (
+	"context"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+// MaxFragmentDepth is the maximum nesting of fragments invoking other fragments.
+const MaxFragmentDepth = 8
+
+// fragmentDotIDSep separates the dot ID of a fragment task from the dot IDs of the tasks it executed.
+const fragmentDotIDSep = "."
+
+var (
+	ErrFragmentAsync   = errors.New("fragments cannot contain asynchronous tasks")
+	ErrFragmentTooDeep = errors.Errorf("fragments cannot be nested more than %d levels deep", MaxFragmentDepth)
+
+	fragmentNameRegex = regexp.MustCompile("^[a-zA-Z0-9_-]+$")
+)
+
+type fragmentDepthCtxKey struct{}
+
+// ValidateFragmentName returns an error if name cannot be used as a fragment name.
+func ValidateFragmentName(name string) error {
+	if !fragmentNameRegex.MatchString(name) {
+		return errors.Errorf("fragment name %q must contain only letters, digits, dashes and underscores", name)
+	}
+	return nil
+}
+
+// FragmentTask executes a version of a pipeline fragment stored in the database, as if its tasks were part of the
+// pipeline. The fragment's tasks can access the task params with `$(params.<key>)` and the values of the task
+// inputs with `$(inputs)`.
+//
+// The results of the fragment's tasks are included in the TaskRunResults of the run (see ParentTask), with dot IDs
+// prefixed by the dot ID of the fragment task, e.g. `median3.ds1`.
+//
+// Return types:
+//
+//	the value of the fragment's terminal task, or
+//	[]interface{} of the values of its terminal tasks ordered by index, if it has several
+type FragmentTask struct {
+	BaseTask `mapstructure:",squash"`
+	Name     string `json:"name"`
+	Version  string `json:"version"`
+	Params   string `json:"params"`
+
+	runner *runner
+	spec   Spec
+}
+
+var _ Task = (*FragmentTask)(nil)
+
+func (t *FragmentTask) Type() TaskType {
+	return TaskTypeFragment
+}
+
+// staticRef returns the fragment referenced by the task if it does not depend on variables.
+func (t *FragmentTask) staticRef() (name string, version int32, ok bool) {
+	return staticFragmentRef(t.Name, t.Version)
+}
+
+func (t *FragmentTask) Run(ctx context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	inputValues, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		name    StringParam
+		version Uint64Param
+		params  MapParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&name, From(VarExpr(t.Name, vars), NonemptyString(t.Name))), "name"),
+		errors.Wrap(ResolveParam(&version, From(VarExpr(t.Version, vars), NonemptyString(t.Version))), "version"),
+		errors.Wrap(ResolveParam(&params, From(VarExpr(t.Params, vars), JSONWithVarExprs(t.Params, vars, false), nil)), "params"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if version == 0 || version > math.MaxInt32 {
+		return Result{Error: errors.Wrapf(ErrBadInput, "invalid fragment version %d", version)}, runInfo
+	}
+
+	value, trrs, err := t.runner.invokeFragment(ctx, t.spec, string(name), int32(version), NewVarsFrom(map[string]interface{}{
+		"params": params.Map(),
+		"inputs": inputValues,
+	}))
+	for i := range trrs {
+		trrs[i].Task = &fragmentChildTask{Task: trrs[i].Task, parent: t, dotIDPrefix: t.DotID()}
+	}
+	runInfo.nested = trrs
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	return Result{Value: value}, runInfo
+}
+
+// invokeFragment executes a fragment version to completion with vars. It returns the value of the fragment's terminal
+// task, or the values of its terminal tasks if it has several, along with the results of all the tasks executed.
+func (r *runner) invokeFragment(ctx context.Context, parentSpec Spec, name string, version int32, vars Vars) (interface{}, TaskRunResults, error) {
+	depth, _ := ctx.Value(fragmentDepthCtxKey{}).(int)
+	if depth >= MaxFragmentDepth {
+		return nil, nil, ErrFragmentTooDeep
+	}
+	ctx = context.WithValue(ctx, fragmentDepthCtxKey{}, depth+1)
+
+	source, err := r.fragmentSource(ctx, name, version)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "fragment %s version %d", name, version)
+	}
+	spec := Spec{
+		ID:                parentSpec.ID,
+		DotDagSource:      source,
+		MaxTaskDuration:   parentSpec.MaxTaskDuration,
+		GasLimit:          parentSpec.GasLimit,
+		ForwardingAllowed: parentSpec.ForwardingAllowed,
+		JobID:             parentSpec.JobID,
+		JobName:           parentSpec.JobName,
+		JobType:           parentSpec.JobType,
+	}
+
+	trrs, err := r.runFragment(ctx, spec, vars)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "fragment %s version %d", name, version)
+	}
+	final := trrs.FinalResult()
+	if final.HasFatalErrors() {
+		return nil, trrs, final.CombinedError()
+	}
+	if len(final.Values) == 1 {
+		return final.Values[0], trrs, nil
+	}
+	return final.Values, trrs, nil
+}
+
+func staticFragmentRef(name, version string) (string, int32, bool) {
+	if strings.Contains(name, "$(") || strings.Contains(version, "$(") {
+		return "", 0, false
+	}
+	v, err := strconv.ParseInt(version, 10, 32)
+	if err != nil {
+		return "", 0, false
+	}
+	return name, int32(v), true
+}
+
+// InvokedFragment returns the name of the fragment invoked by task, if it is a fragment or foreach task.
+func InvokedFragment(task Task) (string, bool) {
+	switch t := task.(type) {
+	case *FragmentTask:
+		return t.Name, true
+	case *ForEachTask:
+		return t.Fragment, true
+	default:
+		return "", false
+	}
+}
+
+// fragmentChildTask is a task of a fragment invoked by parent. It is never terminal, since its results flow into
+// the parent task.
+type fragmentChildTask struct {
+	Task
+	parent      Task
+	dotIDPrefix string
+}
+
+func (t *fragmentChildTask) DotID() string {
+	return t.dotIDPrefix + fragmentDotIDSep + t.Task.DotID()
+}
+
+func (t *fragmentChildTask) Outputs() []Task {
+	return []Task{t.parent}
+}
+
+// ParentTask returns the fragment or foreach task that executed task, or nil if task is not part of a fragment.
+func ParentTask(task Task) Task {
+	if child, ok := task.(*fragmentChildTask); ok {
+		return child.parent
+	}
+	return nil
+}
+
+// isFragmentChildDotID returns true if dotID is the dot ID of a task executed by a fragment or foreach task of p.
+func isFragmentChildDotID(p *Pipeline, dotID string) bool {
+	parentDotID, _, found := strings.Cut(dotID, fragmentDotIDSep)
+	if !found {
+		return false
+	}
+	parent := p.ByDotID(parentDotID)
+	return parent != nil && (parent.Type() == TaskTypeFragment || parent.Type() == TaskTypeForEach)
+}
@@ -0,0 +1,114 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+)
+
+const (
+	// DefaultJSONQueryMaxDataBytes is the largest document the jsonquery task evaluates unless overridden with maxDataBytes.
+	DefaultJSONQueryMaxDataBytes = 10 * 1024 * 1024
+	// MaxJSONQueryDataBytes is the largest maxDataBytes accepted by the jsonquery task.
+	MaxJSONQueryDataBytes = 100 * 1024 * 1024
+	// MaxJSONQueryLength is the longest query accepted by the jsonquery task.
+	MaxJSONQueryLength = 4096
+)
+
+// JSONQueryTask evaluates a GJSON query (https://github.com/tidwall/gjson/blob/master/SYNTAX.md) against a JSON
+// document. Unlike jsonparse, the query may iterate over arrays, filter them, select multiple paths and reshape the
+// result with modifiers, e.g. `data.#(volume>1000)#.price` or `{"bid":data.bids.0,"ask":data.asks.0}`.
+//
+// Queries returning several matches (`#` and `#(...)#` queries, multipaths) return them as a single []interface{}.
+// Evaluation cannot be interrupted, so the task timeout is not applied to it. Instead, its time and memory are bounded
+// by the size of the document, which is limited by maxDataBytes, times the length of the query, which is limited to
+// MaxJSONQueryLength.
+//
+// Return types:
+//
+//	float64
+//	int64
+//	string
+//	bool
+//	map[string]interface{}
+//	[]interface{}
+//	nil
+type JSONQueryTask struct {
+	BaseTask     `mapstructure:",squash"`
+	Query        string `json:"query"`
+	Data         string `json:"data"`
+	MaxDataBytes string `json:"maxDataBytes"`
+	// Lax when disabled will return an error if the query does not match anything
+	// Lax when enabled will return nil with no error if the query does not match anything
+	Lax string
+}
+
+var _ Task = (*JSONQueryTask)(nil)
+
+func (t *JSONQueryTask) Type() TaskType {
+	return TaskTypeJSONQuery
+}
+
+func (t *JSONQueryTask) Run(ctx context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		query        StringParam
+		data         JSONParam
+		maxDataBytes Uint64Param
+		lax          BoolParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&query, From(VarExpr(t.Query, vars), NonemptyString(t.Query))), "query"),
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), Input(inputs, 0))), "data"),
+		errors.Wrap(ResolveParam(&maxDataBytes, From(NonemptyString(t.MaxDataBytes), DefaultJSONQueryMaxDataBytes)), "maxDataBytes"),
+		errors.Wrap(ResolveParam(&lax, From(NonemptyString(t.Lax), false)), "lax"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if maxDataBytes > MaxJSONQueryDataBytes {
+		return Result{Error: errors.Wrapf(ErrBadInput, "maxDataBytes of %d exceeds the maximum of %d", maxDataBytes, MaxJSONQueryDataBytes)}, runInfo
+	}
+	if uint64(len(data)) > uint64(maxDataBytes) {
+		return Result{Error: errors.Wrapf(ErrBadInput, "data is %d bytes, exceeding maxDataBytes of %d", len(data), maxDataBytes)}, runInfo
+	}
+	if len(query) > MaxJSONQueryLength {
+		return Result{Error: errors.Wrapf(ErrBadInput, "query is %d bytes, exceeding the maximum of %d", len(query), MaxJSONQueryLength)}, runInfo
+	}
+	if ctx.Err() != nil {
+		return Result{Error: errors.Wrap(ctx.Err(), "query evaluation")}, runInfo
+	}
+
+	res := gjson.GetBytes(data, string(query))
+
+	if !res.Exists() {
+		if bool(lax) {
+			return Result{Value: nil}, runInfo
+		}
+		return Result{Error: errors.Wrapf(ErrKeypathNotFound, "query %q did not match anything", string(query))}, runInfo
+	}
+
+	var decoded interface{}
+	d := json.NewDecoder(bytes.NewReader([]byte(res.Raw)))
+	d.UseNumber()
+	if err = d.Decode(&decoded); err != nil {
+		return Result{Error: multierr.Combine(ErrBadInput, err)}, runInfo
+	}
+	decoded, err = jsonserializable.ReinterpretJSONNumbers(decoded)
+	if err != nil {
+		return Result{Error: multierr.Combine(ErrBadInput, err)}, runInfo
+	}
+
+	return Result{Value: decoded}, runInfo
+}
@@ -0,0 +1,227 @@
+package pipeline_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestJSONQueryTask(t *testing.T) {
+	t.Parallel()
+
+	const book = `{"data":{"pair":"ETH/USD","trades":[{"price":3000.5,"volume":10},{"price":3001,"volume":2000},{"price":2999,"volume":5000}]}}`
+
+	tests := []struct {
+		name           string
+		query          string
+		data           string
+		maxDataBytes   string
+		lax            string
+		vars           pipeline.Vars
+		inputs         []pipeline.Result
+		wantData       interface{}
+		wantErrorCause error
+	}{
+		{
+			"simple path",
+			"data.pair",
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: book}},
+			"ETH/USD",
+			nil,
+		},
+		{
+			"array index",
+			"data.trades.1.volume",
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: book}},
+			int64(2000),
+			nil,
+		},
+		{
+			"all elements of an array",
+			"data.trades.#.price",
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: book}},
+			[]interface{}{3000.5, int64(3001), int64(2999)},
+			nil,
+		},
+		{
+			"filter",
+			"data.trades.#(volume>1000)#.price",
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: book}},
+			[]interface{}{int64(3001), int64(2999)},
+			nil,
+		},
+		{
+			"reshape",
+			`{"pair":data.pair,"first":data.trades.0.price}`,
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: book}},
+			map[string]interface{}{"pair": "ETH/USD", "first": 3000.5},
+			nil,
+		},
+		{
+			"map input",
+			"a.b",
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: map[string]interface{}{"a": map[string]interface{}{"b": true}}}},
+			true,
+			nil,
+		},
+		{
+			"query and data from vars",
+			"$(query)",
+			"$(foo.bar)",
+			"",
+			"",
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"query": "data.trades.#",
+				"foo":   map[string]interface{}{"bar": book},
+			}),
+			nil,
+			int64(3),
+			nil,
+		},
+		{
+			"no match",
+			"data.nope",
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: book}},
+			nil,
+			pipeline.ErrKeypathNotFound,
+		},
+		{
+			"no match lax",
+			"data.nope",
+			"",
+			"",
+			"true",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: book}},
+			nil,
+			nil,
+		},
+		{
+			"data too large",
+			"data.pair",
+			"",
+			"16",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: book}},
+			nil,
+			pipeline.ErrBadInput,
+		},
+		{
+			"invalid JSON",
+			"data.pair",
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"data":`}},
+			nil,
+			pipeline.ErrBadInput,
+		},
+		{
+			"maxDataBytes over the maximum",
+			"data.pair",
+			"",
+			"104857601",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: book}},
+			nil,
+			pipeline.ErrBadInput,
+		},
+		{
+			"query too long",
+			"data." + strings.Repeat("a", pipeline.MaxJSONQueryLength),
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: book}},
+			nil,
+			pipeline.ErrBadInput,
+		},
+		{
+			"missing query",
+			"",
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: book}},
+			nil,
+			pipeline.ErrParameterEmpty,
+		},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.JSONQueryTask{
+				BaseTask:     pipeline.NewBaseTask(0, "query", nil, nil, 0),
+				Query:        test.query,
+				Data:         test.data,
+				MaxDataBytes: test.maxDataBytes,
+				Lax:          test.lax,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+				require.Nil(t, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.wantData, result.Value)
+			}
+		})
+	}
+
+	t.Run("does not evaluate once the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(testutils.Context(t))
+		cancel()
+		task := pipeline.JSONQueryTask{
+			BaseTask: pipeline.NewBaseTask(0, "query", nil, nil, 0),
+			Query:    "data.pair",
+		}
+		result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: book}})
+		require.ErrorIs(t, result.Error, context.Canceled)
+		require.Nil(t, result.Value)
+	})
+}
@@ -442,6 +442,10 @@ func (u *URLParam) String() string {
 	return (*url.URL)(u).String()
 }
 
+func (u *URLParam) URL() *url.URL {
+	return (*url.URL)(u)
+}
+
 type AddressParam common.Address
 
 func (a *AddressParam) UnmarshalPipelineParam(val interface{}) error {
@@ -741,6 +745,48 @@ func (p *JSONPathParam) UnmarshalPipelineParam(val interface{}) error {
 	return nil
 }
 
+// JSONParam is a JSON document. Strings and byte slices are expected to already be JSON encoded,
+// any other value is encoded to JSON.
+type JSONParam []byte
+
+func (p *JSONParam) UnmarshalPipelineParam(val interface{}) error {
+	var bs []byte
+	switch v := val.(type) {
+	case string:
+		bs = []byte(v)
+	case []byte:
+		bs = v
+	case ObjectParam:
+		if v.Type == StringType {
+			bs = []byte(v.StringValue)
+			break
+		}
+		return p.marshal(v)
+	case *ObjectParam:
+		if v != nil && v.Type == StringType {
+			bs = []byte(v.StringValue)
+			break
+		}
+		return p.marshal(v)
+	default:
+		return p.marshal(v)
+	}
+	if !json.Valid(bs) {
+		return errors.Wrap(ErrBadInput, "invalid JSON")
+	}
+	*p = bs
+	return nil
+}
+
+func (p *JSONParam) marshal(val interface{}) error {
+	bs, err := json.Marshal(val)
+	if err != nil {
+		return errors.Wrapf(ErrBadInput, "cannot encode %T to JSON: %v", val, err)
+	}
+	*p = bs
+	return nil
+}
+
 type MaybeBigIntParam struct {
 	n *big.Int
 }
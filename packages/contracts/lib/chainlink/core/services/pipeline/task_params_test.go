@@ -669,6 +669,35 @@ func TestJSONPathParam_UnmarshalPipelineParam(t *testing.T) {
 	}
 }
 
+func TestJSONParam_UnmarshalPipelineParam(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected pipeline.JSONParam
+		err      error
+	}{
+		{"string", `{"a":[1,2]}`, pipeline.JSONParam(`{"a":[1,2]}`), nil},
+		{"[]byte", []byte(`[1,2]`), pipeline.JSONParam(`[1,2]`), nil},
+		{"map", map[string]interface{}{"a": "b"}, pipeline.JSONParam(`{"a":"b"}`), nil},
+		{"slice", []interface{}{"a", 1}, pipeline.JSONParam(`["a",1]`), nil},
+		{"string object", pipeline.ObjectParam{Type: pipeline.StringType, StringValue: pipeline.StringParam(`{"a":1}`)}, pipeline.JSONParam(`{"a":1}`), nil},
+		{"nil", nil, pipeline.JSONParam(`null`), nil},
+		{"invalid JSON", `{"a":`, pipeline.JSONParam(nil), pipeline.ErrBadInput},
+		{"unsupported", make(chan int), pipeline.JSONParam(nil), pipeline.ErrBadInput},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var p pipeline.JSONParam
+			err := p.UnmarshalPipelineParam(test.input)
+			require.Equal(t, test.err, errors.Cause(err))
+			require.Equal(t, test.expected, p)
+		})
+	}
+}
+
 func TestResolveValue(t *testing.T) {
 	t.Parallel()
 
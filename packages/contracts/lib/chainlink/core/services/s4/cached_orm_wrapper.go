@@ -85,6 +85,10 @@ func (c CachedORM) GetUnconfirmedRows(ctx context.Context, limit uint) ([]*Row,
 	return c.underlayingORM.GetUnconfirmedRows(ctx, limit)
 }
 
+func (c CachedORM) Watch(address *ubig.Big) (<-chan *Row, func()) {
+	return c.underlayingORM.Watch(address)
+}
+
 // deleteRowFromSnapshotCache will clean the cache for every snapshot that would involve a given row
 // in case of an error parsing a key it will also delete the key from the cache
 func (c CachedORM) deleteRowFromSnapshotCache(row *Row) {
@@ -3,6 +3,7 @@ package s4
 import (
 	"crypto/ecdsa"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -16,12 +17,16 @@ import (
 // A signer is responsible for generating a JSON that has no whitespace and
 // the keys appear in this exact order:
 // {"address":base64,"slotid":int,"payload":base64,"version":int,"expiration":int}
+// Deletions have an empty payload and an extra key, so that a signed record
+// cannot be replayed as a deletion (and vice versa):
+// {"address":base64,"slotid":int,"payload":"","version":int,"expiration":int,"deleted":true}
 type Envelope struct {
 	Address    []byte `json:"address"`
 	SlotID     uint   `json:"slotid"`
 	Payload    []byte `json:"payload"`
 	Version    uint64 `json:"version"`
 	Expiration int64  `json:"expiration"`
+	Deleted    bool   `json:"deleted,omitempty"`
 }
 
 func NewEnvelopeFromRecord(key *Key, record *Record) *Envelope {
@@ -34,6 +39,18 @@ func NewEnvelopeFromRecord(key *Key, record *Record) *Envelope {
 	}
 }
 
+// NewDeleteEnvelope returns the envelope of the deletion of the slot of key.
+// The deletion is kept until expiration, so that it replaces the record on all nodes.
+func NewDeleteEnvelope(key *Key, expiration int64) *Envelope {
+	return &Envelope{
+		Address:    key.Address.Bytes(),
+		SlotID:     key.SlotId,
+		Version:    key.Version,
+		Expiration: expiration,
+		Deleted:    true,
+	}
+}
+
 // Sign calculates signature for the serialized envelope data.
 func (e Envelope) Sign(privateKey *ecdsa.PrivateKey) (signature []byte, err error) {
 	if len(e.Address) != common.AddressLength {
@@ -72,6 +89,13 @@ func (e Envelope) ToJson() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	if e.Deleted {
+		if len(e.Payload) > 0 {
+			return nil, errors.New("deletion must have an empty payload")
+		}
+		js := fmt.Sprintf(`{"address":%s,"slotid":%d,"payload":%s,"version":%d,"expiration":%d,"deleted":true}`, address, e.SlotID, payload, e.Version, e.Expiration)
+		return []byte(js), nil
+	}
 	js := fmt.Sprintf(`{"address":%s,"slotid":%d,"payload":%s,"version":%d,"expiration":%d}`, address, e.SlotID, payload, e.Version, e.Expiration)
 	return []byte(js), nil
 }
@@ -59,3 +59,41 @@ func TestEnvelope(t *testing.T) {
 		assert.Equal(t, *env, decoded)
 	})
 }
+
+func TestEnvelope_Delete(t *testing.T) {
+	t.Parallel()
+
+	privateKey, address := testutils.NewPrivateKeyAndAddress(t)
+	key := &s4.Key{
+		Address: address,
+		SlotId:  3,
+		Version: 6,
+	}
+	expiration := time.Now().Add(time.Hour).UnixMilli()
+	env := s4.NewDeleteEnvelope(key, expiration)
+
+	sig, err := env.Sign(privateKey)
+	assert.NoError(t, err)
+	addr, err := env.GetSignerAddress(sig)
+	assert.NoError(t, err)
+	assert.Equal(t, address, addr)
+
+	// the signature of a deletion does not match the record with the same key and an empty payload
+	recordEnv := s4.NewEnvelopeFromRecord(key, &s4.Record{Expiration: expiration})
+	addr, err = recordEnv.GetSignerAddress(sig)
+	assert.NoError(t, err)
+	assert.NotEqual(t, address, addr)
+
+	js, err := env.ToJson()
+	assert.NoError(t, err)
+	var decoded s4.Envelope
+	assert.NoError(t, json.Unmarshal(js, &decoded))
+	assert.True(t, decoded.Deleted)
+	js2, err := decoded.ToJson()
+	assert.NoError(t, err)
+	assert.Equal(t, js, js2)
+
+	env.Payload = []byte("foo")
+	_, err = env.ToJson()
+	assert.EqualError(t, err, "deletion must have an empty payload")
+}
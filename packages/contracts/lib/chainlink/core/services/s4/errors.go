@@ -10,4 +10,5 @@ var (
 	ErrPastExpiration    = errors.New("past expiration")
 	ErrVersionTooLow     = errors.New("version too low")
 	ErrExpirationTooLong = errors.New("expiration too long")
+	ErrQuotaExceeded     = errors.New("total payload size quota exceeded")
 )
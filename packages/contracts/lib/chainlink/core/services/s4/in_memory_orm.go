@@ -20,8 +20,9 @@ type mrow struct {
 }
 
 type inMemoryOrm struct {
-	rows map[key]*mrow
-	mu   sync.RWMutex
+	rows     map[key]*mrow
+	mu       sync.RWMutex
+	watchers rowWatchers
 }
 
 var _ ORM = (*inMemoryOrm)(nil)
@@ -71,6 +72,11 @@ func (o *inMemoryOrm) Update(ctx context.Context, row *Row) error {
 		Row:       row.Clone(),
 		UpdatedAt: time.Now().UTC(),
 	}
+	var prev *Row
+	if ok {
+		prev = existing.Row
+	}
+	o.watchers.notify(prev, row)
 	return nil
 }
 
@@ -94,20 +100,22 @@ func (o *inMemoryOrm) DeleteExpired(ctx context.Context, limit uint, now time.Ti
 	return int64(len(queue)), nil
 }
 
-func (o *inMemoryOrm) GetSnapshot(ctx context.Context, _ *AddressRange) ([]*SnapshotRow, error) {
+func (o *inMemoryOrm) GetSnapshot(ctx context.Context, addressRange *AddressRange) ([]*SnapshotRow, error) {
 	o.mu.RLock()
 	defer o.mu.RUnlock()
 
 	now := time.Now().UnixMilli()
 	var rows []*SnapshotRow
 	for _, mrow := range o.rows {
-		if mrow.Row.Expiration > now {
+		if mrow.Row.Expiration > now && addressRange.Contains(mrow.Row.Address) {
 			rows = append(rows, &SnapshotRow{
-				Address:    big.New(mrow.Row.Address.ToInt()),
-				SlotId:     mrow.Row.SlotId,
-				Version:    mrow.Row.Version,
-				Expiration: mrow.Row.Expiration,
-				Confirmed:  mrow.Row.Confirmed,
+				Address:     big.New(mrow.Row.Address.ToInt()),
+				SlotId:      mrow.Row.SlotId,
+				Version:     mrow.Row.Version,
+				Expiration:  mrow.Row.Expiration,
+				Confirmed:   mrow.Row.Confirmed,
+				PayloadSize: uint64(len(mrow.Row.Payload)),
+				Deleted:     mrow.Row.Deleted,
 			})
 		}
 	}
@@ -142,3 +150,7 @@ func (o *inMemoryOrm) GetUnconfirmedRows(ctx context.Context, limit uint) ([]*Ro
 
 	return rows, nil
 }
+
+func (o *inMemoryOrm) Watch(address *big.Big) (<-chan *Row, func()) {
+	return o.watchers.watch(address)
+}
@@ -170,3 +170,43 @@ func TestInMemoryORM_GetSnapshot(t *testing.T) {
 		assert.Equal(t, 1, c)
 	}
 }
+
+func TestInMemoryORM_Watch(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	orm := s4.NewInMemoryORM()
+	address := big.New(testutils.NewAddress().Big())
+	row := &s4.Row{
+		Address:    address,
+		SlotId:     1,
+		Payload:    []byte("foo"),
+		Version:    1,
+		Expiration: time.Now().Add(time.Minute).UnixMilli(),
+		Signature:  []byte{},
+	}
+
+	changes, cancel := orm.Watch(address)
+	assert.NoError(t, orm.Update(ctx, row))
+	assert.Empty(t, changes)
+
+	row.Confirmed = true
+	assert.NoError(t, orm.Update(ctx, row))
+	assert.NoError(t, orm.Update(ctx, row))
+	assert.Len(t, changes, 1)
+	assert.Equal(t, row, <-changes)
+
+	snapshot, err := orm.GetSnapshot(ctx, s4.NewFullAddressRange())
+	assert.NoError(t, err)
+	assert.Len(t, snapshot, 1)
+	assert.Equal(t, uint64(3), snapshot[0].PayloadSize)
+	addressRange, err := s4.NewSingleAddressRange(big.New(testutils.NewAddress().Big()))
+	assert.NoError(t, err)
+	snapshot, err = orm.GetSnapshot(ctx, addressRange)
+	assert.NoError(t, err)
+	assert.Empty(t, snapshot)
+
+	cancel()
+	_, ok := <-changes
+	assert.False(t, ok)
+}
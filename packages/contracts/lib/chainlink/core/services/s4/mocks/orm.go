@@ -310,6 +310,66 @@ func (_c *ORM_Update_Call) RunAndReturn(run func(context.Context, *s4.Row) error
 	return _c
 }
 
+// Watch provides a mock function with given fields: address
+func (_m *ORM) Watch(address *big.Big) (<-chan *s4.Row, func()) {
+	ret := _m.Called(address)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Watch")
+	}
+
+	var r0 <-chan *s4.Row
+	var r1 func()
+	if rf, ok := ret.Get(0).(func(*big.Big) (<-chan *s4.Row, func())); ok {
+		return rf(address)
+	}
+	if rf, ok := ret.Get(0).(func(*big.Big) <-chan *s4.Row); ok {
+		r0 = rf(address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan *s4.Row)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*big.Big) func()); ok {
+		r1 = rf(address)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
+
+// ORM_Watch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Watch'
+type ORM_Watch_Call struct {
+	*mock.Call
+}
+
+// Watch is a helper method to define mock.On call
+//   - address *big.Big
+func (_e *ORM_Expecter) Watch(address interface{}) *ORM_Watch_Call {
+	return &ORM_Watch_Call{Call: _e.mock.On("Watch", address)}
+}
+
+func (_c *ORM_Watch_Call) Run(run func(address *big.Big)) *ORM_Watch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*big.Big))
+	})
+	return _c
+}
+
+func (_c *ORM_Watch_Call) Return(_a0 <-chan *s4.Row, _a1 func()) *ORM_Watch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_Watch_Call) RunAndReturn(run func(*big.Big) (<-chan *s4.Row, func())) *ORM_Watch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewORM creates a new instance of ORM. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewORM(t interface {
@@ -70,6 +70,55 @@ func (_c *Storage_Constraints_Call) RunAndReturn(run func() s4.Constraints) *Sto
 	return _c
 }
 
+// Delete provides a mock function with given fields: ctx, key, expiration, signature
+func (_m *Storage) Delete(ctx context.Context, key *s4.Key, expiration int64, signature []byte) error {
+	ret := _m.Called(ctx, key, expiration, signature)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *s4.Key, int64, []byte) error); ok {
+		r0 = rf(ctx, key, expiration, signature)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storage_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type Storage_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key *s4.Key
+//   - expiration int64
+//   - signature []byte
+func (_e *Storage_Expecter) Delete(ctx interface{}, key interface{}, expiration interface{}, signature interface{}) *Storage_Delete_Call {
+	return &Storage_Delete_Call{Call: _e.mock.On("Delete", ctx, key, expiration, signature)}
+}
+
+func (_c *Storage_Delete_Call) Run(run func(ctx context.Context, key *s4.Key, expiration int64, signature []byte)) *Storage_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*s4.Key), args[2].(int64), args[3].([]byte))
+	})
+	return _c
+}
+
+func (_c *Storage_Delete_Call) Return(_a0 error) *Storage_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storage_Delete_Call) RunAndReturn(run func(context.Context, *s4.Key, int64, []byte) error) *Storage_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: ctx, key
 func (_m *Storage) Get(ctx context.Context, key *s4.Key) (*s4.Record, *s4.Metadata, error) {
 	ret := _m.Called(ctx, key)
@@ -246,6 +295,66 @@ func (_c *Storage_Put_Call) RunAndReturn(run func(context.Context, *s4.Key, *s4.
 	return _c
 }
 
+// Watch provides a mock function with given fields: address
+func (_m *Storage) Watch(address common.Address) (<-chan s4.RecordChange, func()) {
+	ret := _m.Called(address)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Watch")
+	}
+
+	var r0 <-chan s4.RecordChange
+	var r1 func()
+	if rf, ok := ret.Get(0).(func(common.Address) (<-chan s4.RecordChange, func())); ok {
+		return rf(address)
+	}
+	if rf, ok := ret.Get(0).(func(common.Address) <-chan s4.RecordChange); ok {
+		r0 = rf(address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan s4.RecordChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(common.Address) func()); ok {
+		r1 = rf(address)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
+
+// Storage_Watch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Watch'
+type Storage_Watch_Call struct {
+	*mock.Call
+}
+
+// Watch is a helper method to define mock.On call
+//   - address common.Address
+func (_e *Storage_Expecter) Watch(address interface{}) *Storage_Watch_Call {
+	return &Storage_Watch_Call{Call: _e.mock.On("Watch", address)}
+}
+
+func (_c *Storage_Watch_Call) Run(run func(address common.Address)) *Storage_Watch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(common.Address))
+	})
+	return _c
+}
+
+func (_c *Storage_Watch_Call) Return(_a0 <-chan s4.RecordChange, _a1 func()) *Storage_Watch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_Watch_Call) RunAndReturn(run func(common.Address) (<-chan s4.RecordChange, func())) *Storage_Watch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewStorage creates a new instance of Storage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewStorage(t interface {
@@ -16,6 +16,9 @@ type Row struct {
 	Expiration int64
 	Confirmed  bool
 	Signature  []byte
+	// Deleted rows keep the version of a deleted record until expiration,
+	// so that the deletion replaces the record on all nodes.
+	Deleted bool
 }
 
 // SnapshotRow(s) are returned by GetSnapshot function.
@@ -26,6 +29,7 @@ type SnapshotRow struct {
 	Expiration  int64
 	Confirmed   bool
 	PayloadSize uint64
+	Deleted     bool
 }
 
 // ORM represents S4 persistence layer.
@@ -54,6 +58,12 @@ type ORM interface {
 	// GetUnconfirmedRows selects all non-expired, non-confirmed rows ordered by UpdatedAt.
 	// The number of returned rows is limited to the given limit.
 	GetUnconfirmedRows(ctx context.Context, limit uint) ([]*Row, error)
+
+	// Watch subscribes to the rows of the given address confirmed by Update,
+	// omitting the confirmations of rows already confirmed with the same version.
+	// The channel is closed by the returned cancel function, or when the subscriber
+	// does not keep up with the confirmations.
+	Watch(address *big.Big) (rows <-chan *Row, cancel func())
 }
 
 func (r Row) Clone() *Row {
@@ -65,6 +75,7 @@ func (r Row) Clone() *Row {
 		Expiration: r.Expiration,
 		Confirmed:  r.Confirmed,
 		Signature:  make([]byte, len(r.Signature)),
+		Deleted:    r.Deleted,
 	}
 	copy(clone.Payload, r.Payload)
 	copy(clone.Signature, r.Signature)
@@ -21,6 +21,7 @@ type orm struct {
 	ds        sqlutil.DataSource
 	tableName string
 	namespace string
+	watchers  *rowWatchers
 }
 
 var _ ORM = (*orm)(nil)
@@ -30,13 +31,14 @@ func NewPostgresORM(ds sqlutil.DataSource, tableName, namespace string) ORM {
 		ds:        ds,
 		tableName: fmt.Sprintf(`"%s".%s`, s4PostgresSchema, tableName),
 		namespace: namespace,
+		watchers:  &rowWatchers{},
 	}
 }
 
 func (o *orm) Get(ctx context.Context, address *big.Big, slotId uint) (*Row, error) {
 	row := &Row{}
 
-	stmt := fmt.Sprintf(`SELECT address, slot_id, version, expiration, confirmed, payload, signature FROM %s 
+	stmt := fmt.Sprintf(`SELECT address, slot_id, version, expiration, confirmed, payload, signature, deleted FROM %s 
 WHERE namespace=$1 AND address=$2 AND slot_id=$3;`, o.tableName)
 	if err := o.ds.GetContext(ctx, row, stmt, o.namespace, address, slotId); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -51,23 +53,38 @@ func (o *orm) Update(ctx context.Context, row *Row) error {
 	// This query inserts or updates a row, depending on whether the version is higher than the existing one.
 	// We only allow the same version when the row is confirmed.
 	// We never transition back from unconfirmed to confirmed state.
-	stmt := fmt.Sprintf(`INSERT INTO %s as t (namespace, address, slot_id, version, expiration, confirmed, payload, signature, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())
+	stmt := fmt.Sprintf(`INSERT INTO %s as t (namespace, address, slot_id, version, expiration, confirmed, payload, signature, deleted, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW())
 ON CONFLICT (namespace, address, slot_id)
 DO UPDATE SET version = EXCLUDED.version,
 expiration = EXCLUDED.expiration,
 confirmed = EXCLUDED.confirmed,
 payload = EXCLUDED.payload,
 signature = EXCLUDED.signature,
+deleted = EXCLUDED.deleted,
 updated_at = NOW()
 WHERE (t.version < EXCLUDED.version) OR (t.version <= EXCLUDED.version AND EXCLUDED.confirmed IS TRUE)
 RETURNING id;`, o.tableName)
+
+	// the previous row is only needed to notify the subscribers of new confirmations
+	var prev *Row
+	if row.Confirmed && o.watchers.watched(row.Address) {
+		var err error
+		if prev, err = o.Get(ctx, row.Address, row.SlotId); err != nil && !errors.Is(err, ErrNotFound) {
+			return err
+		}
+	}
+
 	var id uint64
-	err := o.ds.GetContext(ctx, &id, stmt, o.namespace, row.Address, row.SlotId, row.Version, row.Expiration, row.Confirmed, row.Payload, row.Signature)
+	err := o.ds.GetContext(ctx, &id, stmt, o.namespace, row.Address, row.SlotId, row.Version, row.Expiration, row.Confirmed, row.Payload, row.Signature, row.Deleted)
 	if errors.Is(err, sql.ErrNoRows) {
 		return ErrVersionTooLow
 	}
-	return err
+	if err != nil {
+		return err
+	}
+	o.watchers.notify(prev, row)
+	return nil
 }
 
 func (o *orm) DeleteExpired(ctx context.Context, limit uint, utcNow time.Time) (int64, error) {
@@ -83,7 +100,7 @@ func (o *orm) DeleteExpired(ctx context.Context, limit uint, utcNow time.Time) (
 func (o *orm) GetSnapshot(ctx context.Context, addressRange *AddressRange) ([]*SnapshotRow, error) {
 	rows := make([]*SnapshotRow, 0)
 
-	stmt := fmt.Sprintf(`SELECT address, slot_id, version, expiration, confirmed, octet_length(payload) AS payload_size FROM %s WHERE namespace = $1 AND address >= $2 AND address <= $3;`, o.tableName)
+	stmt := fmt.Sprintf(`SELECT address, slot_id, version, expiration, confirmed, octet_length(payload) AS payload_size, deleted FROM %s WHERE namespace = $1 AND address >= $2 AND address <= $3;`, o.tableName)
 	if err := o.ds.SelectContext(ctx, &rows, stmt, o.namespace, addressRange.MinAddress, addressRange.MaxAddress); err != nil {
 		if !errors.Is(err, sql.ErrNoRows) {
 			return nil, err
@@ -95,7 +112,7 @@ func (o *orm) GetSnapshot(ctx context.Context, addressRange *AddressRange) ([]*S
 func (o *orm) GetUnconfirmedRows(ctx context.Context, limit uint) ([]*Row, error) {
 	rows := make([]*Row, 0)
 
-	stmt := fmt.Sprintf(`SELECT address, slot_id, version, expiration, confirmed, payload, signature FROM %s
+	stmt := fmt.Sprintf(`SELECT address, slot_id, version, expiration, confirmed, payload, signature, deleted FROM %s
 WHERE namespace = $1 AND confirmed IS FALSE ORDER BY updated_at LIMIT $2;`, o.tableName)
 	if err := o.ds.SelectContext(ctx, &rows, stmt, o.namespace, limit); err != nil {
 		if !errors.Is(err, sql.ErrNoRows) {
@@ -104,3 +121,7 @@ WHERE namespace = $1 AND confirmed IS FALSE ORDER BY updated_at LIMIT $2;`, o.ta
 	}
 	return rows, nil
 }
+
+func (o *orm) Watch(address *big.Big) (<-chan *Row, func()) {
+	return o.watchers.watch(address)
+}
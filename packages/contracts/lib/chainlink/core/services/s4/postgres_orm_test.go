@@ -13,6 +13,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/s4"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func setupORM(t *testing.T, namespace string) s4.ORM {
@@ -285,3 +286,46 @@ func TestPostgresORM_BigIntVersion(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, row, gotRow)
 }
+
+func TestPostgresORM_Watch(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	orm := setupORM(t, "test")
+	rows := generateTestRows(t, 2)
+	row, other := rows[0], rows[1]
+	row.Confirmed = false
+
+	changes, cancel := orm.Watch(row.Address)
+
+	// unconfirmed rows and rows of other addresses are not emitted
+	assert.NoError(t, orm.Update(ctx, row))
+	other.Confirmed = true
+	assert.NoError(t, orm.Update(ctx, other))
+	assert.Empty(t, changes)
+
+	row.Confirmed = true
+	assert.NoError(t, orm.Update(ctx, row))
+	// confirming the same version again is not a change
+	assert.NoError(t, orm.Update(ctx, row))
+	require.Len(t, changes, 1)
+	assert.Equal(t, row, <-changes)
+
+	// deletions are kept as rows
+	row.Version++
+	row.Payload = []byte{}
+	row.Deleted = true
+	assert.NoError(t, orm.Update(ctx, row))
+	gotRow, err := orm.Get(ctx, row.Address, row.SlotId)
+	assert.NoError(t, err)
+	assert.True(t, gotRow.Deleted)
+	snapshot, err := orm.GetSnapshot(ctx, s4.NewFullAddressRange())
+	assert.NoError(t, err)
+	for _, sr := range snapshot {
+		assert.Equal(t, sr.Address.Cmp(row.Address) == 0, sr.Deleted)
+	}
+
+	cancel()
+	_, ok := <-changes
+	assert.False(t, ok)
+}
@@ -16,6 +16,9 @@ type Constraints struct {
 	MaxPayloadSizeBytes    uint   `json:"maxPayloadSizeBytes"`
 	MaxSlotsPerUser        uint   `json:"maxSlotsPerUser"`
 	MaxExpirationLengthSec uint64 `json:"maxExpirationLengthSec"`
+	// MaxTotalBytesPerUser limits the sum of the payload sizes of all non-expired
+	// records of a user. Zero means no limit.
+	MaxTotalBytesPerUser uint64 `json:"maxTotalBytesPerUser"`
 }
 
 // Key identifies a versioned user record.
@@ -44,6 +47,15 @@ type Metadata struct {
 	Signature []byte
 }
 
+// RecordChange is a confirmed change of a user record, emitted by Storage.Watch.
+type RecordChange struct {
+	Key Key
+	// Record is nil when the record was deleted.
+	Record *Record
+	// Signature contains the original user signature of the record or deletion.
+	Signature []byte
+}
+
 // Storage represents S4 storage access interface.
 // All functions are thread-safe.
 type Storage interface {
@@ -59,9 +71,20 @@ type Storage interface {
 	// For signature calculation see envelope.go
 	Put(ctx context.Context, key *Key, record *Record, signature []byte) error
 
+	// Delete deletes the record identified by the specified key, before its expiration.
+	// The key version must be greater than the version of the record, and the deletion
+	// is kept until the given expiration, so that it replaces the record on all nodes.
+	// For signature calculation see NewDeleteEnvelope in envelope.go
+	Delete(ctx context.Context, key *Key, expiration int64, signature []byte) error
+
 	// List returns a snapshot for the specified address.
-	// Slots having no data are not returned.
+	// Slots having no data, or deleted, are not returned.
 	List(ctx context.Context, address common.Address) ([]*SnapshotRow, error)
+
+	// Watch subscribes to the confirmed changes of the records of the specified address.
+	// The channel is closed by the returned cancel function, or when the subscriber does
+	// not keep up with the changes, in which case List should be used to catch up.
+	Watch(address common.Address) (changes <-chan RecordChange, cancel func())
 }
 
 type storage struct {
@@ -97,7 +120,7 @@ func (s *storage) Get(ctx context.Context, key *Key) (*Record, *Metadata, error)
 		return nil, nil, err
 	}
 
-	if row.Version != key.Version || row.Expiration <= s.clock.Now().UnixMilli() {
+	if row.Version != key.Version || row.Expiration <= s.clock.Now().UnixMilli() || row.Deleted {
 		return nil, nil, ErrNotFound
 	}
 
@@ -122,7 +145,17 @@ func (s *storage) List(ctx context.Context, address common.Address) ([]*Snapshot
 	if err != nil {
 		return nil, err
 	}
-	return s.orm.GetSnapshot(ctx, sar)
+	snapshot, err := s.orm.GetSnapshot(ctx, sar)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]*SnapshotRow, 0, len(snapshot))
+	for _, row := range snapshot {
+		if !row.Deleted {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
 }
 
 func (s *storage) Put(ctx context.Context, key *Key, record *Record, signature []byte) error {
@@ -132,12 +165,8 @@ func (s *storage) Put(ctx context.Context, key *Key, record *Record, signature [
 	if len(record.Payload) > int(s.contraints.MaxPayloadSizeBytes) {
 		return ErrPayloadTooBig
 	}
-	now := s.clock.Now().UnixMilli()
-	if now > record.Expiration {
-		return ErrPastExpiration
-	}
-	if record.Expiration-now > int64(s.contraints.MaxExpirationLengthSec)*1000 {
-		return ErrExpirationTooLong
+	if err := s.checkExpiration(record.Expiration); err != nil {
+		return err
 	}
 
 	envelope := NewEnvelopeFromRecord(key, record)
@@ -145,6 +174,9 @@ func (s *storage) Put(ctx context.Context, key *Key, record *Record, signature [
 	if err != nil || signer != key.Address {
 		return ErrWrongSignature
 	}
+	if err = s.checkQuota(ctx, key, uint64(len(record.Payload))); err != nil {
+		return err
+	}
 
 	row := &Row{
 		Address:    big.New(key.Address.Big()),
@@ -160,3 +192,99 @@ func (s *storage) Put(ctx context.Context, key *Key, record *Record, signature [
 
 	return s.orm.Update(ctx, row)
 }
+
+func (s *storage) Delete(ctx context.Context, key *Key, expiration int64, signature []byte) error {
+	if key.SlotId >= s.contraints.MaxSlotsPerUser {
+		return ErrSlotIdTooBig
+	}
+	if err := s.checkExpiration(expiration); err != nil {
+		return err
+	}
+
+	envelope := NewDeleteEnvelope(key, expiration)
+	signer, err := envelope.GetSignerAddress(signature)
+	if err != nil || signer != key.Address {
+		return ErrWrongSignature
+	}
+
+	row := &Row{
+		Address:    big.New(key.Address.Big()),
+		SlotId:     key.SlotId,
+		Payload:    []byte{},
+		Version:    key.Version,
+		Expiration: expiration,
+		Confirmed:  false,
+		Signature:  make([]byte, len(signature)),
+		Deleted:    true,
+	}
+	copy(row.Signature, signature)
+
+	return s.orm.Update(ctx, row)
+}
+
+func (s *storage) Watch(address common.Address) (<-chan RecordChange, func()) {
+	rows, cancel := s.orm.Watch(big.New(address.Big()))
+	changes := make(chan RecordChange, watchChannelSize)
+	go func() {
+		defer close(changes)
+		for row := range rows {
+			change := RecordChange{
+				Key: Key{
+					Address: address,
+					SlotId:  row.SlotId,
+					Version: row.Version,
+				},
+				Signature: row.Signature,
+			}
+			if !row.Deleted {
+				change.Record = &Record{
+					Payload:    row.Payload,
+					Expiration: row.Expiration,
+				}
+			}
+			select {
+			case changes <- change:
+			default:
+				s.lggr.Warnw("Watch subscriber does not keep up with the changes, cancelling", "address", address)
+				cancel()
+				return
+			}
+		}
+	}()
+	return changes, cancel
+}
+
+func (s *storage) checkExpiration(expiration int64) error {
+	now := s.clock.Now().UnixMilli()
+	if now > expiration {
+		return ErrPastExpiration
+	}
+	if expiration-now > int64(s.contraints.MaxExpirationLengthSec)*1000 {
+		return ErrExpirationTooLong
+	}
+	return nil
+}
+
+// checkQuota returns ErrQuotaExceeded if storing payloadSize bytes in the slot of key
+// exceeds the MaxTotalBytesPerUser of its address.
+func (s *storage) checkQuota(ctx context.Context, key *Key, payloadSize uint64) error {
+	if s.contraints.MaxTotalBytesPerUser == 0 {
+		return nil
+	}
+	snapshot, err := s.List(ctx, key.Address)
+	if err != nil {
+		return err
+	}
+	total := payloadSize
+	now := s.clock.Now().UnixMilli()
+	for _, row := range snapshot {
+		// the record being replaced does not count towards the quota
+		if row.SlotId != key.SlotId && row.Expiration > now {
+			total += row.PayloadSize
+		}
+	}
+	if total > s.contraints.MaxTotalBytesPerUser {
+		return ErrQuotaExceeded
+	}
+	return nil
+}
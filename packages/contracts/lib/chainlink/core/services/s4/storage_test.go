@@ -235,3 +235,113 @@ func TestStorage_List(t *testing.T) {
 		}
 	}
 }
+
+func TestStorage_Delete(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	ormMock, storage := setupTestStorage(t, now)
+
+	privateKey, address := testutils.NewPrivateKeyAndAddress(t)
+	key := &s4.Key{
+		Address: address,
+		SlotId:  2,
+		Version: 3,
+	}
+	expiration := now.Add(time.Hour).UnixMilli()
+	signature, err := s4.NewDeleteEnvelope(key, expiration).Sign(privateKey)
+	require.NoError(t, err)
+
+	t.Run("ErrWrongSignature", func(t *testing.T) {
+		// the signature of a record with an empty payload is not a deletion
+		recordSignature, err := s4.NewEnvelopeFromRecord(key, &s4.Record{Expiration: expiration}).Sign(privateKey)
+		require.NoError(t, err)
+		err = storage.Delete(testutils.Context(t), key, expiration, recordSignature)
+		assert.ErrorIs(t, err, s4.ErrWrongSignature)
+	})
+
+	t.Run("ErrPastExpiration", func(t *testing.T) {
+		err := storage.Delete(testutils.Context(t), key, now.UnixMilli()-1, signature)
+		assert.ErrorIs(t, err, s4.ErrPastExpiration)
+	})
+
+	t.Run("deleted", func(t *testing.T) {
+		ormMock.On("Update", mock.Anything, mock.MatchedBy(func(row *s4.Row) bool {
+			return row.Deleted && !row.Confirmed && len(row.Payload) == 0 && row.Version == key.Version && row.Expiration == expiration
+		})).Return(nil).Once()
+		require.NoError(t, storage.Delete(testutils.Context(t), key, expiration, signature))
+
+		ormMock.On("Get", mock.Anything, big.New(key.Address.Big()), key.SlotId).Return(&s4.Row{
+			Address:    big.New(key.Address.Big()),
+			SlotId:     key.SlotId,
+			Version:    key.Version,
+			Expiration: expiration,
+			Signature:  signature,
+			Deleted:    true,
+		}, nil).Once()
+		_, _, err := storage.Get(testutils.Context(t), key)
+		assert.ErrorIs(t, err, s4.ErrNotFound)
+	})
+}
+
+func TestStorage_Quota(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	quotaConstraints := constraints
+	quotaConstraints.MaxTotalBytesPerUser = 50
+	ormMock := mocks.NewORM(t)
+	storage := s4.NewStorage(logger.TestLogger(t), quotaConstraints, ormMock, clockwork.NewFakeClockAt(now))
+
+	privateKey, address := testutils.NewPrivateKeyAndAddress(t)
+	addressRange, err := s4.NewSingleAddressRange(big.New(address.Big()))
+	require.NoError(t, err)
+	expiration := now.Add(time.Hour).UnixMilli()
+	ormMock.On("GetSnapshot", mock.Anything, addressRange).Return([]*s4.SnapshotRow{
+		{SlotId: 0, Expiration: expiration, PayloadSize: 20},
+		{SlotId: 1, Expiration: expiration, PayloadSize: 20},
+		// expired and deleted records do not count towards the quota
+		{SlotId: 2, Expiration: now.UnixMilli() - 1, PayloadSize: 20},
+		{SlotId: 3, Expiration: expiration, Deleted: true},
+	}, nil)
+
+	put := func(slotID uint, size int) error {
+		key := &s4.Key{Address: address, SlotId: slotID}
+		record := &s4.Record{Payload: make([]byte, size), Expiration: expiration}
+		signature, err := s4.NewEnvelopeFromRecord(key, record).Sign(privateKey)
+		require.NoError(t, err)
+		return storage.Put(testutils.Context(t), key, record, signature)
+	}
+
+	assert.ErrorIs(t, put(3, 11), s4.ErrQuotaExceeded)
+	// the replaced record does not count towards the quota
+	ormMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+	assert.NoError(t, put(1, 30))
+	assert.NoError(t, put(3, 10))
+}
+
+func TestStorage_Watch(t *testing.T) {
+	t.Parallel()
+
+	ormMock, storage := setupTestStorage(t, time.Now())
+	address := testutils.NewAddress()
+	rows := make(chan *s4.Row, 2)
+	ormMock.On("Watch", big.New(address.Big())).Return((<-chan *s4.Row)(rows), func() { close(rows) })
+
+	changes, cancel := storage.Watch(address)
+	rows <- &s4.Row{Address: big.New(address.Big()), SlotId: 1, Version: 2, Payload: []byte("foo"), Expiration: 3, Confirmed: true, Signature: []byte("sig")}
+	rows <- &s4.Row{Address: big.New(address.Big()), SlotId: 2, Version: 4, Payload: []byte{}, Expiration: 5, Confirmed: true, Deleted: true}
+
+	change := <-changes
+	assert.Equal(t, s4.Key{Address: address, SlotId: 1, Version: 2}, change.Key)
+	assert.Equal(t, &s4.Record{Payload: []byte("foo"), Expiration: 3}, change.Record)
+	assert.Equal(t, []byte("sig"), change.Signature)
+
+	change = <-changes
+	assert.Equal(t, s4.Key{Address: address, SlotId: 2, Version: 4}, change.Key)
+	assert.Nil(t, change.Record)
+
+	cancel()
+	_, ok := <-changes
+	assert.False(t, ok)
+}
@@ -0,0 +1,83 @@
+package s4
+
+import (
+	"sync"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+)
+
+// watchChannelSize is the number of confirmed rows buffered for each subscriber.
+const watchChannelSize = 100
+
+// rowWatchers dispatches the confirmed rows to the subscribers of their address.
+// It is shared by the ORM implementations.
+type rowWatchers struct {
+	mu       sync.Mutex
+	nextID   uint64
+	watchers map[string]map[uint64]chan *Row
+}
+
+func (w *rowWatchers) watch(address *big.Big) (<-chan *Row, func()) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.watchers == nil {
+		w.watchers = make(map[string]map[uint64]chan *Row)
+	}
+	addr := address.Hex()
+	if w.watchers[addr] == nil {
+		w.watchers[addr] = make(map[uint64]chan *Row)
+	}
+	id := w.nextID
+	w.nextID++
+	ch := make(chan *Row, watchChannelSize)
+	w.watchers[addr][id] = ch
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			w.mu.Lock()
+			defer w.mu.Unlock()
+			w.removeLocked(addr, id)
+		})
+	}
+}
+
+// watched returns true if the address has subscribers.
+func (w *rowWatchers) watched(address *big.Big) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.watchers[address.Hex()]) > 0
+}
+
+// notify sends row to the subscribers of its address, if it is a new confirmation of prev.
+// Subscribers having a full channel are unsubscribed.
+func (w *rowWatchers) notify(prev, row *Row) {
+	if !row.Confirmed || (prev != nil && prev.Confirmed && prev.Version == row.Version) {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	addr := row.Address.Hex()
+	for id, ch := range w.watchers[addr] {
+		select {
+		case ch <- row.Clone():
+		default:
+			w.removeLocked(addr, id)
+		}
+	}
+}
+
+func (w *rowWatchers) removeLocked(addr string, id uint64) {
+	ch, ok := w.watchers[addr][id]
+	if !ok {
+		return
+	}
+	close(ch)
+	delete(w.watchers[addr], id)
+	if len(w.watchers[addr]) == 0 {
+		delete(w.watchers, addr)
+	}
+}
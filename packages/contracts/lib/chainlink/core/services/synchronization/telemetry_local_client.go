@@ -0,0 +1,265 @@
+package synchronization
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+type telemetryLocalClient struct {
+	services.Service
+	eng *services.Engine
+
+	network string
+	chainID string
+	cfg     config.TelemetryLocalSink
+
+	file   *lumberjack.Logger
+	server *grpc.Server
+
+	bufferSize       uint
+	dropMessageCount atomic.Uint32
+	chTelemetry      chan TelemPayload
+
+	subsMu sync.Mutex
+	subsID uint64
+	subs   map[uint64]chan *structpb.Struct
+}
+
+// NewTelemetryLocalClient returns a client that writes decoded telemetry to rotating JSONL files in the directory of
+// cfg, and streams it over gRPC to the clients connected to the listen address of cfg, instead of sending it to an
+// ingress server.
+func NewTelemetryLocalClient(network string, chainID string, cfg config.TelemetryLocalSink, lggr logger.Logger, telemBufferSize uint) TelemetryService {
+	c := &telemetryLocalClient{
+		network:     network,
+		chainID:     chainID,
+		cfg:         cfg,
+		bufferSize:  telemBufferSize,
+		chTelemetry: make(chan TelemPayload, telemBufferSize),
+		subs:        map[uint64]chan *structpb.Struct{},
+	}
+	c.Service, c.eng = services.Config{
+		Name:  "TelemetryLocalClient",
+		Start: c.start,
+		Close: c.close,
+	}.NewServiceEngine(lggr)
+	return c
+}
+
+func (tc *telemetryLocalClient) start(context.Context) error {
+	if dir := tc.cfg.Dir(); dir != "" {
+		if err := utils.EnsureDirAndMaxPerms(dir, 0700); err != nil {
+			return errors.Wrapf(err, "failed to create telemetry directory %s", dir)
+		}
+		maxSizeMB := int(tc.cfg.MaxFileSize() / utils.MB) //nolint:gosec // file sizes are small
+		if maxSizeMB < 1 {
+			maxSizeMB = 1
+		}
+		tc.file = &lumberjack.Logger{
+			Filename:   filepath.Join(dir, localTelemetryFileName(tc.network, tc.chainID)),
+			MaxSize:    maxSizeMB,
+			MaxBackups: int(tc.cfg.MaxFiles()),
+		}
+	}
+
+	if addr := tc.cfg.ListenAddr(); addr != "" {
+		lis, err := net.Listen("tcp", addr)
+		if err != nil {
+			return errors.Wrapf(err, "failed to listen for telemetry stream clients on %s", addr)
+		}
+		opts, err := tc.serverOptions(addr)
+		if err != nil {
+			return multierr.Combine(err, lis.Close())
+		}
+		tc.server = grpc.NewServer(opts...)
+		tc.server.RegisterService(&localTelemetryServiceDesc, tc)
+		tc.eng.Infow("Streaming telemetry over gRPC", "addr", lis.Addr().String())
+		tc.eng.Go(func(context.Context) {
+			if err := tc.server.Serve(lis); err != nil {
+				tc.eng.Errorw("Telemetry stream server stopped", "err", err)
+			}
+		})
+	}
+
+	tc.eng.Go(tc.handleTelemetry)
+	return nil
+}
+
+// serverOptions serves the telemetry stream over mutual TLS if configured. Otherwise, the stream is not authenticated,
+// so it is only served on a loopback address.
+func (tc *telemetryLocalClient) serverOptions(addr string) ([]grpc.ServerOption, error) {
+	certPath, keyPath, clientCAPath := tc.cfg.TLSCertPath(), tc.cfg.TLSKeyPath(), tc.cfg.TLSClientCACertPath()
+	if certPath == "" && keyPath == "" && clientCAPath == "" {
+		if host, _, err := net.SplitHostPort(addr); err != nil || !isLoopbackHost(host) {
+			return nil, errors.Errorf("telemetry stream address %s must be a loopback address unless mutual TLS is configured", addr)
+		}
+		return nil, nil
+	}
+	cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load telemetry stream certificate")
+	}
+	caCert, err := os.ReadFile(clientCAPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read telemetry stream client CA certificate")
+	}
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caCert) {
+		return nil, errors.Errorf("no PEM certificate found in %s", clientCAPath)
+	}
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}))}, nil
+}
+
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func (tc *telemetryLocalClient) close() (err error) {
+	if tc.server != nil {
+		tc.server.Stop()
+	}
+	if tc.file != nil {
+		err = tc.file.Close()
+	}
+	return
+}
+
+func (tc *telemetryLocalClient) handleTelemetry(ctx context.Context) {
+	for {
+		select {
+		case p := <-tc.chTelemetry:
+			record := newLocalTelemetryRecord(time.Now(), tc.network, tc.chainID, p)
+			line, err := json.Marshal(record)
+			if err != nil {
+				tc.eng.Errorw("Could not encode telemetry", "contractID", p.ContractID, "telemetryType", p.TelemType, "err", err)
+				continue
+			}
+			if tc.file != nil {
+				if _, err = tc.file.Write(append(line, '\n')); err != nil {
+					tc.eng.Errorw("Could not write telemetry", "file", tc.file.Filename, "err", err)
+				}
+			}
+			tc.broadcast(line)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// broadcast sends line to the connected stream clients. Clients that do not keep up miss telemetry.
+func (tc *telemetryLocalClient) broadcast(line []byte) {
+	tc.subsMu.Lock()
+	defer tc.subsMu.Unlock()
+	if len(tc.subs) == 0 {
+		return
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(line, &fields); err != nil {
+		tc.eng.Errorw("Could not decode telemetry for stream clients", "err", err)
+		return
+	}
+	msg, err := structpb.NewStruct(fields)
+	if err != nil {
+		tc.eng.Errorw("Could not encode telemetry for stream clients", "err", err)
+		return
+	}
+	for id, ch := range tc.subs {
+		select {
+		case ch <- msg:
+		default:
+			tc.eng.Warnw("Telemetry stream client is too slow, dropping message", "client", id)
+		}
+	}
+}
+
+func (tc *telemetryLocalClient) subscribe() (<-chan *structpb.Struct, func()) {
+	tc.subsMu.Lock()
+	defer tc.subsMu.Unlock()
+	tc.subsID++
+	id := tc.subsID
+	ch := make(chan *structpb.Struct, tc.bufferSize)
+	tc.subs[id] = ch
+	return ch, func() {
+		tc.subsMu.Lock()
+		delete(tc.subs, id)
+		tc.subsMu.Unlock()
+	}
+}
+
+// streamTelemetry serves a client of the telemetry stream until it disconnects or the client is closed.
+func (tc *telemetryLocalClient) streamTelemetry(stream grpc.ServerStream) error {
+	ch, unsubscribe := tc.subscribe()
+	defer unsubscribe()
+	for {
+		select {
+		case msg := <-ch:
+			if err := stream.SendMsg(msg); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// logBufferFullWithExpBackoff logs messages at 1, 2, 4, 8, 16, 32, 64, 100, 200, 300, etc.
+func (tc *telemetryLocalClient) logBufferFullWithExpBackoff(payload TelemPayload) {
+	count := tc.dropMessageCount.Add(1)
+	if count > 0 && (count%100 == 0 || count&(count-1) == 0) {
+		tc.eng.Warnw("telemetry local client buffer full, dropping message", "telemetryType", payload.TelemType, "droppedCount", count)
+	}
+}
+
+// Send stores telemetry in a buffer to be written by the worker, throwing away messages once the buffer is full.
+func (tc *telemetryLocalClient) Send(ctx context.Context, telemData []byte, contractID string, telemType TelemetryType) {
+	payload := TelemPayload{
+		Telemetry:  telemData,
+		TelemType:  telemType,
+		ContractID: contractID,
+	}
+
+	select {
+	case tc.chTelemetry <- payload:
+		tc.dropMessageCount.Store(0)
+	case <-ctx.Done():
+		return
+	default:
+		tc.logBufferFullWithExpBackoff(payload)
+	}
+}
+
+func localTelemetryFileName(network string, chainID string) string {
+	return fmt.Sprintf("telemetry_%s_%s.jsonl", strings.ToLower(network), strings.ToLower(chainID))
+}
@@ -0,0 +1,283 @@
+package synchronization_test
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/freeport"
+	// link the libocr telemetry messages
+	_ "github.com/smartcontractkit/libocr/offchainreporting"
+	_ "github.com/smartcontractkit/libocr/offchainreporting2plus"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization"
+	telemPb "github.com/smartcontractkit/chainlink/v2/core/services/synchronization/telem"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+type localSink struct {
+	dir          string
+	listenAddr   string
+	certPath     string
+	keyPath      string
+	clientCAPath string
+}
+
+func (l localSink) Dir() string                 { return l.dir }
+func (l localSink) MaxFileSize() utils.FileSize { return utils.MB }
+func (l localSink) MaxFiles() int64             { return 1 }
+func (l localSink) ListenAddr() string          { return l.listenAddr }
+func (l localSink) TLSCertPath() string         { return l.certPath }
+func (l localSink) TLSKeyPath() string          { return l.keyPath }
+func (l localSink) TLSClientCACertPath() string { return l.clientCAPath }
+
+func enhancedEATelemetry(t *testing.T) []byte {
+	b, err := proto.Marshal(&telemPb.EnhancedEA{DataSource: "data-source", Value: 1.5, Feed: "feed"})
+	require.NoError(t, err)
+	return b
+}
+
+func TestTelemetryLocalClient_File(t *testing.T) {
+	dir := t.TempDir()
+	client := synchronization.NewTelemetryLocalClient("EVM", "1", localSink{dir: dir}, logger.TestLogger(t), 100)
+	servicetest.Run(t, client)
+
+	ctx := testutils.Context(t)
+	client.Send(ctx, enhancedEATelemetry(t), "0xa", synchronization.EnhancedEA)
+	client.Send(ctx, []byte("101010"), "0xb", synchronization.OCR)
+
+	path := filepath.Join(dir, "telemetry_evm_1.jsonl")
+	var lines []map[string]interface{}
+	require.Eventually(t, func() bool {
+		f, err := os.Open(path)
+		if err != nil {
+			return false
+		}
+		defer f.Close()
+		lines = nil
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			var line map[string]interface{}
+			if err = json.Unmarshal(scanner.Bytes(), &line); err != nil {
+				return false
+			}
+			lines = append(lines, line)
+		}
+		return len(lines) == 2
+	}, testutils.WaitTimeout(t), testutils.TestInterval)
+
+	assert.Equal(t, "EVM", lines[0]["network"])
+	assert.Equal(t, "1", lines[0]["chainID"])
+	assert.Equal(t, "0xa", lines[0]["contractID"])
+	assert.Equal(t, string(synchronization.EnhancedEA), lines[0]["telemetryType"])
+	assert.Equal(t, map[string]interface{}{"data_source": "data-source", "value": 1.5, "feed": "feed"}, lines[0]["message"])
+	assert.NotContains(t, lines[0], "raw")
+
+	assert.Equal(t, string(synchronization.OCR), lines[1]["telemetryType"])
+	assert.Equal(t, "MTAxMDEw", lines[1]["raw"])
+	assert.NotContains(t, lines[1], "message")
+}
+
+func TestTelemetryLocalClient_File_OCR(t *testing.T) {
+	dir := t.TempDir()
+	client := synchronization.NewTelemetryLocalClient("EVM", "1", localSink{dir: dir}, logger.TestLogger(t), 100)
+	servicetest.Run(t, client)
+
+	ctx := testutils.Context(t)
+	telemTypes := []synchronization.TelemetryType{synchronization.OCR, synchronization.OCR2Median, synchronization.OCR3Mercury}
+	for _, telemType := range telemTypes {
+		// an empty TelemetryWrapper
+		client.Send(ctx, []byte{}, "0xa", telemType)
+	}
+
+	path := filepath.Join(dir, "telemetry_evm_1.jsonl")
+	var lines []map[string]interface{}
+	require.Eventually(t, func() bool {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return false
+		}
+		lines = nil
+		scanner := bufio.NewScanner(bytes.NewReader(b))
+		for scanner.Scan() {
+			var line map[string]interface{}
+			if err = json.Unmarshal(scanner.Bytes(), &line); err != nil {
+				return false
+			}
+			lines = append(lines, line)
+		}
+		return len(lines) == len(telemTypes)
+	}, testutils.WaitTimeout(t), testutils.TestInterval)
+
+	for i, telemType := range telemTypes {
+		assert.Equal(t, string(telemType), lines[i]["telemetryType"])
+		assert.Equal(t, map[string]interface{}{}, lines[i]["message"], telemType)
+		assert.NotContains(t, lines[i], "raw", telemType)
+	}
+}
+
+func TestTelemetryLocalClient_Stream(t *testing.T) {
+	addr := fmt.Sprintf("127.0.0.1:%d", freeport.GetOne(t))
+	client := synchronization.NewTelemetryLocalClient("EVM", "1", localSink{listenAddr: addr}, logger.TestLogger(t), 100)
+	servicetest.Run(t, client)
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, conn.Close()) })
+
+	fields := receiveTelemetry(t, client, conn)
+	assert.Equal(t, "0xa", fields["contractID"])
+	assert.Equal(t, string(synchronization.EnhancedEA), fields["telemetryType"])
+	assert.Equal(t, "data-source", fields["message"].(map[string]interface{})["data_source"])
+}
+
+func TestTelemetryLocalClient_Stream_TLS(t *testing.T) {
+	ca := newTestCA(t, "ca")
+	serverCert, serverKey := ca.issue(t, "server", x509.ExtKeyUsageServerAuth)
+	clientCert, clientKey := ca.issue(t, "client", x509.ExtKeyUsageClientAuth)
+	addr := fmt.Sprintf("127.0.0.1:%d", freeport.GetOne(t))
+	sink := localSink{listenAddr: addr, certPath: serverCert, keyPath: serverKey, clientCAPath: ca.certPath}
+	client := synchronization.NewTelemetryLocalClient("EVM", "1", sink, logger.TestLogger(t), 100)
+	servicetest.Run(t, client)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+
+	t.Run("rejects clients without a certificate", func(t *testing.T) {
+		creds := credentials.NewTLS(&tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12})
+		conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, conn.Close()) })
+		stream, err := conn.NewStream(testutils.Context(t), &synchronization.LocalTelemetryStreamDesc, synchronization.LocalTelemetryStreamMethod)
+		if err == nil {
+			err = stream.RecvMsg(new(structpb.Struct))
+		}
+		require.Error(t, err)
+	})
+
+	t.Run("streams to clients with a certificate", func(t *testing.T) {
+		cert, err := tls.LoadX509KeyPair(clientCert, clientKey)
+		require.NoError(t, err)
+		creds := credentials.NewTLS(&tls.Config{Certificates: []tls.Certificate{cert}, RootCAs: roots, MinVersion: tls.VersionTLS12})
+		conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, conn.Close()) })
+
+		fields := receiveTelemetry(t, client, conn)
+		assert.Equal(t, string(synchronization.EnhancedEA), fields["telemetryType"])
+	})
+}
+
+func TestTelemetryLocalClient_Stream_RequiresLoopback(t *testing.T) {
+	addr := fmt.Sprintf("0.0.0.0:%d", freeport.GetOne(t))
+	client := synchronization.NewTelemetryLocalClient("EVM", "1", localSink{listenAddr: addr}, logger.TestLogger(t), 100)
+	err := client.Start(testutils.Context(t))
+	require.ErrorContains(t, err, "must be a loopback address unless mutual TLS is configured")
+}
+
+// receiveTelemetry connects to the telemetry stream of client over conn, and returns the first telemetry received.
+func receiveTelemetry(t *testing.T, client synchronization.TelemetryService, conn *grpc.ClientConn) map[string]interface{} {
+	ctx := testutils.Context(t)
+	stream, err := conn.NewStream(ctx, &synchronization.LocalTelemetryStreamDesc, synchronization.LocalTelemetryStreamMethod)
+	require.NoError(t, err)
+	require.NoError(t, stream.SendMsg(&emptypb.Empty{}))
+	require.NoError(t, stream.CloseSend())
+
+	// The client subscribes asynchronously, so keep sending until telemetry is received
+	received := make(chan *structpb.Struct, 1)
+	go func() {
+		msg := new(structpb.Struct)
+		if stream.RecvMsg(msg) == nil {
+			received <- msg
+		}
+	}()
+	telemetry := enhancedEATelemetry(t)
+	var msg *structpb.Struct
+	require.Eventually(t, func() bool {
+		client.Send(ctx, telemetry, "0xa", synchronization.EnhancedEA)
+		select {
+		case msg = <-received:
+			return true
+		default:
+			return false
+		}
+	}, testutils.WaitTimeout(t), testutils.TestInterval)
+	return msg.AsMap()
+}
+
+type testCA struct {
+	cert     *x509.Certificate
+	key      *ecdsa.PrivateKey
+	certPath string
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key, certPath: writePEM(t, name+".crt", "CERTIFICATE", der)}
+}
+
+// issue returns the paths of a new certificate, valid for the loopback address, and its key.
+func (ca *testCA) issue(t *testing.T, name string, usage x509.ExtKeyUsage) (certPath, keyPath string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return writePEM(t, name+".crt", "CERTIFICATE", der), writePEM(t, name+".key", "EC PRIVATE KEY", keyDER)
+}
+
+func writePEM(t *testing.T, name, blockType string, der []byte) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600))
+	return path
+}
@@ -0,0 +1,184 @@
+package synchronization
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	datastreamsllo "github.com/smartcontractkit/chainlink-data-streams/llo"
+)
+
+// telemetryMessages maps the telemetry types to the protobuf message they are encoded with. Messages are looked up in
+// the global registry by name, as the packages defining some of them depend on this one. Telemetry of other types is
+// written undecoded.
+var telemetryMessages = map[TelemetryType]protoreflect.FullName{
+	EnhancedEA:        "telem.EnhancedEA",
+	EnhancedEAMercury: "telem.EnhancedEAMercury",
+	FunctionsRequests: "telem.FunctionsRequest",
+	AutomationCustom:  "telem.AutomationTelemWrapper",
+	HeadReport:        "telem.HeadReportRequest",
+	PipelineBridge:    "telem.LLOBridgeTelemetry",
+	LLOObservation:    "telem.LLOObservationTelemetry",
+	LLOOutcome:        (&datastreamsllo.LLOOutcomeTelemetry{}).ProtoReflect().Descriptor().FullName(),
+	LLOReport:         (&datastreamsllo.LLOReportTelemetry{}).ProtoReflect().Descriptor().FullName(),
+}
+
+// ocrProtocol is a version of the libocr protocol.
+type ocrProtocol int
+
+const (
+	ocr1 ocrProtocol = iota + 1
+	ocr2
+	ocr3
+)
+
+// ocrTelemetryProtocols maps the telemetry types sent by libocr to the version of the protocol sending them. Each
+// version encodes its telemetry with its own TelemetryWrapper message.
+var ocrTelemetryProtocols = map[TelemetryType]ocrProtocol{
+	OCR:               ocr1,
+	OCR2Automation:    ocr2,
+	OCR2Functions:     ocr2,
+	OCR2CCIPCommit:    ocr2,
+	OCR2CCIPExec:      ocr2,
+	OCR2Threshold:     ocr2,
+	OCR2S4:            ocr2,
+	OCR2Median:        ocr2,
+	OCR3Mercury:       ocr3,
+	OCR3DataFeeds:     ocr3,
+	OCR3Automation:    ocr3,
+	OCR3Rebalancer:    ocr3,
+	OCR3CCIPCommit:    ocr3,
+	OCR3CCIPExec:      ocr3,
+	OCR3CCIPBootstrap: ocr3,
+}
+
+var (
+	ocrTelemetryMessagesOnce sync.Once
+	ocrTelemetryMessages     map[ocrProtocol]protoreflect.MessageType
+)
+
+// ocrTelemetryMessage returns the message libocr encodes the telemetry of protocol with. The messages are defined in
+// packages internal to libocr, so they are found in the global registry by the Go package of their generated type.
+func ocrTelemetryMessage(protocol ocrProtocol) (protoreflect.MessageType, bool) {
+	ocrTelemetryMessagesOnce.Do(func() {
+		ocrTelemetryMessages = map[ocrProtocol]protoreflect.MessageType{}
+		ambiguous := map[ocrProtocol]bool{}
+		protoregistry.GlobalTypes.RangeMessages(func(mt protoreflect.MessageType) bool {
+			if mt.Descriptor().Name() != "TelemetryWrapper" {
+				return true
+			}
+			p, ok := ocrTelemetryPackageProtocol(reflect.TypeOf(mt.Zero().Interface()).Elem().PkgPath())
+			if !ok {
+				return true
+			}
+			if _, dup := ocrTelemetryMessages[p]; dup {
+				ambiguous[p] = true
+			}
+			ocrTelemetryMessages[p] = mt
+			return true
+		})
+		// Telemetry is written undecoded rather than decoded with the message of another protocol
+		for p := range ambiguous {
+			delete(ocrTelemetryMessages, p)
+		}
+	})
+	mt, ok := ocrTelemetryMessages[protocol]
+	return mt, ok
+}
+
+// ocrTelemetryPackageProtocol returns the protocol whose TelemetryWrapper is generated in the libocr package pkgPath.
+// Packages are matched by their path segments: the protocol root, then the ocr3 package for OCR3. Packages of other
+// protocol versions, like ocr3_1, are not matched.
+func ocrTelemetryPackageProtocol(pkgPath string) (ocrProtocol, bool) {
+	rel, ok := strings.CutPrefix(pkgPath, "github.com/smartcontractkit/libocr/")
+	if !ok {
+		return 0, false
+	}
+	segments := strings.Split(rel, "/")
+	switch segments[0] {
+	case "offchainreporting":
+		return ocr1, true
+	case "offchainreporting2", "offchainreporting2plus":
+		var versions []string
+		for _, s := range segments[1:] {
+			if strings.HasPrefix(s, "ocr") {
+				versions = append(versions, s)
+			}
+		}
+		switch {
+		case len(versions) == 0, len(versions) == 1 && versions[0] == "ocr2":
+			return ocr2, true
+		case len(versions) == 1 && versions[0] == "ocr3":
+			return ocr3, true
+		}
+	}
+	return 0, false
+}
+
+// localTelemetryRecord is a line of the files written by the local telemetry client, and a message of its stream.
+type localTelemetryRecord struct {
+	Timestamp     time.Time     `json:"timestamp"`
+	Network       string        `json:"network"`
+	ChainID       string        `json:"chainID"`
+	ContractID    string        `json:"contractID"`
+	TelemetryType TelemetryType `json:"telemetryType"`
+	// Message is the decoded telemetry, if its type is known.
+	Message json.RawMessage `json:"message,omitempty"`
+	// Raw is the undecoded telemetry otherwise.
+	Raw []byte `json:"raw,omitempty"`
+}
+
+func newLocalTelemetryRecord(now time.Time, network string, chainID string, p TelemPayload) localTelemetryRecord {
+	record := localTelemetryRecord{
+		Timestamp:     now.UTC(),
+		Network:       network,
+		ChainID:       chainID,
+		ContractID:    p.ContractID,
+		TelemetryType: p.TelemType,
+	}
+	if msg, ok := decodeTelemetry(p.TelemType, p.Telemetry); ok {
+		record.Message = msg
+	} else {
+		record.Raw = p.Telemetry
+	}
+	return record
+}
+
+// decodeTelemetry returns the protobuf encoded telemetry of telemType as JSON, or false if it cannot be decoded.
+func decodeTelemetry(telemType TelemetryType, telemetry []byte) (json.RawMessage, bool) {
+	mt, ok := telemetryMessageType(telemType)
+	if !ok {
+		return nil, false
+	}
+	msg := mt.New().Interface()
+	if err := proto.Unmarshal(telemetry, msg); err != nil {
+		return nil, false
+	}
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return nil, false
+	}
+	return b, true
+}
+
+func telemetryMessageType(telemType TelemetryType) (protoreflect.MessageType, bool) {
+	if protocol, ok := ocrTelemetryProtocols[telemType]; ok {
+		return ocrTelemetryMessage(protocol)
+	}
+	name, ok := telemetryMessages[telemType]
+	if !ok {
+		return nil, false
+	}
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(name)
+	if err != nil {
+		return nil, false
+	}
+	return mt, true
+}
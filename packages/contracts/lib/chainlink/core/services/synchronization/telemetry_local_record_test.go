@@ -0,0 +1,30 @@
+package synchronization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ocrTelemetryPackageProtocol(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		pkgPath  string
+		protocol ocrProtocol
+		ok       bool
+	}{
+		{"github.com/smartcontractkit/libocr/offchainreporting/internal/serialization/protobuf", ocr1, true},
+		{"github.com/smartcontractkit/libocr/offchainreporting2plus/internal/serialization", ocr2, true},
+		{"github.com/smartcontractkit/libocr/offchainreporting2/internal/serialization", ocr2, true},
+		{"github.com/smartcontractkit/libocr/offchainreporting2plus/internal/ocr3/serialization", ocr3, true},
+		{"github.com/smartcontractkit/libocr/offchainreporting2plus/internal/ocr3_1/serialization", 0, false},
+		{"github.com/smartcontractkit/libocr/offchainreporting2plusplus/internal/serialization", 0, false},
+		{"github.com/smartcontractkit/libocr/offchainreportingx/internal/serialization", 0, false},
+		{"github.com/smartcontractkit/libocrx/offchainreporting/internal/serialization", 0, false},
+	} {
+		protocol, ok := ocrTelemetryPackageProtocol(tc.pkgPath)
+		assert.Equal(t, tc.ok, ok, tc.pkgPath)
+		assert.Equal(t, tc.protocol, protocol, tc.pkgPath)
+	}
+}
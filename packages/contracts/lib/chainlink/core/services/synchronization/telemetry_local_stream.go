@@ -0,0 +1,44 @@
+package synchronization
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// LocalTelemetryStreamMethod is the gRPC method streaming the telemetry of a local telemetry client. It is defined
+// with well-known types only, so that clients need no generated code:
+//
+//	service LocalTelemetry {
+//	  rpc Stream(google.protobuf.Empty) returns (stream google.protobuf.Struct);
+//	}
+//
+// Each message holds the fields of a line of the telemetry files.
+const LocalTelemetryStreamMethod = "/telem.LocalTelemetry/Stream"
+
+type localTelemetryServer interface {
+	streamTelemetry(stream grpc.ServerStream) error
+}
+
+var localTelemetryServiceDesc = grpc.ServiceDesc{
+	ServiceName: "telem.LocalTelemetry",
+	HandlerType: (*localTelemetryServer)(nil),
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Stream",
+			ServerStreams: true,
+			Handler: func(srv interface{}, stream grpc.ServerStream) error {
+				if err := stream.RecvMsg(new(emptypb.Empty)); err != nil {
+					return err
+				}
+				return srv.(localTelemetryServer).streamTelemetry(stream)
+			},
+		},
+	},
+}
+
+// LocalTelemetryStreamDesc describes the stream of LocalTelemetryStreamMethod, for clients opening it with
+// grpc.ClientConn.NewStream.
+var LocalTelemetryStreamDesc = grpc.StreamDesc{
+	StreamName:    "Stream",
+	ServerStreams: true,
+}
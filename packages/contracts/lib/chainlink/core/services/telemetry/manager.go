@@ -111,12 +111,17 @@ func (m *Manager) newEndpoint(e config.TelemetryIngressEndpoint, lggr logger.Log
 		return nil, errors.New("cannot add telemetry endpoint, chainID cannot be empty")
 	}
 
-	if e.URL() == nil {
-		return nil, errors.New("cannot add telemetry endpoint, URL cannot be empty")
-	}
+	local := e.Local()
+	if local == nil {
+		if e.URL() == nil {
+			return nil, errors.New("cannot add telemetry endpoint, URL cannot be empty")
+		}
 
-	if e.ServerPubKey() == "" {
-		return nil, errors.New("cannot add telemetry endpoint, ServerPubKey cannot be empty")
+		if e.ServerPubKey() == "" {
+			return nil, errors.New("cannot add telemetry endpoint, ServerPubKey cannot be empty")
+		}
+	} else if local.Dir() == "" && local.ListenAddr() == "" {
+		return nil, errors.New("cannot add local telemetry endpoint, Dir or ListenAddr must be set")
 	}
 
 	if _, found := m.getEndpoint(e.Network(), e.ChainID()); found {
@@ -125,7 +130,9 @@ func (m *Manager) newEndpoint(e config.TelemetryIngressEndpoint, lggr logger.Log
 
 	lggr = logger.Sugared(lggr).Named(e.Network()).Named(e.ChainID())
 	var tClient synchronization.TelemetryService
-	if m.useBatchSend {
+	if local != nil {
+		tClient = synchronization.NewTelemetryLocalClient(e.Network(), e.ChainID(), local, lggr, cfg.BufferSize())
+	} else if m.useBatchSend {
 		tClient = synchronization.NewTelemetryIngressBatchClient(e.URL(), e.ServerPubKey(), m.ks, cfg.Logging(), lggr, cfg.BufferSize(), cfg.MaxBatchSize(), cfg.SendInterval(), cfg.SendTimeout(), cfg.UniConn())
 	} else {
 		tClient = synchronization.NewTelemetryIngressClient(e.URL(), e.ServerPubKey(), m.ks, lggr, cfg.BufferSize())
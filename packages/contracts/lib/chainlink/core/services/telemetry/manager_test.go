@@ -21,6 +21,7 @@ import (
 	keymocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization"
 	mocks2 "github.com/smartcontractkit/chainlink/v2/core/services/synchronization/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func setupMockConfig(t *testing.T, useBatchSend bool) *mocks.TelemetryIngress {
@@ -42,6 +43,7 @@ func TestManagerAgents(t *testing.T) {
 	te.On("Network").Return("network-1")
 	te.On("ChainID").Return("network-1-chainID-1")
 	te.On("ServerPubKey").Return("some-pubkey")
+	te.On("Local").Return(nil)
 	u, _ := url.Parse("http://some-url.test")
 	te.On("URL").Return(u)
 	tic.On("Endpoints").Return([]config.TelemetryIngressEndpoint{te})
@@ -63,6 +65,43 @@ func TestManagerAgents(t *testing.T) {
 	assert.Equal(t, "*telemetry.TypedIngressAgent", reflect.TypeOf(me).String())
 }
 
+type localSink struct {
+	dir        string
+	listenAddr string
+}
+
+func (l localSink) Dir() string                 { return l.dir }
+func (l localSink) MaxFileSize() utils.FileSize { return utils.MB }
+func (l localSink) MaxFiles() int64             { return 1 }
+func (l localSink) ListenAddr() string          { return l.listenAddr }
+func (l localSink) TLSCertPath() string         { return "" }
+func (l localSink) TLSKeyPath() string          { return "" }
+func (l localSink) TLSClientCACertPath() string { return "" }
+
+func TestManagerLocalEndpoint(t *testing.T) {
+	tic := setupMockConfig(t, true)
+	te := mocks.NewTelemetryIngressEndpoint(t)
+	te.On("Network").Return("network-1")
+	te.On("ChainID").Return("network-1-chainID-1")
+	te.On("Local").Return(localSink{dir: t.TempDir()})
+	te.On("URL").Maybe().Return(nil)
+	te.On("ServerPubKey").Maybe().Return("")
+	invalid := mocks.NewTelemetryIngressEndpoint(t)
+	invalid.On("Network").Return("network-2")
+	invalid.On("ChainID").Return("network-2-chainID-1")
+	invalid.On("Local").Return(localSink{})
+	tic.On("Endpoints").Return([]config.TelemetryIngressEndpoint{te, invalid})
+
+	lggr, logObs := logger.TestLoggerObserved(t, zapcore.InfoLevel)
+
+	tm := NewManager(tic, keymocks.NewCSA(t), lggr)
+	require.Len(t, tm.endpoints, 1)
+	require.Equal(t, "*synchronization.telemetryLocalClient", reflect.TypeOf(tm.endpoints[0].client).String())
+	me := tm.GenMonitoringEndpoint("network-1", "network-1-chainID-1", "", "")
+	assert.Equal(t, "*telemetry.TypedIngressAgentBatch", reflect.TypeOf(me).String())
+	require.Len(t, logObs.FilterMessageSnippet("Dir or ListenAddr must be set").All(), 1)
+}
+
 func TestNewManager(t *testing.T) {
 	type endpointTest struct {
 		network       string
@@ -134,6 +173,7 @@ func TestNewManager(t *testing.T) {
 		te.On("Network").Maybe().Return(e.network)
 		te.On("ChainID").Maybe().Return(e.chainID)
 		te.On("ServerPubKey").Maybe().Return(e.pubKey)
+		te.On("Local").Maybe().Return(nil)
 
 		u, _ := url.Parse(e.url)
 		if e.url == "" {
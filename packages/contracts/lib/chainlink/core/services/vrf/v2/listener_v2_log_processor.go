@@ -849,6 +849,7 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 				ll.Errorw("Error enqueuing fulfillment, requeuing request", "err", err)
 				continue
 			}
+			lsn.pipelineRunner.PublishFinishedRuns(ctx, []*pipeline.Run{p.run})
 			ll.Infow("Enqueued fulfillment", "ethTxID", transaction.GetID())
 
 			// If we successfully enqueued for the txm, subtract that balance
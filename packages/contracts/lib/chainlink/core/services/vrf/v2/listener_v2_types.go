@@ -261,6 +261,7 @@ func (lsn *listenerV2) processBatch(
 		ll.Errorw("Error enqueuing batch fulfillments, requeuing requests", "err", err)
 		return
 	}
+	lsn.pipelineRunner.PublishFinishedRuns(ctx, batch.runs)
 	ll.Infow("Enqueued fulfillment", "ethTxID", ethTX.GetID())
 
 	// mark requests as processed since the fulfillment has been successfully enqueued
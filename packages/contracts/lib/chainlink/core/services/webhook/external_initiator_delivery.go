@@ -0,0 +1,286 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+const (
+	// DeliveryMaxAttempts is the number of failed attempts after which a delivery is dead, and only resent on replay.
+	DeliveryMaxAttempts = 12
+
+	// deliveryPollInterval is the interval pending deliveries are polled at, for those whose retry is due
+	deliveryPollInterval = 5 * time.Second
+	deliveryBatchSize    = 100
+	deliveryTimeout      = 30 * time.Second
+	deliveryMinBackoff   = 5 * time.Second
+	deliveryMaxBackoff   = time.Hour
+)
+
+// DeliveryType is the type of the notification of a delivery.
+type DeliveryType string
+
+const (
+	// DeliveryTypeCreate notifies the external initiator of a new job.
+	DeliveryTypeCreate DeliveryType = "create"
+	// DeliveryTypeDelete notifies the external initiator of a deleted job.
+	DeliveryTypeDelete DeliveryType = "delete"
+)
+
+// DeliveryState is the state of a delivery.
+type DeliveryState string
+
+const (
+	// DeliveryStatePending deliveries are sent, or retried, once their next attempt is due.
+	DeliveryStatePending DeliveryState = "pending"
+	// DeliveryStateDelivered deliveries were acknowledged by the external initiator.
+	DeliveryStateDelivered DeliveryState = "delivered"
+	// DeliveryStateDead deliveries failed DeliveryMaxAttempts times, and are only resent on replay.
+	DeliveryStateDead DeliveryState = "dead"
+)
+
+// ParseDeliveryState returns the DeliveryState named s, where the empty string matches any state.
+func ParseDeliveryState(s string) (DeliveryState, error) {
+	switch state := DeliveryState(s); state {
+	case "", DeliveryStatePending, DeliveryStateDelivered, DeliveryStateDead:
+		return state, nil
+	default:
+		return "", errors.Errorf("unknown delivery state %q, must be %q, %q or %q", s, DeliveryStatePending, DeliveryStateDelivered, DeliveryStateDead)
+	}
+}
+
+// Delivery is a notification of a job to an external initiator, persisted until it is delivered.
+type Delivery struct {
+	ID                    int64
+	ExternalInitiatorID   int64
+	ExternalInitiatorName string
+	JobID                 uuid.UUID
+	Type                  DeliveryType
+	Spec                  models.JSON
+	State                 DeliveryState
+	Attempts              int32
+	LastError             null.String
+	NextAttemptAt         time.Time
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+}
+
+// ReplayDeliveriesRequest is the request to replay deliveries. With no IDs, all dead deliveries are replayed.
+type ReplayDeliveriesRequest struct {
+	IDs               []int64 `json:"ids"`
+	ExternalInitiator string  `json:"externalInitiator"`
+}
+
+func (m *externalInitiatorManager) start(context.Context) error {
+	m.eng.Go(m.runDeliveries)
+	return nil
+}
+
+func (m *externalInitiatorManager) wake() {
+	select {
+	case m.chWake <- struct{}{}:
+	default:
+	}
+}
+
+func (m *externalInitiatorManager) runDeliveries(ctx context.Context) {
+	ticker := time.NewTicker(deliveryPollInterval)
+	defer ticker.Stop()
+	for {
+		if err := m.DeliverPending(ctx); err != nil && ctx.Err() == nil {
+			m.eng.Errorw("Failed to deliver external initiator notifications", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-m.chWake:
+		}
+	}
+}
+
+func (m *externalInitiatorManager) createDelivery(ctx context.Context, eiID int64, jobID uuid.UUID, typ DeliveryType, spec models.JSON) error {
+	if typ == DeliveryTypeDelete {
+		// creations not delivered yet are obsolete, and must not be replayed once the job is deleted
+		if _, err := m.ds.ExecContext(ctx, `DELETE FROM external_initiator_deliveries
+WHERE external_initiator_id = $1 AND job_id = $2 AND type = $3 AND state <> $4`, eiID, jobID, DeliveryTypeCreate, DeliveryStateDelivered); err != nil {
+			return errors.Wrap(err, "failed to delete obsolete deliveries")
+		}
+	}
+	_, err := m.ds.ExecContext(ctx, `INSERT INTO external_initiator_deliveries (external_initiator_id, job_id, type, spec)
+VALUES ($1, $2, $3, COALESCE($4, '{}'))`, eiID, jobID, typ, spec)
+	return errors.Wrap(err, "failed to create delivery")
+}
+
+// DeliverPending sends the pending deliveries whose next attempt is due, and schedules the retry of those that fail
+// with an exponential backoff. The deliveries of a job to an external initiator are sent in the order they were
+// created, each one waiting until the earlier ones are delivered, so that the deletion of a job which is then
+// re-created with the same ID is never sent after the creation. A dead delivery holds the later ones back until it is
+// replayed.
+func (m *externalInitiatorManager) DeliverPending(ctx context.Context) error {
+	var deliveries []Delivery
+	if err := m.ds.SelectContext(ctx, &deliveries, `SELECT d.*, ei.name AS external_initiator_name
+FROM external_initiator_deliveries d JOIN external_initiators ei ON ei.id = d.external_initiator_id
+WHERE d.state = $1 AND d.next_attempt_at <= NOW()
+AND NOT EXISTS (
+	SELECT 1 FROM external_initiator_deliveries earlier
+	WHERE earlier.external_initiator_id = d.external_initiator_id AND earlier.job_id = d.job_id
+	AND earlier.id < d.id AND earlier.state <> $2
+)
+ORDER BY d.id LIMIT $3`, DeliveryStatePending, DeliveryStateDelivered, deliveryBatchSize); err != nil {
+		return errors.Wrap(err, "failed to load pending deliveries")
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	var ids []int64
+	for _, d := range deliveries {
+		ids = append(ids, d.ExternalInitiatorID)
+	}
+	var externalInitiators []bridges.ExternalInitiator
+	if err := m.ds.SelectContext(ctx, &externalInitiators, `SELECT * FROM external_initiators WHERE id = ANY($1)`, pq.Array(ids)); err != nil {
+		return errors.Wrap(err, "failed to load external initiators of pending deliveries")
+	}
+	eiMap := make(map[int64]bridges.ExternalInitiator)
+	for _, ei := range externalInitiators {
+		eiMap[ei.ID] = ei
+	}
+
+	var delivered bool
+	for _, d := range deliveries {
+		sendErr := m.send(ctx, d, eiMap[d.ExternalInitiatorID])
+		if ctx.Err() != nil {
+			// the delivery is retried once the node restarts
+			return nil
+		}
+		if err := m.recordAttempt(ctx, d, sendErr); err != nil {
+			return err
+		}
+		delivered = delivered || sendErr == nil
+	}
+	if delivered {
+		// the deliveries held back by those just delivered can be sent right away
+		m.wake()
+	}
+	return nil
+}
+
+func (m *externalInitiatorManager) send(ctx context.Context, d Delivery, ei bridges.ExternalInitiator) error {
+	if ei.URL == nil {
+		return errors.New("external initiator has no URL")
+	}
+	ctx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+
+	var req *http.Request
+	var err error
+	switch d.Type {
+	case DeliveryTypeCreate:
+		notice := JobSpecNotice{
+			JobID:  d.JobID,
+			Type:   ei.Name,
+			Params: d.Spec,
+		}
+		buf, merr := json.Marshal(notice)
+		if merr != nil {
+			return errors.Wrap(merr, "new Job Spec notification")
+		}
+		req, err = newNotifyHTTPRequest(ctx, buf, ei)
+	case DeliveryTypeDelete:
+		req, err = newDeleteJobFromExternalInitiatorHTTPRequest(ctx, ei, d.JobID)
+	default:
+		return errors.Errorf("unknown delivery type %q", d.Type)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "creating %s HTTP request", d.Type)
+	}
+	resp, err := m.httpclient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "could not notify '%s' (%s)", ei.Name, req.URL)
+	}
+	if err := resp.Body.Close(); err != nil {
+		return err
+	}
+	if !(resp.StatusCode >= 200 && resp.StatusCode < 300) {
+		return fmt.Errorf("%s '%s' (%s) received bad response '%d: %s'", d.Type, ei.Name, ei.URL, resp.StatusCode, resp.Status)
+	}
+	return nil
+}
+
+func (m *externalInitiatorManager) recordAttempt(ctx context.Context, d Delivery, sendErr error) error {
+	attempts := d.Attempts + 1
+	if sendErr == nil {
+		_, err := m.ds.ExecContext(ctx, `UPDATE external_initiator_deliveries
+SET state = $1, attempts = $2, last_error = NULL, updated_at = NOW() WHERE id = $3`, DeliveryStateDelivered, attempts, d.ID)
+		return errors.Wrapf(err, "failed to mark delivery %d as delivered", d.ID)
+	}
+
+	state := DeliveryStatePending
+	if attempts >= DeliveryMaxAttempts {
+		state = DeliveryStateDead
+		m.eng.Errorw("External initiator notification failed too many times, moving it to the dead-letter queue",
+			"deliveryID", d.ID, "externalInitiator", d.ExternalInitiatorName, "jobID", d.JobID, "type", d.Type, "attempts", attempts, "err", sendErr)
+	} else {
+		m.eng.Warnw("Failed to notify external initiator, retrying",
+			"deliveryID", d.ID, "externalInitiator", d.ExternalInitiatorName, "jobID", d.JobID, "type", d.Type, "attempts", attempts, "err", sendErr)
+	}
+	_, err := m.ds.ExecContext(ctx, `UPDATE external_initiator_deliveries
+SET state = $1, attempts = $2, last_error = $3, next_attempt_at = $4, updated_at = NOW() WHERE id = $5`,
+		state, attempts, sendErr.Error(), time.Now().Add(deliveryBackoff(attempts)), d.ID)
+	return errors.Wrapf(err, "failed to record attempt of delivery %d", d.ID)
+}
+
+// deliveryBackoff returns the delay before the retry of a delivery that failed attempts times.
+func deliveryBackoff(attempts int32) time.Duration {
+	backoff := deliveryMinBackoff
+	for i := int32(1); i < attempts && backoff < deliveryMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, deliveryMaxBackoff)
+}
+
+func (m *externalInitiatorManager) Deliveries(ctx context.Context, state DeliveryState, offset, limit int) (deliveries []Delivery, count int, err error) {
+	if err = m.ds.GetContext(ctx, &count, `SELECT COUNT(*) FROM external_initiator_deliveries WHERE $1 = '' OR state = $1`, state); err != nil {
+		return nil, 0, errors.Wrap(err, "failed to count deliveries")
+	}
+	if err = m.ds.SelectContext(ctx, &deliveries, `SELECT d.*, ei.name AS external_initiator_name
+FROM external_initiator_deliveries d JOIN external_initiators ei ON ei.id = d.external_initiator_id
+WHERE $1 = '' OR d.state = $1
+ORDER BY d.id DESC OFFSET $2 LIMIT $3`, state, offset, limit); err != nil {
+		return nil, 0, errors.Wrap(err, "failed to load deliveries")
+	}
+	return deliveries, count, nil
+}
+
+func (m *externalInitiatorManager) ReplayDeliveries(ctx context.Context, ids []int64, externalInitiatorName string) (int64, error) {
+	res, err := m.ds.ExecContext(ctx, `UPDATE external_initiator_deliveries d
+SET state = $1, attempts = 0, next_attempt_at = NOW(), updated_at = NOW()
+FROM external_initiators ei
+WHERE ei.id = d.external_initiator_id
+AND ($2 = '' OR lower(ei.name) = lower($2))
+AND (CASE WHEN cardinality($3::bigint[]) > 0 THEN d.id = ANY($3) ELSE d.state = $4 END)`,
+		DeliveryStatePending, externalInitiatorName, pq.Array(ids), DeliveryStateDead)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to replay deliveries")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to replay deliveries")
+	}
+	if n > 0 {
+		m.wake()
+	}
+	return n, nil
+}
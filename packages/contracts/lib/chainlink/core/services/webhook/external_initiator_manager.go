@@ -3,7 +3,6 @@ package webhook
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -11,8 +10,10 @@ import (
 	"github.com/lib/pq"
 	"github.com/pkg/errors"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
@@ -23,6 +24,12 @@ type ExternalInitiatorManager interface {
 	Notify(ctx context.Context, webhookSpecID int32) error
 	DeleteJob(ctx context.Context, webhookSpecID int32) error
 	FindExternalInitiatorByName(ctx context.Context, name string) (bridges.ExternalInitiator, error)
+	// Deliveries returns a page of the notifications sent to external initiators, the latest first, optionally filtered
+	// by state, and the total count.
+	Deliveries(ctx context.Context, state DeliveryState, offset, limit int) ([]Delivery, int, error)
+	// ReplayDeliveries resends the deliveries with ids, or all dead deliveries if ids is empty, optionally filtered by the
+	// name of their external initiator. It returns the number of deliveries to resend.
+	ReplayDeliveries(ctx context.Context, ids []int64, externalInitiatorName string) (int64, error)
 }
 
 type HTTPClient interface {
@@ -30,63 +37,62 @@ type HTTPClient interface {
 }
 
 type externalInitiatorManager struct {
+	services.Service
+	eng *services.Engine
+
 	ds         sqlutil.DataSource
 	httpclient HTTPClient
+	// chWake wakes the delivery of pending notifications up
+	chWake chan struct{}
 }
 
 var _ ExternalInitiatorManager = (*externalInitiatorManager)(nil)
 
-// NewExternalInitiatorManager returns the concrete externalInitiatorManager
-func NewExternalInitiatorManager(ds sqlutil.DataSource, httpclient HTTPClient) *externalInitiatorManager {
-	return &externalInitiatorManager{
+// NewExternalInitiatorManager returns the concrete externalInitiatorManager. Notifications are persisted, and delivered
+// with retries once the manager is started.
+func NewExternalInitiatorManager(ds sqlutil.DataSource, httpclient HTTPClient, lggr logger.Logger) *externalInitiatorManager {
+	m := &externalInitiatorManager{
 		ds:         ds,
 		httpclient: httpclient,
+		chWake:     make(chan struct{}, 1),
 	}
+	m.Service, m.eng = services.Config{
+		Name:  "ExternalInitiatorManager",
+		Start: m.start,
+	}.NewServiceEngine(lggr)
+	return m
+}
+
+func (m *externalInitiatorManager) withDataSource(ds sqlutil.DataSource) *externalInitiatorManager {
+	return &externalInitiatorManager{ds: ds, httpclient: m.httpclient}
 }
 
-// Notify sends a POST notification to the External Initiator
+// Notify queues a POST notification to the External Initiator
 // responsible for initiating the Job Spec.
 func (m *externalInitiatorManager) Notify(ctx context.Context, webhookSpecID int32) error {
 	eiWebhookSpecs, jobID, err := m.Load(ctx, webhookSpecID)
 	if err != nil {
 		return err
 	}
+	var queued bool
 	for _, eiWebhookSpec := range eiWebhookSpecs {
 		ei := eiWebhookSpec.ExternalInitiator
 		if ei.URL == nil {
 			continue
 		}
-		notice := JobSpecNotice{
-			JobID:  jobID,
-			Type:   ei.Name,
-			Params: eiWebhookSpec.Spec,
-		}
-		buf, err := json.Marshal(notice)
-		if err != nil {
-			return errors.Wrap(err, "new Job Spec notification")
-		}
-		req, err := newNotifyHTTPRequest(ctx, buf, ei)
-		if err != nil {
-			return errors.Wrap(err, "creating notify HTTP request")
-		}
-		resp, err := m.httpclient.Do(req)
-		if err != nil {
-			return errors.Wrap(err, "could not notify '%s' (%s)")
-		}
-		if err := resp.Body.Close(); err != nil {
-			return err
-		}
-		if !(resp.StatusCode >= 200 && resp.StatusCode < 300) {
-			return fmt.Errorf(" notify '%s' (%s) received bad response '%d: %s'", ei.Name, ei.URL, resp.StatusCode, resp.Status)
+		if err = m.createDelivery(ctx, ei.ID, jobID, DeliveryTypeCreate, eiWebhookSpec.Spec); err != nil {
+			return errors.Wrapf(err, "could not queue notification of '%s'", ei.Name)
 		}
+		queued = true
+	}
+	if queued {
+		m.wake()
 	}
 	return nil
 }
 
 func (m *externalInitiatorManager) Load(ctx context.Context, webhookSpecID int32) (eiWebhookSpecs []job.ExternalInitiatorWebhookSpec, jobID uuid.UUID, err error) {
-	err = sqlutil.Transact(ctx, func(ds sqlutil.DataSource) *externalInitiatorManager {
-		return NewExternalInitiatorManager(ds, m.httpclient)
-	}, m.ds, nil, func(tx *externalInitiatorManager) error {
+	err = sqlutil.Transact(ctx, m.withDataSource, m.ds, nil, func(tx *externalInitiatorManager) error {
 		if err = tx.ds.GetContext(ctx, &jobID, "SELECT external_job_id FROM jobs WHERE webhook_spec_id = $1", webhookSpecID); err != nil {
 			if err = errors.Wrapf(err, "failed to load job ID from job for webhook spec with ID %d", webhookSpecID); err != nil {
 				return err
@@ -132,31 +138,26 @@ func (m *externalInitiatorManager) eagerLoadExternalInitiator(ctx context.Contex
 	return nil
 }
 
+// DeleteJob queues a DELETE notification of the job to the External Initiators
+// responsible for initiating the Job Spec.
 func (m *externalInitiatorManager) DeleteJob(ctx context.Context, webhookSpecID int32) error {
 	eiWebhookSpecs, jobID, err := m.Load(ctx, webhookSpecID)
 	if err != nil {
 		return err
 	}
+	var queued bool
 	for _, eiWebhookSpec := range eiWebhookSpecs {
 		ei := eiWebhookSpec.ExternalInitiator
 		if ei.URL == nil {
 			continue
 		}
-
-		req, err := newDeleteJobFromExternalInitiatorHTTPRequest(ctx, ei, jobID)
-		if err != nil {
-			return errors.Wrap(err, "creating delete HTTP request")
-		}
-		resp, err := m.httpclient.Do(req)
-		if err != nil {
-			return errors.Wrapf(err, "could not delete job from remote external initiator at %s", req.URL)
-		}
-		if err := resp.Body.Close(); err != nil {
-			return err
-		}
-		if !(resp.StatusCode >= 200 && resp.StatusCode < 300) {
-			return fmt.Errorf(" delete '%s' (%s) received bad response '%d: %s'", ei.Name, ei.URL, resp.StatusCode, resp.Status)
+		if err = m.createDelivery(ctx, ei.ID, jobID, DeliveryTypeDelete, models.JSON{}); err != nil {
+			return errors.Wrapf(err, "could not queue delete notification of '%s'", ei.Name)
 		}
+		queued = true
+	}
+	if queued {
+		m.wake()
 	}
 	return nil
 }
@@ -209,3 +210,9 @@ func (NullExternalInitiatorManager) DeleteJob(context.Context, int32) error { re
 func (NullExternalInitiatorManager) FindExternalInitiatorByName(ctx context.Context, name string) (bridges.ExternalInitiator, error) {
 	return bridges.ExternalInitiator{}, nil
 }
+func (NullExternalInitiatorManager) Deliveries(context.Context, DeliveryState, int, int) ([]Delivery, int, error) {
+	return nil, 0, nil
+}
+func (NullExternalInitiatorManager) ReplayDeliveries(context.Context, []int64, string) (int64, error) {
+	return 0, nil
+}
@@ -4,8 +4,12 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -16,6 +20,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	_ "github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	webhookmocks "github.com/smartcontractkit/chainlink/v2/core/services/webhook/mocks"
@@ -37,7 +42,7 @@ func Test_ExternalInitiatorManager_Load(t *testing.T) {
 	pgtest.MustExec(t, db, `INSERT INTO external_initiator_webhook_specs (external_initiator_id, webhook_spec_id, spec) VALUES ($1,$2,$3)`, eiBar.ID, webhookSpecTwoEIs.ID, `{"ei": "bar", "name": "webhookSpecTwoEIs"}`)
 	pgtest.MustExec(t, db, `INSERT INTO external_initiator_webhook_specs (external_initiator_id, webhook_spec_id, spec) VALUES ($1,$2,$3)`, eiFoo.ID, webhookSpecOneEI.ID, `{"ei": "foo", "name": "webhookSpecOneEI"}`)
 
-	eim := webhook.NewExternalInitiatorManager(db, nil)
+	eim := webhook.NewExternalInitiatorManager(db, nil, logger.TestLogger(t))
 
 	eiWebhookSpecs, jobID, err := eim.Load(ctx, webhookSpecNoEIs.ID)
 	require.NoError(t, err)
@@ -76,10 +81,11 @@ func Test_ExternalInitiatorManager_Notify(t *testing.T) {
 	pgtest.MustExec(t, db, `INSERT INTO external_initiator_webhook_specs (external_initiator_id, webhook_spec_id, spec) VALUES ($1,$2,$3)`, eiNoURL.ID, webhookSpecTwoEIs.ID, `{"ei": "bar", "name": "webhookSpecTwoEIs"}`)
 
 	client := webhookmocks.NewHTTPClient(t)
-	eim := webhook.NewExternalInitiatorManager(db, client)
+	eim := webhook.NewExternalInitiatorManager(db, client, logger.TestLogger(t))
 
 	// Does nothing with no EI
 	require.NoError(t, eim.Notify(ctx, webhookSpecNoEIs.ID))
+	require.NoError(t, eim.DeliverPending(ctx))
 
 	client.On("Do", mock.MatchedBy(func(r *http.Request) bool {
 		body, err := r.GetBody()
@@ -94,6 +100,20 @@ func Test_ExternalInitiatorManager_Notify(t *testing.T) {
 		return r.Method == "POST" && r.URL.String() == eiWithURL.URL.String() && r.Header["Content-Type"][0] == "application/json" && r.Header["X-Chainlink-Ea-Accesskey"][0] == "token" && r.Header["X-Chainlink-Ea-Secret"][0] == "secret"
 	})).Once().Return(&http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(""))}, nil)
 	require.NoError(t, eim.Notify(ctx, webhookSpecTwoEIs.ID))
+
+	// the notification is queued for the EI with a URL only
+	deliveries, count, err := eim.Deliveries(ctx, webhook.DeliveryStatePending, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	assert.Equal(t, eiWithURL.Name, deliveries[0].ExternalInitiatorName)
+	assert.Equal(t, webhook.DeliveryTypeCreate, deliveries[0].Type)
+
+	require.NoError(t, eim.DeliverPending(ctx))
+	deliveries, _, err = eim.Deliveries(ctx, "", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, webhook.DeliveryStateDelivered, deliveries[0].State)
+	assert.Equal(t, int32(1), deliveries[0].Attempts)
 }
 
 func Test_ExternalInitiatorManager_DeleteJob(t *testing.T) {
@@ -115,14 +135,152 @@ func Test_ExternalInitiatorManager_DeleteJob(t *testing.T) {
 	pgtest.MustExec(t, db, `INSERT INTO external_initiator_webhook_specs (external_initiator_id, webhook_spec_id, spec) VALUES ($1,$2,$3)`, eiNoURL.ID, webhookSpecTwoEIs.ID, `{"ei": "bar", "name": "webhookSpecTwoEIs"}`)
 
 	client := webhookmocks.NewHTTPClient(t)
-	eim := webhook.NewExternalInitiatorManager(db, client)
+	eim := webhook.NewExternalInitiatorManager(db, client, logger.TestLogger(t))
 
 	// Does nothing with no EI
 	require.NoError(t, eim.DeleteJob(ctx, webhookSpecNoEIs.ID))
+	require.NoError(t, eim.DeliverPending(ctx))
 
 	client.On("Do", mock.MatchedBy(func(r *http.Request) bool {
 		expectedURL := fmt.Sprintf("%s/%s", eiWithURL.URL.String(), jb.ExternalJobID.String())
 		return r.Method == "DELETE" && r.URL.String() == expectedURL && r.Header["Content-Type"][0] == "application/json" && r.Header["X-Chainlink-Ea-Accesskey"][0] == "token" && r.Header["X-Chainlink-Ea-Secret"][0] == "secret"
 	})).Once().Return(&http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(""))}, nil)
 	require.NoError(t, eim.DeleteJob(ctx, webhookSpecTwoEIs.ID))
+	require.NoError(t, eim.DeliverPending(ctx))
+
+	deliveries, _, err := eim.Deliveries(ctx, webhook.DeliveryStateDelivered, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, webhook.DeliveryTypeDelete, deliveries[0].Type)
+}
+
+func Test_ExternalInitiatorManager_Retries(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	borm := bridges.NewORM(db)
+
+	var eiUp atomic.Bool
+	var calls atomic.Int32
+	ei := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if !eiUp.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	t.Cleanup(ei.Close)
+
+	eiWithURL := cltest.MustInsertExternalInitiatorWithOpts(t, borm, cltest.ExternalInitiatorOpts{
+		URL:            cltest.MustWebURL(t, ei.URL),
+		OutgoingSecret: "secret",
+		OutgoingToken:  "token",
+	})
+	_, webhookSpec := cltest.MustInsertWebhookSpec(t, db)
+	pgtest.MustExec(t, db, `INSERT INTO external_initiator_webhook_specs (external_initiator_id, webhook_spec_id, spec) VALUES ($1,$2,$3)`, eiWithURL.ID, webhookSpec.ID, `{}`)
+
+	eim := webhook.NewExternalInitiatorManager(db, ei.Client(), logger.TestLogger(t))
+	require.NoError(t, eim.Notify(ctx, webhookSpec.ID))
+
+	// retries are backed off
+	require.NoError(t, eim.DeliverPending(ctx))
+	require.NoError(t, eim.DeliverPending(ctx))
+	assert.Equal(t, int32(1), calls.Load())
+	deliveries, _, err := eim.Deliveries(ctx, "", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, webhook.DeliveryStatePending, deliveries[0].State)
+	assert.Equal(t, int32(1), deliveries[0].Attempts)
+	assert.Contains(t, deliveries[0].LastError.String, "503")
+	assert.True(t, deliveries[0].NextAttemptAt.After(time.Now()))
+
+	// the delivery is dead after too many attempts
+	for i := 1; i < webhook.DeliveryMaxAttempts; i++ {
+		pgtest.MustExec(t, db, `UPDATE external_initiator_deliveries SET next_attempt_at = NOW()`)
+		require.NoError(t, eim.DeliverPending(ctx))
+	}
+	assert.Equal(t, int32(webhook.DeliveryMaxAttempts), calls.Load())
+	_, count, err := eim.Deliveries(ctx, webhook.DeliveryStateDead, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	require.NoError(t, eim.DeliverPending(ctx))
+	assert.Equal(t, int32(webhook.DeliveryMaxAttempts), calls.Load())
+
+	// dead deliveries are resent once replayed
+	eiUp.Store(true)
+	n, err := eim.ReplayDeliveries(ctx, nil, "other-ei")
+	require.NoError(t, err)
+	assert.Zero(t, n)
+	n, err = eim.ReplayDeliveries(ctx, nil, eiWithURL.Name)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+	require.NoError(t, eim.DeliverPending(ctx))
+	deliveries, _, err = eim.Deliveries(ctx, "", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, webhook.DeliveryStateDelivered, deliveries[0].State)
+	assert.Equal(t, int32(1), deliveries[0].Attempts)
+
+	// delivered ones are resent when replayed by ID
+	n, err = eim.ReplayDeliveries(ctx, []int64{deliveries[0].ID}, "")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+	require.NoError(t, eim.DeliverPending(ctx))
+	assert.Equal(t, int32(webhook.DeliveryMaxAttempts+2), calls.Load())
+}
+
+func Test_ExternalInitiatorManager_DeliveryOrder(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	borm := bridges.NewORM(db)
+
+	var deleteUp atomic.Bool
+	var mu sync.Mutex
+	var sent []string
+	ei := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete && !deleteUp.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		sent = append(sent, r.Method)
+	}))
+	t.Cleanup(ei.Close)
+
+	eiWithURL := cltest.MustInsertExternalInitiatorWithOpts(t, borm, cltest.ExternalInitiatorOpts{
+		URL:            cltest.MustWebURL(t, ei.URL),
+		OutgoingSecret: "secret",
+		OutgoingToken:  "token",
+	})
+	_, webhookSpec := cltest.MustInsertWebhookSpec(t, db)
+	pgtest.MustExec(t, db, `INSERT INTO external_initiator_webhook_specs (external_initiator_id, webhook_spec_id, spec) VALUES ($1,$2,$3)`, eiWithURL.ID, webhookSpec.ID, `{}`)
+
+	eim := webhook.NewExternalInitiatorManager(db, ei.Client(), logger.TestLogger(t))
+	require.NoError(t, eim.Notify(ctx, webhookSpec.ID))
+	require.NoError(t, eim.DeliverPending(ctx))
+
+	// the job is updated, which deletes and re-creates it with the same external job ID, and the deletion fails
+	require.NoError(t, eim.DeleteJob(ctx, webhookSpec.ID))
+	require.NoError(t, eim.Notify(ctx, webhookSpec.ID))
+	require.NoError(t, eim.DeliverPending(ctx))
+
+	// the creation waits for the deletion to be delivered
+	deliveries, _, err := eim.Deliveries(ctx, webhook.DeliveryStatePending, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	assert.Equal(t, webhook.DeliveryTypeCreate, deliveries[0].Type)
+	assert.Zero(t, deliveries[0].Attempts)
+	assert.Equal(t, webhook.DeliveryTypeDelete, deliveries[1].Type)
+	assert.Equal(t, int32(1), deliveries[1].Attempts)
+
+	deleteUp.Store(true)
+	pgtest.MustExec(t, db, `UPDATE external_initiator_deliveries SET next_attempt_at = NOW()`)
+	require.NoError(t, eim.DeliverPending(ctx))
+	require.NoError(t, eim.DeliverPending(ctx))
+
+	_, count, err := eim.Deliveries(ctx, webhook.DeliveryStateDelivered, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{http.MethodPost, http.MethodDelete, http.MethodPost}, sent)
 }
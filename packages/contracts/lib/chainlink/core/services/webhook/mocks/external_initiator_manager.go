@@ -8,6 +8,8 @@ import (
 	bridges "github.com/smartcontractkit/chainlink/v2/core/bridges"
 
 	mock "github.com/stretchr/testify/mock"
+
+	webhook "github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 )
 
 // ExternalInitiatorManager is an autogenerated mock type for the ExternalInitiatorManager type
@@ -70,6 +72,74 @@ func (_c *ExternalInitiatorManager_DeleteJob_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// Deliveries provides a mock function with given fields: ctx, state, offset, limit
+func (_m *ExternalInitiatorManager) Deliveries(ctx context.Context, state webhook.DeliveryState, offset int, limit int) ([]webhook.Delivery, int, error) {
+	ret := _m.Called(ctx, state, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Deliveries")
+	}
+
+	var r0 []webhook.Delivery
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, webhook.DeliveryState, int, int) ([]webhook.Delivery, int, error)); ok {
+		return rf(ctx, state, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, webhook.DeliveryState, int, int) []webhook.Delivery); ok {
+		r0 = rf(ctx, state, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]webhook.Delivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, webhook.DeliveryState, int, int) int); ok {
+		r1 = rf(ctx, state, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, webhook.DeliveryState, int, int) error); ok {
+		r2 = rf(ctx, state, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ExternalInitiatorManager_Deliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Deliveries'
+type ExternalInitiatorManager_Deliveries_Call struct {
+	*mock.Call
+}
+
+// Deliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - state webhook.DeliveryState
+//   - offset int
+//   - limit int
+func (_e *ExternalInitiatorManager_Expecter) Deliveries(ctx interface{}, state interface{}, offset interface{}, limit interface{}) *ExternalInitiatorManager_Deliveries_Call {
+	return &ExternalInitiatorManager_Deliveries_Call{Call: _e.mock.On("Deliveries", ctx, state, offset, limit)}
+}
+
+func (_c *ExternalInitiatorManager_Deliveries_Call) Run(run func(ctx context.Context, state webhook.DeliveryState, offset int, limit int)) *ExternalInitiatorManager_Deliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(webhook.DeliveryState), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *ExternalInitiatorManager_Deliveries_Call) Return(_a0 []webhook.Delivery, _a1 int, _a2 error) *ExternalInitiatorManager_Deliveries_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ExternalInitiatorManager_Deliveries_Call) RunAndReturn(run func(context.Context, webhook.DeliveryState, int, int) ([]webhook.Delivery, int, error)) *ExternalInitiatorManager_Deliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindExternalInitiatorByName provides a mock function with given fields: ctx, name
 func (_m *ExternalInitiatorManager) FindExternalInitiatorByName(ctx context.Context, name string) (bridges.ExternalInitiator, error) {
 	ret := _m.Called(ctx, name)
@@ -174,6 +244,64 @@ func (_c *ExternalInitiatorManager_Notify_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// ReplayDeliveries provides a mock function with given fields: ctx, ids, externalInitiatorName
+func (_m *ExternalInitiatorManager) ReplayDeliveries(ctx context.Context, ids []int64, externalInitiatorName string) (int64, error) {
+	ret := _m.Called(ctx, ids, externalInitiatorName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayDeliveries")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, string) (int64, error)); ok {
+		return rf(ctx, ids, externalInitiatorName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, string) int64); ok {
+		r0 = rf(ctx, ids, externalInitiatorName)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64, string) error); ok {
+		r1 = rf(ctx, ids, externalInitiatorName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExternalInitiatorManager_ReplayDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayDeliveries'
+type ExternalInitiatorManager_ReplayDeliveries_Call struct {
+	*mock.Call
+}
+
+// ReplayDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int64
+//   - externalInitiatorName string
+func (_e *ExternalInitiatorManager_Expecter) ReplayDeliveries(ctx interface{}, ids interface{}, externalInitiatorName interface{}) *ExternalInitiatorManager_ReplayDeliveries_Call {
+	return &ExternalInitiatorManager_ReplayDeliveries_Call{Call: _e.mock.On("ReplayDeliveries", ctx, ids, externalInitiatorName)}
+}
+
+func (_c *ExternalInitiatorManager_ReplayDeliveries_Call) Run(run func(ctx context.Context, ids []int64, externalInitiatorName string)) *ExternalInitiatorManager_ReplayDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ExternalInitiatorManager_ReplayDeliveries_Call) Return(_a0 int64, _a1 error) *ExternalInitiatorManager_ReplayDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExternalInitiatorManager_ReplayDeliveries_Call) RunAndReturn(run func(context.Context, []int64, string) (int64, error)) *ExternalInitiatorManager_ReplayDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewExternalInitiatorManager creates a new instance of ExternalInitiatorManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewExternalInitiatorManager(t interface {
@@ -0,0 +1,196 @@
+package admin
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/artifacts"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/syncer"
+)
+
+// ErrEngineControlUnavailable is returned when pausing or resuming an engine on a node that does not
+// run the workflow registry syncer.
+var ErrEngineControlUnavailable = errors.New("workflow engines can only be paused and resumed when the workflow registry syncer is enabled")
+
+// ErrWorkflowNotActive is returned when resuming the engine of a workflow which is not active in the registry.
+// The local pause is lifted nonetheless, and the engine starts once the workflow is activated.
+var ErrWorkflowNotActive = syncer.ErrWorkflowNotActive
+
+// Service exposes the workflows, engines and capabilities of this node to operators.
+type Service interface {
+	ListWorkflows(ctx context.Context, offset, limit int) ([]Workflow, int, error)
+	GetWorkflow(ctx context.Context, workflowID string) (Workflow, error)
+	ListExecutions(ctx context.Context, workflowID string, offset, limit int) ([]store.WorkflowExecution, int, error)
+	GetExecution(ctx context.Context, executionID string) (store.WorkflowExecution, error)
+	ListCapabilities(ctx context.Context) ([]capabilities.CapabilityInfo, error)
+
+	// PauseEngine stops the engine of the given workflow on this node only, see syncer.EngineController.
+	PauseEngine(ctx context.Context, workflowID string) (Workflow, error)
+	// ResumeEngine starts the engine of a workflow paused with PauseEngine.
+	ResumeEngine(ctx context.Context, workflowID string) (Workflow, error)
+}
+
+// Workflow is a workflow spec stored on this node along with the state of its engine.
+type Workflow struct {
+	Spec job.WorkflowSpec
+	// Engine is nil if no engine for the workflow is held by the workflow registry syncer
+	Engine *Engine
+	// PausedLocally is true if an operator paused the engine on this node
+	PausedLocally bool
+}
+
+// Engine is the state of a running workflow engine.
+type Engine struct {
+	// WorkflowID is the ID of the workflow version the engine runs
+	WorkflowID string
+	Ready      error
+	Health     map[string]error
+}
+
+type service struct {
+	specs        artifacts.WorkflowSpecsDS
+	executions   store.Store
+	capabilities core.CapabilitiesRegistryBase
+
+	// engines and controller are nil when the workflow registry syncer is not enabled
+	engines    *syncer.EngineRegistry
+	controller syncer.EngineController
+}
+
+var _ Service = (*service)(nil)
+
+// NewService returns a Service. engines and controller may be nil if the workflow registry syncer is not
+// enabled on this node.
+func NewService(
+	specs artifacts.WorkflowSpecsDS,
+	executions store.Store,
+	capabilities core.CapabilitiesRegistryBase,
+	engines *syncer.EngineRegistry,
+	controller syncer.EngineController,
+) Service {
+	return &service{
+		specs:        specs,
+		executions:   executions,
+		capabilities: capabilities,
+		engines:      engines,
+		controller:   controller,
+	}
+}
+
+func (s *service) ListWorkflows(ctx context.Context, offset, limit int) ([]Workflow, int, error) {
+	specs, count, err := s.specs.ListWorkflowSpecs(ctx, offset, limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list workflow specs: %w", err)
+	}
+
+	workflows := make([]Workflow, 0, len(specs))
+	for _, spec := range specs {
+		workflows = append(workflows, s.newWorkflow(spec))
+	}
+	return workflows, count, nil
+}
+
+func (s *service) GetWorkflow(ctx context.Context, workflowID string) (Workflow, error) {
+	spec, err := s.specs.GetWorkflowSpecByID(ctx, workflowID)
+	if err != nil {
+		return Workflow{}, fmt.Errorf("failed to get workflow spec %s: %w", workflowID, err)
+	}
+	return s.newWorkflow(*spec), nil
+}
+
+func (s *service) ListExecutions(ctx context.Context, workflowID string, offset, limit int) ([]store.WorkflowExecution, int, error) {
+	return s.executions.GetRecent(ctx, workflowID, offset, limit)
+}
+
+func (s *service) GetExecution(ctx context.Context, executionID string) (store.WorkflowExecution, error) {
+	return s.executions.Get(ctx, executionID)
+}
+
+// ListCapabilities returns the local and remote capabilities known to this node, sorted by ID.
+func (s *service) ListCapabilities(ctx context.Context) ([]capabilities.CapabilityInfo, error) {
+	caps, err := s.capabilities.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list capabilities: %w", err)
+	}
+
+	infos := make([]capabilities.CapabilityInfo, 0, len(caps))
+	for _, c := range caps {
+		info, err := c.Info(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get capability info: %w", err)
+		}
+		infos = append(infos, info)
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ID < infos[j].ID
+	})
+	return infos, nil
+}
+
+func (s *service) PauseEngine(ctx context.Context, workflowID string) (Workflow, error) {
+	return s.controlEngine(ctx, workflowID, func(owner []byte, name string) error {
+		return s.controller.PauseEngine(ctx, owner, name)
+	})
+}
+
+func (s *service) ResumeEngine(ctx context.Context, workflowID string) (Workflow, error) {
+	return s.controlEngine(ctx, workflowID, func(owner []byte, name string) error {
+		return s.controller.ResumeEngine(ctx, owner, name)
+	})
+}
+
+func (s *service) controlEngine(ctx context.Context, workflowID string, fn func(owner []byte, name string) error) (Workflow, error) {
+	if s.controller == nil {
+		return Workflow{}, ErrEngineControlUnavailable
+	}
+
+	spec, err := s.specs.GetWorkflowSpecByID(ctx, workflowID)
+	if err != nil {
+		return Workflow{}, fmt.Errorf("failed to get workflow spec %s: %w", workflowID, err)
+	}
+
+	owner, err := hex.DecodeString(spec.WorkflowOwner)
+	if err != nil {
+		return Workflow{}, fmt.Errorf("failed to decode workflow owner: %w", err)
+	}
+
+	if err := fn(owner, spec.WorkflowName); err != nil {
+		return Workflow{}, err
+	}
+	return s.newWorkflow(*spec), nil
+}
+
+func (s *service) newWorkflow(spec job.WorkflowSpec) Workflow {
+	wf := Workflow{Spec: spec}
+	if s.engines == nil {
+		return wf
+	}
+
+	owner, err := hex.DecodeString(spec.WorkflowOwner)
+	if err != nil {
+		// Specs of workflow jobs are not required to have a hex encoded owner, and are never held by the syncer.
+		return wf
+	}
+
+	if s.controller != nil {
+		wf.PausedLocally = s.controller.IsPausedLocally(owner, spec.WorkflowName)
+	}
+
+	if e, ok := s.engines.Get(syncer.EngineRegistryKey{Owner: owner, Name: spec.WorkflowName}); ok {
+		wf.Engine = &Engine{
+			WorkflowID: e.WorkflowID.Hex(),
+			Ready:      e.Ready(),
+			Health:     e.HealthReport(),
+		}
+	}
+	return wf
+}
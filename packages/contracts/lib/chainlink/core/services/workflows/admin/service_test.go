@@ -0,0 +1,164 @@
+package admin
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/artifacts"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/syncer"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/types"
+)
+
+type fakeCapabilitiesRegistry struct {
+	core.CapabilitiesRegistryBase
+	caps []capabilities.BaseCapability
+}
+
+func (f *fakeCapabilitiesRegistry) List(context.Context) ([]capabilities.BaseCapability, error) {
+	return f.caps, nil
+}
+
+type fakeCapability struct {
+	info capabilities.CapabilityInfo
+}
+
+func (f fakeCapability) Info(context.Context) (capabilities.CapabilityInfo, error) {
+	return f.info, nil
+}
+
+type fakeEngine struct {
+	healthErr error
+}
+
+func (f fakeEngine) Start(context.Context) error { return nil }
+func (f fakeEngine) Close() error                { return nil }
+func (f fakeEngine) Ready() error                { return nil }
+func (f fakeEngine) Name() string                { return "WorkflowEngine" }
+func (f fakeEngine) HealthReport() map[string]error {
+	return map[string]error{f.Name(): f.healthErr}
+}
+
+type fakeController struct {
+	paused map[string]bool
+}
+
+func (f *fakeController) PauseEngine(_ context.Context, owner []byte, name string) error {
+	f.paused[hex.EncodeToString(owner)+name] = true
+	return nil
+}
+
+func (f *fakeController) ResumeEngine(_ context.Context, owner []byte, name string) error {
+	delete(f.paused, hex.EncodeToString(owner)+name)
+	return nil
+}
+
+func (f *fakeController) IsPausedLocally(owner []byte, name string) bool {
+	return f.paused[hex.EncodeToString(owner)+name]
+}
+
+func setupWorkflowSpecs(t *testing.T) artifacts.WorkflowSpecsDS {
+	ctx := testutils.Context(t)
+	orm := artifacts.NewWorkflowRegistryDS(pgtest.NewSqlxDB(t), logger.TestLogger(t))
+	for _, name := range []string{"workflow-a", "workflow-b"} {
+		_, err := orm.UpsertWorkflowSpec(ctx, &job.WorkflowSpec{
+			Workflow:      "test_workflow",
+			WorkflowID:    "wf-" + name,
+			WorkflowOwner: "0a0b",
+			WorkflowName:  name,
+			Status:        job.WorkflowSpecStatusActive,
+			CreatedAt:     time.Now(),
+			SpecType:      job.WASMFile,
+		})
+		require.NoError(t, err)
+	}
+	return orm
+}
+
+func TestService_Workflows(t *testing.T) {
+	ctx := testutils.Context(t)
+	specs := setupWorkflowSpecs(t)
+	owner := []byte{0x0a, 0x0b}
+
+	engines := syncer.NewEngineRegistry()
+	require.NoError(t, engines.Add(syncer.EngineRegistryKey{Owner: owner, Name: "workflow-a"},
+		fakeEngine{healthErr: errors.New("unhealthy")}, types.WorkflowID{1}))
+	controller := &fakeController{paused: map[string]bool{}}
+	svc := NewService(specs, store.NewInMemoryStore(logger.TestLogger(t), clockwork.NewFakeClock()), &fakeCapabilitiesRegistry{}, engines, controller)
+
+	workflows, count, err := svc.ListWorkflows(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, workflows, 2)
+	assert.Equal(t, "workflow-a", workflows[0].Spec.WorkflowName)
+	require.NotNil(t, workflows[0].Engine)
+	assert.Equal(t, types.WorkflowID{1}.Hex(), workflows[0].Engine.WorkflowID)
+	assert.NoError(t, workflows[0].Engine.Ready)
+	assert.EqualError(t, workflows[0].Engine.Health["WorkflowEngine"], "unhealthy")
+	assert.Equal(t, "workflow-b", workflows[1].Spec.WorkflowName)
+	assert.Nil(t, workflows[1].Engine)
+
+	wf, err := svc.PauseEngine(ctx, "wf-workflow-b")
+	require.NoError(t, err)
+	assert.True(t, wf.PausedLocally)
+
+	wf, err = svc.GetWorkflow(ctx, "wf-workflow-b")
+	require.NoError(t, err)
+	assert.True(t, wf.PausedLocally)
+
+	wf, err = svc.ResumeEngine(ctx, "wf-workflow-b")
+	require.NoError(t, err)
+	assert.False(t, wf.PausedLocally)
+
+	_, err = svc.GetWorkflow(ctx, "unknown")
+	require.Error(t, err)
+	_, err = svc.PauseEngine(ctx, "unknown")
+	require.Error(t, err)
+}
+
+func TestService_EngineControlUnavailable(t *testing.T) {
+	ctx := testutils.Context(t)
+	svc := NewService(setupWorkflowSpecs(t), store.NewInMemoryStore(logger.TestLogger(t), clockwork.NewFakeClock()), &fakeCapabilitiesRegistry{}, nil, nil)
+
+	wf, err := svc.GetWorkflow(ctx, "wf-workflow-a")
+	require.NoError(t, err)
+	assert.Nil(t, wf.Engine)
+	assert.False(t, wf.PausedLocally)
+
+	_, err = svc.PauseEngine(ctx, "wf-workflow-a")
+	require.ErrorIs(t, err, ErrEngineControlUnavailable)
+	_, err = svc.ResumeEngine(ctx, "wf-workflow-a")
+	require.ErrorIs(t, err, ErrEngineControlUnavailable)
+}
+
+func TestService_ListCapabilities(t *testing.T) {
+	ctx := testutils.Context(t)
+	don := &capabilities.DON{ID: 2, F: 1}
+	registry := &fakeCapabilitiesRegistry{caps: []capabilities.BaseCapability{
+		fakeCapability{info: capabilities.CapabilityInfo{ID: "write_chain@1.0.0", CapabilityType: capabilities.CapabilityTypeTarget, DON: don}},
+		fakeCapability{info: capabilities.CapabilityInfo{ID: "cron-trigger@1.0.0", CapabilityType: capabilities.CapabilityTypeTrigger, IsLocal: true}},
+	}}
+	svc := NewService(setupWorkflowSpecs(t), store.NewInMemoryStore(logger.TestLogger(t), clockwork.NewFakeClock()), registry, nil, nil)
+
+	infos, err := svc.ListCapabilities(ctx)
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+	assert.Equal(t, "cron-trigger@1.0.0", infos[0].ID)
+	assert.True(t, infos[0].IsLocal)
+	assert.Equal(t, "write_chain@1.0.0", infos[1].ID)
+	assert.Equal(t, don, infos[1].DON)
+}
@@ -55,6 +55,9 @@ type WorkflowSpecsDS interface {
 
 	// GetWorkflowSpecByID returns the workflow spec for the given workflowID.
 	GetWorkflowSpecByID(ctx context.Context, id string) (*job.WorkflowSpec, error)
+
+	// ListWorkflowSpecs returns a page of workflow specs ordered by owner and name, and the total number of specs.
+	ListWorkflowSpecs(ctx context.Context, offset, limit int) ([]job.WorkflowSpec, int, error)
 }
 
 type ORM interface {
@@ -405,6 +408,27 @@ func (orm *orm) GetWorkflowSpecByID(ctx context.Context, id string) (*job.Workfl
 	return &spec, nil
 }
 
+func (orm *orm) ListWorkflowSpecs(ctx context.Context, offset, limit int) ([]job.WorkflowSpec, int, error) {
+	var count int
+	if err := orm.ds.GetContext(ctx, &count, `SELECT count(*) FROM workflow_specs`); err != nil {
+		return nil, 0, err
+	}
+
+	query := `
+		SELECT *
+		FROM workflow_specs
+		ORDER BY workflow_owner, workflow_name, id
+		LIMIT $1 OFFSET $2
+	`
+
+	var specs []job.WorkflowSpec
+	if err := orm.ds.SelectContext(ctx, &specs, query, limit, offset); err != nil {
+		return nil, 0, err
+	}
+
+	return specs, count, nil
+}
+
 func (orm *orm) DeleteWorkflowSpec(ctx context.Context, owner, name string) error {
 	query := `
 		DELETE FROM workflow_specs
@@ -238,6 +238,42 @@ func Test_GetWorkflowSpecByID(t *testing.T) {
 	})
 }
 
+func Test_ListWorkflowSpecs(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	ctx := testutils.Context(t)
+	lggr := logger.TestLogger(t)
+	orm := &orm{ds: db, lggr: lggr}
+
+	for _, name := range []string{"workflow-b", "workflow-a", "workflow-c"} {
+		_, err := orm.UpsertWorkflowSpec(ctx, &job.WorkflowSpec{
+			Workflow:      "test_workflow",
+			Config:        "test_config",
+			WorkflowID:    "cid-" + name,
+			WorkflowOwner: "owner-123",
+			WorkflowName:  name,
+			Status:        job.WorkflowSpecStatusActive,
+			BinaryURL:     "http://example.com/binary",
+			ConfigURL:     "http://example.com/config",
+			CreatedAt:     time.Now(),
+			SpecType:      job.WASMFile,
+		})
+		require.NoError(t, err)
+	}
+
+	specs, count, err := orm.ListWorkflowSpecs(ctx, 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, specs, 2)
+	assert.Equal(t, "workflow-a", specs[0].WorkflowName)
+	assert.Equal(t, "workflow-b", specs[1].WorkflowName)
+
+	specs, count, err = orm.ListWorkflowSpecs(ctx, 2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, specs, 1)
+	assert.Equal(t, "workflow-c", specs[0].WorkflowName)
+}
+
 func Test_GetContentsByWorkflowID(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	ctx := testutils.Context(t)
@@ -40,6 +40,7 @@ const (
 	fifteenMinutesSec            = 15 * 60
 	reservedFieldNameStepTimeout = "cre_step_timeout"
 	maxStepTimeoutOverrideSec    = 10 * 60 // 10 minutes
+	resumeExecutionsBatchSize    = 100
 )
 
 type stepRequest struct {
@@ -366,6 +367,13 @@ func (e *Engine) init(ctx context.Context) {
 
 	e.logger.Debug("capabilities resolved")
 
+	e.logger.Debug("resuming in-progress executions")
+	err := e.resumeInProgressExecutions(ctx)
+	if err != nil {
+		e.logger.Errorf("failed to resume in-progress executions: %v", err)
+		logCustMsg(ctx, e.cma, fmt.Sprintf("failed to resume in-progress executions: %s", err), e.logger)
+	}
+
 	e.logger.Debug("registering triggers")
 	for idx, t := range e.workflow.triggers {
 		terr := e.registerTrigger(ctx, t, idx)
@@ -382,6 +390,87 @@ func (e *Engine) init(ctx context.Context) {
 	e.afterInit(true)
 }
 
+// resumeInProgressExecutions picks up the executions of this workflow that were still in flight when the
+// node last stopped, similar to how the pipeline runner resumes unfinished runs on startup.
+//
+// Executions that have exceeded the maximum execution duration are finished with a timeout status. For the others,
+// every step that has not been processed yet but whose dependencies have all completed is enqueued again.
+func (e *Engine) resumeInProgressExecutions(ctx context.Context) error {
+	// load all unfinished executions before resuming any of them, since resuming may finish an execution and
+	// shift the offsets of the remaining ones.
+	var unfinished []store.WorkflowExecution
+	for offset := 0; ; offset += resumeExecutionsBatchSize {
+		executions, err := e.executionsStore.GetUnfinished(ctx, e.workflow.id, offset, resumeExecutionsBatchSize)
+		if err != nil {
+			return err
+		}
+		unfinished = append(unfinished, executions...)
+		if len(executions) < resumeExecutionsBatchSize {
+			break
+		}
+	}
+
+	if len(unfinished) > 0 {
+		e.logger.Infow("found unfinished executions to resume", "count", len(unfinished))
+	}
+
+	for _, execution := range unfinished {
+		err := e.resumeExecution(ctx, execution)
+		if err != nil {
+			e.logger.With(platform.KeyWorkflowExecutionID, execution.ExecutionID).Errorf("failed to resume execution: %v", err)
+		}
+	}
+	return nil
+}
+
+func (e *Engine) resumeExecution(ctx context.Context, execution store.WorkflowExecution) error {
+	l := e.logger.With(platform.KeyWorkflowExecutionID, execution.ExecutionID)
+	cma := e.cma.With(platform.KeyWorkflowExecutionID, execution.ExecutionID)
+
+	ch := make(chan store.WorkflowExecutionStep)
+	added := e.stepUpdatesChMap.add(execution.ExecutionID, stepUpdateChannel{
+		ch:          ch,
+		executionID: execution.ExecutionID,
+	})
+	if !added {
+		l.Debug("won't resume execution, execution was already started")
+		return nil
+	}
+
+	// metering state is not persisted, so resumed executions are metered from this point onwards only
+	if _, ok := e.meterReports.Get(execution.ExecutionID); !ok {
+		e.meterReports.Add(execution.ExecutionID, metering.NewReport(e.logger))
+	}
+
+	e.wg.Add(1)
+	go e.stepUpdateLoop(ctx, execution.ExecutionID, ch, execution.CreatedAt)
+
+	if execution.CreatedAt != nil && e.clock.Since(*execution.CreatedAt) > e.maxExecutionDuration {
+		l.Info("execution exceeded the maximum execution duration while the engine was stopped")
+		return e.finishExecution(ctx, cma, execution.ExecutionID, store.StatusTimeout)
+	}
+
+	// the node may have stopped after persisting the last step but before marking the execution as finished
+	workflowIsFullyProcessed, status, err := e.isWorkflowFullyProcessed(ctx, execution)
+	if err != nil {
+		return err
+	}
+	if workflowIsFullyProcessed {
+		return e.finishExecution(ctx, cma, execution.ExecutionID, status)
+	}
+
+	l.Info("resuming execution")
+	logCustMsg(ctx, cma, "execution resumed", l)
+	return e.workflow.walkDo(workflows.KeywordTrigger, func(s *step) error {
+		// steps present in the state have already been processed
+		if _, ok := execution.Steps[s.Ref]; ok {
+			return nil
+		}
+		e.queueIfReady(execution, s)
+		return nil
+	})
+}
+
 func generateTriggerID(workflowID string, triggerIdx int) string {
 	return fmt.Sprintf("wf_%s_trigger_%d", workflowID, triggerIdx)
 }
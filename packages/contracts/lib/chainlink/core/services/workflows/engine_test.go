@@ -1006,6 +1006,76 @@ func TestEngine_ErrorsTheWorkflowIfAStepErrors(t *testing.T) {
 	assert.Equal(t, store.StatusErrored, state.Steps["evm_median"].Status)
 }
 
+func TestEngine_ResumesUnfinishedExecutions(t *testing.T) {
+	t.Parallel()
+
+	newResumableEngine := func(t *testing.T, clock clockwork.FakeClock, executionsStore store.Store) (*Engine, *testHooks) {
+		ctx := testutils.Context(t)
+		reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+		trigger, _ := mockTrigger(t)
+		// the trigger never fires, so the only execution is the one found in the store
+		trigger.(*mockTriggerCapability).triggerEvent = nil
+
+		require.NoError(t, reg.Add(ctx, trigger))
+		require.NoError(t, reg.Add(ctx, mockConsensus("")))
+		require.NoError(t, reg.Add(ctx, mockTarget("")))
+
+		return newTestEngineWithYAMLSpec(t, reg, simpleWorkflow, func(c *Config) {
+			c.Store = executionsStore
+			c.clock = clock
+		})
+	}
+
+	addUnfinishedExecution := func(t *testing.T, executionsStore store.Store, executionID string) {
+		_, tr := mockTrigger(t)
+		_, err := executionsStore.Add(testutils.Context(t), map[string]*store.WorkflowExecutionStep{
+			workflows.KeywordTrigger: {
+				ExecutionID: executionID,
+				Ref:         workflows.KeywordTrigger,
+				Status:      store.StatusCompleted,
+				Outputs:     store.StepOutput{Value: tr.Event.Outputs},
+			},
+		}, executionID, testWorkflowID, store.StatusStarted)
+		require.NoError(t, err)
+	}
+
+	t.Run("resumes execution from the last completed step", func(t *testing.T) {
+		clock := clockwork.NewFakeClock()
+		executionsStore := store.NewInMemoryStore(logger.TestLogger(t), clock)
+		addUnfinishedExecution(t, executionsStore, "unfinished-execution")
+
+		eng, hooks := newResumableEngine(t, clock, executionsStore)
+		servicetest.Run(t, eng)
+
+		eid := getExecutionID(t, eng, hooks)
+		assert.Equal(t, "unfinished-execution", eid)
+
+		state, err := eng.executionsStore.Get(testutils.Context(t), eid)
+		require.NoError(t, err)
+		assert.Equal(t, store.StatusCompleted, state.Status)
+		assert.Equal(t, store.StatusCompleted, state.Steps["evm_median"].Status)
+	})
+
+	t.Run("times out execution older than the maximum execution duration", func(t *testing.T) {
+		clock := clockwork.NewFakeClock()
+		executionsStore := store.NewInMemoryStore(logger.TestLogger(t), clock)
+		addUnfinishedExecution(t, executionsStore, "expired-execution")
+		clock.Advance(defaultMaxExecutionDuration + time.Minute)
+
+		eng, hooks := newResumableEngine(t, clock, executionsStore)
+		servicetest.Run(t, eng)
+
+		eid := getExecutionID(t, eng, hooks)
+		assert.Equal(t, "expired-execution", eid)
+
+		state, err := eng.executionsStore.Get(testutils.Context(t), eid)
+		require.NoError(t, err)
+		assert.Equal(t, store.StatusTimeout, state.Status)
+		assert.Nil(t, state.Steps["evm_median"])
+	})
+}
+
 func TestEngine_GracefulEarlyTermination(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
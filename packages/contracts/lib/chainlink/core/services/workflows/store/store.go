@@ -10,6 +10,13 @@ type Store interface {
 	UpsertStep(ctx context.Context, step *WorkflowExecutionStep) (WorkflowExecution, error)
 	FinishExecution(ctx context.Context, executionID string, status string) (WorkflowExecution, error)
 	Get(ctx context.Context, executionID string) (WorkflowExecution, error)
+	// GetUnfinished returns the executions of the given workflow that have not yet reached a terminal status,
+	// ordered by creation time.
+	GetUnfinished(ctx context.Context, workflowID string, offset, limit int) ([]WorkflowExecution, error)
+	// GetRecent returns the executions of the given workflow ordered from newest to oldest, along with the
+	// total number of executions stored for the workflow.
+	GetRecent(ctx context.Context, workflowID string, offset, limit int) ([]WorkflowExecution, int, error)
 }
 
 var _ Store = (*InMemoryStore)(nil)
+var _ Store = (*DBStore)(nil)
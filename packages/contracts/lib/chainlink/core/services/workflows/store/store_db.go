@@ -0,0 +1,426 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commonservices "github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink-common/pkg/values/pb"
+)
+
+// DBStore is a Postgres backed implementation of the Store interface used to store workflow execution states.
+// Unlike the InMemoryStore, executions survive a node restart, which allows the engine to resume (or cleanly fail)
+// executions that were in flight when the node went down.
+type DBStore struct {
+	lggr logger.Logger
+	commonservices.StateMachine
+	ds                sqlutil.DataSource
+	shutdownWaitGroup sync.WaitGroup
+	chStop            commonservices.StopChan
+
+	clock clockwork.Clock
+
+	// pruneInterval is the interval between pruning finished (and expired) executions
+	pruneInterval time.Duration
+
+	// maximumExecutionAge is the maximum age of an execution before it is considered expired and eligible for pruning
+	// regardless of its status
+	maximumExecutionAge time.Duration
+}
+
+// workflowExecutionRow is the row representation of a WorkflowExecution in the workflow_executions table
+type workflowExecutionRow struct {
+	ID         string     `db:"id"`
+	WorkflowID *string    `db:"workflow_id"`
+	Status     string     `db:"status"`
+	CreatedAt  *time.Time `db:"created_at"`
+	UpdatedAt  *time.Time `db:"updated_at"`
+	FinishedAt *time.Time `db:"finished_at"`
+}
+
+// workflowStepRow is the row representation of a WorkflowExecutionStep in the workflow_steps table
+type workflowStepRow struct {
+	ID                  uint32         `db:"id"`
+	WorkflowExecutionID string         `db:"workflow_execution_id"`
+	Ref                 string         `db:"ref"`
+	Status              string         `db:"status"`
+	Inputs              []byte         `db:"inputs"`
+	OutputErr           sql.NullString `db:"output_err"`
+	OutputValue         []byte         `db:"output_value"`
+	UpdatedAt           *time.Time     `db:"updated_at"`
+}
+
+func NewDBStore(ds sqlutil.DataSource, lggr logger.Logger, clock clockwork.Clock) *DBStore {
+	return NewDBStoreWithPruneConfiguration(ds, lggr, clock, defaultPruneInterval, maximumExecutionAge)
+}
+
+func NewDBStoreWithPruneConfiguration(ds sqlutil.DataSource, lggr logger.Logger, clock clockwork.Clock,
+	pruneFrequency time.Duration, maximumExecutionAge time.Duration) *DBStore {
+	return &DBStore{ds: ds, lggr: logger.Named(lggr, "WorkflowDBStore"), clock: clock, chStop: make(chan struct{}),
+		pruneInterval: pruneFrequency, maximumExecutionAge: maximumExecutionAge}
+}
+
+// Add adds a new execution state under the given executionID
+func (d *DBStore) Add(ctx context.Context, steps map[string]*WorkflowExecutionStep,
+	executionID string, workflowID string, status string) (WorkflowExecution, error) {
+	now := d.clock.Now()
+	var execution WorkflowExecution
+	err := sqlutil.TransactDataSource(ctx, d.ds, nil, func(tx sqlutil.DataSource) error {
+		res, err := tx.ExecContext(ctx, `
+			INSERT INTO workflow_executions (id, workflow_id, status, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $4)
+			ON CONFLICT (id) DO NOTHING`,
+			executionID, workflowID, status, now,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert execution %s: %w", executionID, err)
+		}
+		inserted, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if inserted == 0 {
+			return fmt.Errorf("execution ID %s already exists in store", executionID)
+		}
+
+		for _, step := range steps {
+			if err = upsertStep(ctx, tx, step, now); err != nil {
+				return err
+			}
+		}
+
+		execution, err = get(ctx, tx, executionID)
+		return err
+	})
+	return execution, err
+}
+
+// UpsertStep updates a step for the given executionID
+func (d *DBStore) UpsertStep(ctx context.Context, step *WorkflowExecutionStep) (WorkflowExecution, error) {
+	now := d.clock.Now()
+	var execution WorkflowExecution
+	err := sqlutil.TransactDataSource(ctx, d.ds, nil, func(tx sqlutil.DataSource) error {
+		res, err := tx.ExecContext(ctx, `UPDATE workflow_executions SET updated_at = $2 WHERE id = $1`, step.ExecutionID, now)
+		if err != nil {
+			return fmt.Errorf("failed to update execution %s: %w", step.ExecutionID, err)
+		}
+		updated, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if updated == 0 {
+			return fmt.Errorf("could not find execution %s", step.ExecutionID)
+		}
+
+		if err = upsertStep(ctx, tx, step, now); err != nil {
+			return err
+		}
+
+		execution, err = get(ctx, tx, step.ExecutionID)
+		return err
+	})
+	return execution, err
+}
+
+// FinishExecution marks the execution as finished with the given status
+func (d *DBStore) FinishExecution(ctx context.Context, executionID string, status string) (WorkflowExecution, error) {
+	if !isCompletedStatus(status) {
+		return WorkflowExecution{}, fmt.Errorf("invalid status for a finished execution %s", status)
+	}
+
+	now := d.clock.Now()
+	var execution WorkflowExecution
+	err := sqlutil.TransactDataSource(ctx, d.ds, nil, func(tx sqlutil.DataSource) error {
+		res, err := tx.ExecContext(ctx, `
+			UPDATE workflow_executions SET status = $2, updated_at = $3, finished_at = $3
+			WHERE id = $1`,
+			executionID, status, now,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to finish execution %s: %w", executionID, err)
+		}
+		updated, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if updated == 0 {
+			return fmt.Errorf("could not find execution %s", executionID)
+		}
+
+		execution, err = get(ctx, tx, executionID)
+		return err
+	})
+	return execution, err
+}
+
+// Get gets the state for the given executionID
+func (d *DBStore) Get(ctx context.Context, executionID string) (WorkflowExecution, error) {
+	return get(ctx, d.ds, executionID)
+}
+
+// GetUnfinished gets the executions for the given workflowID that have not yet finished
+func (d *DBStore) GetUnfinished(ctx context.Context, workflowID string, offset, limit int) ([]WorkflowExecution, error) {
+	var ids []string
+	q := `SELECT id FROM workflow_executions WHERE workflow_id = $1 AND status = $2 ORDER BY created_at ASC, id ASC OFFSET $3`
+	args := []any{workflowID, StatusStarted, offset}
+	if limit > 0 {
+		q += ` LIMIT $4`
+		args = append(args, limit)
+	}
+	if err := d.ds.SelectContext(ctx, &ids, q, args...); err != nil {
+		return nil, fmt.Errorf("failed to get unfinished executions for workflow %s: %w", workflowID, err)
+	}
+
+	executions := make([]WorkflowExecution, 0, len(ids))
+	for _, id := range ids {
+		execution, err := get(ctx, d.ds, id)
+		if err != nil {
+			return nil, err
+		}
+		executions = append(executions, execution)
+	}
+	return executions, nil
+}
+
+// GetRecent gets the executions for the given workflowID, newest first
+func (d *DBStore) GetRecent(ctx context.Context, workflowID string, offset, limit int) ([]WorkflowExecution, int, error) {
+	var count int
+	if err := d.ds.GetContext(ctx, &count, `SELECT count(*) FROM workflow_executions WHERE workflow_id = $1`, workflowID); err != nil {
+		return nil, 0, fmt.Errorf("failed to count executions for workflow %s: %w", workflowID, err)
+	}
+
+	var ids []string
+	q := `SELECT id FROM workflow_executions WHERE workflow_id = $1 ORDER BY created_at DESC, id DESC OFFSET $2`
+	args := []any{workflowID, offset}
+	if limit > 0 {
+		q += ` LIMIT $3`
+		args = append(args, limit)
+	}
+	if err := d.ds.SelectContext(ctx, &ids, q, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to get executions for workflow %s: %w", workflowID, err)
+	}
+
+	executions := make([]WorkflowExecution, 0, len(ids))
+	for _, id := range ids {
+		execution, err := get(ctx, d.ds, id)
+		if err != nil {
+			return nil, 0, err
+		}
+		executions = append(executions, execution)
+	}
+	return executions, count, nil
+}
+
+func (d *DBStore) Start(context.Context) error {
+	return d.StartOnce("DBStore", func() error {
+		d.shutdownWaitGroup.Add(1)
+		go d.pruneExpiredExecutionEntries()
+		return nil
+	})
+}
+
+func (d *DBStore) Close() error {
+	return d.StopOnce("DBStore", func() error {
+		close(d.chStop)
+		d.shutdownWaitGroup.Wait()
+		return nil
+	})
+}
+
+func (d *DBStore) Ready() error {
+	return nil
+}
+
+func (d *DBStore) HealthReport() map[string]error {
+	return map[string]error{d.Name(): d.Healthy()}
+}
+
+func (d *DBStore) Name() string {
+	return d.lggr.Name()
+}
+
+// pruneExpiredExecutionEntries mirrors the InMemoryStore pruning: finished executions and non-terminated executions
+// that have not been updated within the maximum execution age are removed. Finished executions are kept for the
+// maximum execution age so that they can still be inspected after completion.
+func (d *DBStore) pruneExpiredExecutionEntries() {
+	defer d.shutdownWaitGroup.Done()
+	ctx, cancel := d.chStop.NewCtx()
+	defer cancel()
+	ticker := d.clock.NewTicker(d.pruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-d.chStop:
+			return
+		case <-ticker.Chan():
+			expirationTime := d.clock.Now().Add(-d.maximumExecutionAge)
+			res, err := d.ds.ExecContext(ctx, `DELETE FROM workflow_executions WHERE status <> $1 AND finished_at < $2`,
+				StatusStarted, expirationTime)
+			if err != nil {
+				d.lggr.Errorw("Failed to prune finished workflow executions", "err", err)
+				continue
+			}
+			if pruned, rerr := res.RowsAffected(); rerr == nil && pruned > 0 {
+				d.lggr.Debugw("Pruned finished workflow executions", "count", pruned)
+			}
+
+			// Prune non-terminated executions that are older than the maximum expiration time.
+			// These are executions that no engine has picked up since the node restarted.
+			var prunedNonTerminatedExecutionIDs []string
+			err = d.ds.SelectContext(ctx, &prunedNonTerminatedExecutionIDs,
+				`DELETE FROM workflow_executions WHERE status = $1 AND updated_at < $2 RETURNING id`,
+				StatusStarted, expirationTime)
+			if err != nil {
+				d.lggr.Errorw("Failed to prune expired workflow executions", "err", err)
+				continue
+			}
+			if len(prunedNonTerminatedExecutionIDs) > 0 {
+				d.lggr.Warnw("Found and pruned non completed workflow executions older than the maximum execution age",
+					"maximumExecutionAge", d.maximumExecutionAge, "pruned execution ids", prunedNonTerminatedExecutionIDs)
+			}
+		}
+	}
+}
+
+func upsertStep(ctx context.Context, ds sqlutil.DataSource, step *WorkflowExecutionStep, now time.Time) error {
+	row, err := stepToRow(step)
+	if err != nil {
+		return fmt.Errorf("failed to serialize step %s for execution %s: %w", step.Ref, step.ExecutionID, err)
+	}
+	row.UpdatedAt = &now
+
+	_, err = ds.ExecContext(ctx, `
+		INSERT INTO workflow_steps (workflow_execution_id, ref, status, inputs, output_err, output_value, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (workflow_execution_id, ref) DO UPDATE SET
+			status = EXCLUDED.status,
+			inputs = EXCLUDED.inputs,
+			output_err = EXCLUDED.output_err,
+			output_value = EXCLUDED.output_value,
+			updated_at = EXCLUDED.updated_at`,
+		row.WorkflowExecutionID, row.Ref, row.Status, row.Inputs, row.OutputErr, row.OutputValue, row.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upsert step %s for execution %s: %w", step.Ref, step.ExecutionID, err)
+	}
+	return nil
+}
+
+func get(ctx context.Context, ds sqlutil.DataSource, executionID string) (WorkflowExecution, error) {
+	var execRow workflowExecutionRow
+	err := ds.GetContext(ctx, &execRow, `SELECT * FROM workflow_executions WHERE id = $1`, executionID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return WorkflowExecution{}, fmt.Errorf("could not find execution %s", executionID)
+	} else if err != nil {
+		return WorkflowExecution{}, fmt.Errorf("failed to get execution %s: %w", executionID, err)
+	}
+
+	var stepRows []workflowStepRow
+	err = ds.SelectContext(ctx, &stepRows, `SELECT * FROM workflow_steps WHERE workflow_execution_id = $1`, executionID)
+	if err != nil {
+		return WorkflowExecution{}, fmt.Errorf("failed to get steps for execution %s: %w", executionID, err)
+	}
+
+	steps := make(map[string]*WorkflowExecutionStep, len(stepRows))
+	for _, row := range stepRows {
+		step, err := rowToStep(row)
+		if err != nil {
+			return WorkflowExecution{}, fmt.Errorf("failed to deserialize step %s for execution %s: %w", row.Ref, executionID, err)
+		}
+		steps[step.Ref] = step
+	}
+
+	var workflowID string
+	if execRow.WorkflowID != nil {
+		workflowID = *execRow.WorkflowID
+	}
+
+	return WorkflowExecution{
+		Steps:       steps,
+		ExecutionID: execRow.ID,
+		WorkflowID:  workflowID,
+		Status:      execRow.Status,
+		CreatedAt:   execRow.CreatedAt,
+		UpdatedAt:   execRow.UpdatedAt,
+		FinishedAt:  execRow.FinishedAt,
+	}, nil
+}
+
+func stepToRow(step *WorkflowExecutionStep) (workflowStepRow, error) {
+	row := workflowStepRow{
+		WorkflowExecutionID: step.ExecutionID,
+		Ref:                 step.Ref,
+		Status:              step.Status,
+	}
+
+	if step.Inputs != nil {
+		inputs, err := proto.Marshal(values.ProtoMap(step.Inputs))
+		if err != nil {
+			return workflowStepRow{}, fmt.Errorf("failed to marshal inputs: %w", err)
+		}
+		row.Inputs = inputs
+	}
+
+	if step.Outputs.Value != nil {
+		outputs, err := proto.Marshal(values.Proto(step.Outputs.Value))
+		if err != nil {
+			return workflowStepRow{}, fmt.Errorf("failed to marshal outputs: %w", err)
+		}
+		row.OutputValue = outputs
+	}
+
+	if step.Outputs.Err != nil {
+		row.OutputErr = sql.NullString{String: step.Outputs.Err.Error(), Valid: true}
+	}
+
+	return row, nil
+}
+
+func rowToStep(row workflowStepRow) (*WorkflowExecutionStep, error) {
+	step := &WorkflowExecutionStep{
+		ExecutionID: row.WorkflowExecutionID,
+		Ref:         row.Ref,
+		Status:      row.Status,
+		UpdatedAt:   row.UpdatedAt,
+	}
+
+	if len(row.Inputs) > 0 {
+		inputsProto := &pb.Map{}
+		if err := proto.Unmarshal(row.Inputs, inputsProto); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal inputs: %w", err)
+		}
+		inputs, err := values.FromMapValueProto(inputsProto)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode inputs: %w", err)
+		}
+		step.Inputs = inputs
+	}
+
+	if len(row.OutputValue) > 0 {
+		outputsProto := &pb.Value{}
+		if err := proto.Unmarshal(row.OutputValue, outputsProto); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal outputs: %w", err)
+		}
+		outputs, err := values.FromProto(outputsProto)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode outputs: %w", err)
+		}
+		step.Outputs.Value = outputs
+	}
+
+	if row.OutputErr.Valid {
+		step.Outputs.Err = errors.New(row.OutputErr.String)
+	}
+
+	return step, nil
+}
@@ -0,0 +1,187 @@
+package store
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func TestDBStore_Add(t *testing.T) {
+	ctx := testutils.Context(t)
+	store := NewDBStore(pgtest.NewSqlxDB(t), logger.TestLogger(t), clockwork.NewFakeClock())
+
+	outputs, err := values.NewMap(map[string]any{"price": 100})
+	require.NoError(t, err)
+
+	execution, err := store.Add(ctx, map[string]*WorkflowExecutionStep{
+		"trigger": {ExecutionID: "test-id", Ref: "trigger", Status: StatusCompleted, Outputs: StepOutput{Value: outputs}},
+	}, "test-id", "w1", StatusStarted)
+	require.NoError(t, err)
+	assert.NotZero(t, execution.CreatedAt)
+	assert.NotZero(t, execution.UpdatedAt)
+	assert.Equal(t, "test-id", execution.ExecutionID)
+	assert.Equal(t, "w1", execution.WorkflowID)
+	assert.Equal(t, StatusStarted, execution.Status)
+	require.Len(t, execution.Steps, 1)
+	assert.Equal(t, StatusCompleted, execution.Steps["trigger"].Status)
+	assert.Equal(t, outputs, execution.Steps["trigger"].Outputs.Value)
+
+	// Try adding the same execution ID again
+	_, err = store.Add(ctx, map[string]*WorkflowExecutionStep{}, "test-id", "w1", StatusStarted)
+	assert.Error(t, err)
+}
+
+func TestDBStore_UpsertStep(t *testing.T) {
+	ctx := testutils.Context(t)
+	fakeClock := clockwork.NewFakeClock()
+	store := NewDBStore(pgtest.NewSqlxDB(t), logger.TestLogger(t), fakeClock)
+
+	initialState, err := store.Add(ctx, map[string]*WorkflowExecutionStep{}, "test-id", "w1", StatusStarted)
+	require.NoError(t, err)
+	fakeClock.Advance(1 * time.Hour)
+
+	inputs, err := values.NewMap(map[string]any{"observations": []any{"a", "b"}})
+	require.NoError(t, err)
+	step := &WorkflowExecutionStep{ExecutionID: "test-id", Ref: "step-1", Status: StatusErrored, Inputs: inputs,
+		Outputs: StepOutput{Err: errors.New("step failed")}}
+	updatedState, err := store.UpsertStep(ctx, step)
+	require.NoError(t, err)
+	require.Contains(t, updatedState.Steps, "step-1")
+	assert.Equal(t, StatusErrored, updatedState.Steps["step-1"].Status)
+	assert.Equal(t, inputs, updatedState.Steps["step-1"].Inputs)
+	assert.EqualError(t, updatedState.Steps["step-1"].Outputs.Err, "step failed")
+	assert.True(t, updatedState.UpdatedAt.After(*initialState.UpdatedAt))
+
+	// Upserting the same step again overwrites it
+	step.Status = StatusCompleted
+	step.Outputs = StepOutput{Value: values.NewString("output")}
+	updatedState, err = store.UpsertStep(ctx, step)
+	require.NoError(t, err)
+	assert.Equal(t, StatusCompleted, updatedState.Steps["step-1"].Status)
+	assert.NoError(t, updatedState.Steps["step-1"].Outputs.Err)
+	assert.Equal(t, values.NewString("output"), updatedState.Steps["step-1"].Outputs.Value)
+
+	_, err = store.UpsertStep(ctx, &WorkflowExecutionStep{ExecutionID: "unknown-id", Ref: "step-1"})
+	assert.Error(t, err)
+}
+
+func TestDBStore_FinishExecution(t *testing.T) {
+	ctx := testutils.Context(t)
+	store := NewDBStore(pgtest.NewSqlxDB(t), logger.TestLogger(t), clockwork.NewFakeClock())
+
+	_, err := store.Add(ctx, map[string]*WorkflowExecutionStep{}, "test-id", "w1", StatusStarted)
+	require.NoError(t, err)
+
+	_, err = store.FinishExecution(ctx, "test-id", StatusStarted)
+	require.Error(t, err)
+
+	updatedState, err := store.FinishExecution(ctx, "test-id", StatusCompleted)
+	require.NoError(t, err)
+	assert.Equal(t, StatusCompleted, updatedState.Status)
+	assert.NotNil(t, updatedState.FinishedAt)
+
+	_, err = store.FinishExecution(ctx, "unknown-id", StatusCompleted)
+	assert.Error(t, err)
+}
+
+func TestDBStore_GetUnfinished(t *testing.T) {
+	ctx := testutils.Context(t)
+	fakeClock := clockwork.NewFakeClock()
+	store := NewDBStore(pgtest.NewSqlxDB(t), logger.TestLogger(t), fakeClock)
+
+	for _, id := range []string{"exec-1", "exec-2", "exec-3"} {
+		_, err := store.Add(ctx, map[string]*WorkflowExecutionStep{}, id, "w1", StatusStarted)
+		require.NoError(t, err)
+		fakeClock.Advance(time.Second)
+	}
+	_, err := store.Add(ctx, map[string]*WorkflowExecutionStep{}, "exec-other", "w2", StatusStarted)
+	require.NoError(t, err)
+	_, err = store.FinishExecution(ctx, "exec-2", StatusCompleted)
+	require.NoError(t, err)
+
+	unfinished, err := store.GetUnfinished(ctx, "w1", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, unfinished, 2)
+	assert.Equal(t, "exec-1", unfinished[0].ExecutionID)
+	assert.Equal(t, "exec-3", unfinished[1].ExecutionID)
+
+	unfinished, err = store.GetUnfinished(ctx, "w1", 1, 10)
+	require.NoError(t, err)
+	require.Len(t, unfinished, 1)
+	assert.Equal(t, "exec-3", unfinished[0].ExecutionID)
+}
+
+func TestDBStore_PrunesExpiredExecutions(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	fakeClock := clockwork.NewFakeClock()
+	store := NewDBStoreWithPruneConfiguration(db, logger.TestLogger(t), fakeClock, 10*time.Millisecond, time.Hour)
+
+	_, err := store.Add(ctx, map[string]*WorkflowExecutionStep{
+		"step-1": {ExecutionID: "finished-id", Ref: "step-1", Status: StatusCompleted},
+	}, "finished-id", "w1", StatusStarted)
+	require.NoError(t, err)
+	_, err = store.FinishExecution(ctx, "finished-id", StatusCompleted)
+	require.NoError(t, err)
+	_, err = store.Add(ctx, map[string]*WorkflowExecutionStep{}, "stale-id", "w1", StatusStarted)
+	require.NoError(t, err)
+
+	fakeClock.Advance(2 * time.Hour)
+	_, err = store.Add(ctx, map[string]*WorkflowExecutionStep{}, "recent-id", "w1", StatusStarted)
+	require.NoError(t, err)
+
+	servicetest.Run(t, store)
+	require.Eventually(t, func() bool {
+		fakeClock.Advance(10 * time.Millisecond)
+		_, err1 := store.Get(ctx, "finished-id")
+		_, err2 := store.Get(ctx, "stale-id")
+		return err1 != nil && err2 != nil
+	}, 10*time.Second, 50*time.Millisecond)
+
+	_, err = store.Get(ctx, "recent-id")
+	require.NoError(t, err)
+
+	var steps int
+	require.NoError(t, db.GetContext(ctx, &steps, `SELECT count(*) FROM workflow_steps WHERE workflow_execution_id = 'finished-id'`))
+	assert.Zero(t, steps)
+}
+
+func TestDBStore_GetRecent(t *testing.T) {
+	ctx := testutils.Context(t)
+	fakeClock := clockwork.NewFakeClock()
+	store := NewDBStore(pgtest.NewSqlxDB(t), logger.TestLogger(t), fakeClock)
+
+	for _, id := range []string{"exec-1", "exec-2", "exec-3"} {
+		_, err := store.Add(ctx, map[string]*WorkflowExecutionStep{}, id, "w1", StatusStarted)
+		require.NoError(t, err)
+		fakeClock.Advance(time.Second)
+	}
+	_, err := store.Add(ctx, map[string]*WorkflowExecutionStep{}, "exec-other", "w2", StatusStarted)
+	require.NoError(t, err)
+	_, err = store.FinishExecution(ctx, "exec-2", StatusCompleted)
+	require.NoError(t, err)
+
+	executions, count, err := store.GetRecent(ctx, "w1", 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, executions, 2)
+	assert.Equal(t, "exec-3", executions[0].ExecutionID)
+	assert.Equal(t, "exec-2", executions[1].ExecutionID)
+	assert.Equal(t, StatusCompleted, executions[1].Status)
+
+	executions, count, err = store.GetRecent(ctx, "w1", 2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, executions, 1)
+	assert.Equal(t, "exec-1", executions[0].ExecutionID)
+}
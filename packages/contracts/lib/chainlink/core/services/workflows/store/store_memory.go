@@ -3,6 +3,7 @@ package store
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -136,6 +137,57 @@ func (s *InMemoryStore) Get(ctx context.Context, executionID string) (WorkflowEx
 	return execution.DeepCopy(), nil
 }
 
+// GetUnfinished gets the executions for the given workflowID that have not yet finished
+func (s *InMemoryStore) GetUnfinished(ctx context.Context, workflowID string, offset, limit int) ([]WorkflowExecution, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var unfinished []WorkflowExecution
+	for _, execution := range s.idToExecution {
+		if execution.WorkflowID == workflowID && !isCompletedStatus(execution.Status) {
+			unfinished = append(unfinished, execution.DeepCopy())
+		}
+	}
+
+	sort.Slice(unfinished, func(i, j int) bool {
+		return unfinished[i].CreatedAt.Before(*unfinished[j].CreatedAt)
+	})
+
+	if offset >= len(unfinished) {
+		return nil, nil
+	}
+	end := len(unfinished)
+	if limit > 0 && offset+limit < end {
+		end = offset + limit
+	}
+	return unfinished[offset:end], nil
+}
+
+// GetRecent gets the executions for the given workflowID, newest first
+func (s *InMemoryStore) GetRecent(ctx context.Context, workflowID string, offset, limit int) ([]WorkflowExecution, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var executions []WorkflowExecution
+	for _, execution := range s.idToExecution {
+		if execution.WorkflowID == workflowID {
+			executions = append(executions, execution.DeepCopy())
+		}
+	}
+
+	sort.Slice(executions, func(i, j int) bool {
+		return executions[i].CreatedAt.After(*executions[j].CreatedAt)
+	})
+
+	count := len(executions)
+	if offset >= count {
+		return nil, count, nil
+	}
+	end := count
+	if limit > 0 && offset+limit < end {
+		end = offset + limit
+	}
+	return executions[offset:end], count, nil
+}
+
 func (s *InMemoryStore) Start(context.Context) error {
 	return s.StartOnce("InMemoryStore", func() error {
 		s.shutdownWaitGroup.Add(1)
@@ -119,3 +119,58 @@ func TestInMemoryStore_ExpiresNonCompletedExecutions(t *testing.T) {
 		return err2 != nil
 	}, 300*time.Millisecond, 50*time.Millisecond)
 }
+
+func TestInMemoryStore_GetUnfinished(t *testing.T) {
+	fakeClock := clockwork.NewFakeClock()
+	store := NewInMemoryStore(logger.TestLogger(t), fakeClock)
+
+	for _, id := range []string{"exec-1", "exec-2", "exec-3"} {
+		_, err := store.Add(context.Background(), map[string]*WorkflowExecutionStep{}, id, "w1", StatusStarted)
+		require.NoError(t, err)
+		fakeClock.Advance(time.Second)
+	}
+	_, err := store.Add(context.Background(), map[string]*WorkflowExecutionStep{}, "exec-other", "w2", StatusStarted)
+	require.NoError(t, err)
+	_, err = store.FinishExecution(context.Background(), "exec-2", StatusCompleted)
+	require.NoError(t, err)
+
+	unfinished, err := store.GetUnfinished(context.Background(), "w1", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, unfinished, 2)
+	assert.Equal(t, "exec-1", unfinished[0].ExecutionID)
+	assert.Equal(t, "exec-3", unfinished[1].ExecutionID)
+
+	unfinished, err = store.GetUnfinished(context.Background(), "w1", 1, 10)
+	require.NoError(t, err)
+	require.Len(t, unfinished, 1)
+	assert.Equal(t, "exec-3", unfinished[0].ExecutionID)
+
+	unfinished, err = store.GetUnfinished(context.Background(), "w1", 2, 10)
+	require.NoError(t, err)
+	assert.Empty(t, unfinished)
+}
+
+func TestInMemoryStore_GetRecent(t *testing.T) {
+	fakeClock := clockwork.NewFakeClock()
+	store := NewInMemoryStore(logger.TestLogger(t), fakeClock)
+
+	for _, id := range []string{"exec-1", "exec-2", "exec-3"} {
+		_, err := store.Add(context.Background(), map[string]*WorkflowExecutionStep{}, id, "w1", StatusStarted)
+		require.NoError(t, err)
+		fakeClock.Advance(time.Second)
+	}
+	_, err := store.Add(context.Background(), map[string]*WorkflowExecutionStep{}, "exec-other", "w2", StatusStarted)
+	require.NoError(t, err)
+
+	executions, count, err := store.GetRecent(context.Background(), "w1", 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, executions, 2)
+	assert.Equal(t, "exec-3", executions[0].ExecutionID)
+	assert.Equal(t, "exec-2", executions[1].ExecutionID)
+
+	executions, count, err = store.GetRecent(context.Background(), "w1", 3, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	assert.Empty(t, executions)
+}
@@ -14,6 +14,9 @@ import (
 
 var errNotFound = errors.New("engine not found")
 
+// ErrWorkflowNotActive is returned when resuming the engine of a workflow which is not active in the registry.
+var ErrWorkflowNotActive = errors.New("workflow is not active in the registry")
+
 type EngineRegistryKey struct {
 	Owner []byte
 	Name  string
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/custmsg"
@@ -123,8 +124,25 @@ type eventHandler struct {
 	workflowLimits         *syncerlimiter.Limits
 	workflowArtifactsStore WorkflowArtifactsStore
 	billingClient          workflows.BillingClient
+
+	// mu serializes the handling of registry events with local engine controls
+	mu sync.Mutex
+	// locallyPaused holds the keys of engines paused by an operator on this node, see PauseEngine
+	locallyPaused   map[string]struct{}
+	locallyPausedMu sync.RWMutex
+}
+
+// EngineController pauses and resumes the engines of workflows managed by the workflow registry syncer
+// on this node only. The workflow status in the registry is not modified, and local pauses do not
+// survive a node restart.
+type EngineController interface {
+	PauseEngine(ctx context.Context, owner []byte, name string) error
+	ResumeEngine(ctx context.Context, owner []byte, name string) error
+	IsPausedLocally(owner []byte, name string) bool
 }
 
+var _ EngineController = (*eventHandler)(nil)
+
 type Event struct {
 	EventType WorkflowRegistryEventType
 	Data      any
@@ -198,6 +216,7 @@ func NewEventHandler(
 		ratelimiter:            ratelimiter,
 		workflowLimits:         workflowLimits,
 		workflowArtifactsStore: workflowArtifacts,
+		locallyPaused:          make(map[string]struct{}),
 	}
 	eh.engineFactory = eh.engineFactoryFn
 	for _, o := range opts {
@@ -213,6 +232,9 @@ func (h *eventHandler) Close() error {
 }
 
 func (h *eventHandler) Handle(ctx context.Context, event Event) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
 	switch event.EventType {
 	case ForceUpdateSecretsEvent:
 		payload, ok := event.Data.(ForceUpdateSecretsRequestedV1)
@@ -405,9 +427,9 @@ func (h *eventHandler) workflowRegisteredEvent(
 	}
 
 	// Next, let's synchronize the engine registry.
-	// If the state isn't active, we shouldn't have an engine running.
+	// If the state isn't active, or an operator paused the engine on this node, we shouldn't have an engine running.
 	// Let's try to clean one up if it exists
-	if spec.Status != job.WorkflowSpecStatusActive {
+	if spec.Status != job.WorkflowSpecStatusActive || h.IsPausedLocally(payload.WorkflowOwner, payload.WorkflowName) {
 		return h.tryEngineCleanup(payload.WorkflowOwner, payload.WorkflowName)
 	}
 
@@ -666,6 +688,63 @@ func (h *eventHandler) workflowDeletedEvent(
 	return err
 }
 
+// PauseEngine stops the engine of the given workflow on this node and keeps it stopped until
+// ResumeEngine is called, even if registry events for the workflow are received in the meantime.
+func (h *eventHandler) PauseEngine(ctx context.Context, owner []byte, name string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, err := h.workflowArtifactsStore.GetWorkflowSpec(ctx, hex.EncodeToString(owner), name); err != nil {
+		return fmt.Errorf("failed to get workflow spec: %w", err)
+	}
+
+	h.locallyPausedMu.Lock()
+	h.locallyPaused[EngineRegistryKey{Owner: owner, Name: name}.keyFor()] = struct{}{}
+	h.locallyPausedMu.Unlock()
+	if err := h.tryEngineCleanup(owner, name); err != nil {
+		return err
+	}
+
+	h.lggr.Infow("paused workflow engine locally", "workflowName", name, "workflowOwner", hex.EncodeToString(owner))
+	return nil
+}
+
+// ResumeEngine lifts a local pause set by PauseEngine and starts the engine again if the workflow
+// is active in the registry.
+func (h *eventHandler) ResumeEngine(ctx context.Context, owner []byte, name string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	spec, err := h.workflowArtifactsStore.GetWorkflowSpec(ctx, hex.EncodeToString(owner), name)
+	if err != nil {
+		return fmt.Errorf("failed to get workflow spec: %w", err)
+	}
+
+	key := EngineRegistryKey{Owner: owner, Name: name}
+	h.locallyPausedMu.Lock()
+	delete(h.locallyPaused, key.keyFor())
+	h.locallyPausedMu.Unlock()
+	h.lggr.Infow("resumed workflow engine locally", "workflowName", name, "workflowOwner", hex.EncodeToString(owner))
+
+	if spec.Status != job.WorkflowSpecStatusActive {
+		return fmt.Errorf("%w: it is %s, its engine will start once it is activated", ErrWorkflowNotActive, spec.Status)
+	}
+
+	if h.engineRegistry.Contains(key) {
+		return nil
+	}
+
+	return h.tryEngineCreate(ctx, spec)
+}
+
+// IsPausedLocally returns true if the engine of the given workflow was paused by PauseEngine.
+func (h *eventHandler) IsPausedLocally(owner []byte, name string) bool {
+	h.locallyPausedMu.RLock()
+	defer h.locallyPausedMu.RUnlock()
+	_, ok := h.locallyPaused[EngineRegistryKey{Owner: owner, Name: name}.keyFor()]
+	return ok
+}
+
 // tryEngineCleanup attempts to stop the workflow engine for the given workflow ID.  Does nothing if the
 // workflow engine is not running.
 func (h *eventHandler) tryEngineCleanup(workflowOwner []byte, workflowName string) error {
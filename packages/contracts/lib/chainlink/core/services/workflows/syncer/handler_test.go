@@ -1181,3 +1181,105 @@ func Test_workflowPausedActivatedUpdatedHandler(t *testing.T) {
 		require.Equal(t, types.WorkflowID(updatedWFID), engine.WorkflowID)
 	})
 }
+
+func Test_Handler_PauseResumeEngineLocally(t *testing.T) {
+	var (
+		ctx     = testutils.Context(t)
+		lggr    = logger.TestLogger(t)
+		db      = pgtest.NewSqlxDB(t)
+		orm     = artifacts.NewWorkflowRegistryDS(db, lggr)
+		emitter = custmsg.NewLabeler()
+
+		binary        = wasmtest.CreateTestBinary(binaryCmd, true, t)
+		encodedBinary = []byte(base64.StdEncoding.EncodeToString(binary))
+		config        = []byte("")
+		secretsURL    = "http://example.com"
+		binaryURL     = "http://example.com/binary"
+		configURL     = "http://example.com/config"
+		wfOwner       = []byte("0xOwner")
+
+		fetcher = newMockFetcher(map[string]mockFetchResp{
+			binaryURL:  {Body: encodedBinary, Err: nil},
+			configURL:  {Body: config, Err: nil},
+			secretsURL: {Body: []byte("secrets"), Err: nil},
+		})
+	)
+
+	giveWFID, err := pkgworkflows.GenerateWorkflowID(wfOwner, "workflow-name", binary, config, secretsURL)
+	require.NoError(t, err)
+
+	active := WorkflowRegisteredV1{
+		Status:        uint8(0),
+		WorkflowID:    giveWFID,
+		WorkflowOwner: wfOwner,
+		WorkflowName:  "workflow-name",
+		BinaryURL:     binaryURL,
+		ConfigURL:     configURL,
+		SecretsURL:    secretsURL,
+	}
+
+	er := NewEngineRegistry()
+	store := wfstore.NewInMemoryStore(lggr, clockwork.NewFakeClock())
+	registry := capabilities.NewRegistry(lggr)
+	registry.SetLocalRegistry(&capabilities.TestMetadataRegistry{})
+	rl, err := ratelimiter.NewRateLimiter(rlConfig)
+	require.NoError(t, err)
+	workflowLimits, err := syncerlimiter.NewWorkflowLimits(lggr, syncerlimiter.Config{Global: 200, PerOwner: 200})
+	require.NoError(t, err)
+
+	decrypter := newMockDecrypter()
+	artifactStore := artifacts.NewStoreWithDecryptSecretsFn(lggr, orm, fetcher.FetcherFunc(), clockwork.NewFakeClock(), workflowkey.Key{}, custmsg.NewLabeler(), decrypter.decryptSecrets)
+
+	h, err := NewEventHandler(lggr, store, registry, er, emitter, rl, workflowLimits, artifactStore)
+	require.NoError(t, err)
+
+	key := EngineRegistryKey{Owner: wfOwner, Name: "workflow-name"}
+	require.NoError(t, h.workflowRegisteredEvent(ctx, active))
+	require.True(t, er.Contains(key))
+
+	t.Run("fails for an unknown workflow", func(t *testing.T) {
+		require.Error(t, h.PauseEngine(ctx, wfOwner, "unknown"))
+		require.Error(t, h.ResumeEngine(ctx, wfOwner, "unknown"))
+	})
+
+	t.Run("paused engine stays stopped across registry events", func(t *testing.T) {
+		require.NoError(t, h.PauseEngine(ctx, wfOwner, "workflow-name"))
+		assert.True(t, h.IsPausedLocally(wfOwner, "workflow-name"))
+		assert.False(t, er.Contains(key))
+
+		require.NoError(t, h.workflowRegisteredEvent(ctx, active))
+		assert.False(t, er.Contains(key))
+
+		// The registry status is left untouched
+		dbSpec, err := orm.GetWorkflowSpec(ctx, hex.EncodeToString(wfOwner), "workflow-name")
+		require.NoError(t, err)
+		assert.Equal(t, job.WorkflowSpecStatusActive, dbSpec.Status)
+	})
+
+	t.Run("resumed engine is started again", func(t *testing.T) {
+		require.NoError(t, h.ResumeEngine(ctx, wfOwner, "workflow-name"))
+		assert.False(t, h.IsPausedLocally(wfOwner, "workflow-name"))
+
+		engine, ok := er.Get(key)
+		require.True(t, ok)
+		require.NoError(t, engine.Ready())
+		assert.Equal(t, types.WorkflowID(giveWFID), engine.WorkflowID)
+
+		// Resuming a running engine is a no-op
+		require.NoError(t, h.ResumeEngine(ctx, wfOwner, "workflow-name"))
+	})
+
+	t.Run("resuming a workflow paused in the registry lifts the local pause only", func(t *testing.T) {
+		require.NoError(t, h.PauseEngine(ctx, wfOwner, "workflow-name"))
+		require.NoError(t, h.workflowPausedEvent(ctx, WorkflowPausedV1{
+			WorkflowID:    giveWFID,
+			WorkflowOwner: wfOwner,
+			WorkflowName:  "workflow-name",
+		}))
+
+		err := h.ResumeEngine(ctx, wfOwner, "workflow-name")
+		require.ErrorIs(t, err, ErrWorkflowNotActive)
+		assert.False(t, h.IsPausedLocally(wfOwner, "workflow-name"))
+		assert.False(t, er.Contains(key))
+	})
+}
@@ -599,6 +599,73 @@ func (_c *ORM_GetWorkflowSpecByID_Call) RunAndReturn(run func(context.Context, s
 	return _c
 }
 
+// ListWorkflowSpecs provides a mock function with given fields: ctx, offset, limit
+func (_m *ORM) ListWorkflowSpecs(ctx context.Context, offset int, limit int) ([]job.WorkflowSpec, int, error) {
+	ret := _m.Called(ctx, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWorkflowSpecs")
+	}
+
+	var r0 []job.WorkflowSpec
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]job.WorkflowSpec, int, error)); ok {
+		return rf(ctx, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []job.WorkflowSpec); ok {
+		r0 = rf(ctx, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.WorkflowSpec)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) int); ok {
+		r1 = rf(ctx, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
+		r2 = rf(ctx, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ORM_ListWorkflowSpecs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWorkflowSpecs'
+type ORM_ListWorkflowSpecs_Call struct {
+	*mock.Call
+}
+
+// ListWorkflowSpecs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - offset int
+//   - limit int
+func (_e *ORM_Expecter) ListWorkflowSpecs(ctx interface{}, offset interface{}, limit interface{}) *ORM_ListWorkflowSpecs_Call {
+	return &ORM_ListWorkflowSpecs_Call{Call: _e.mock.On("ListWorkflowSpecs", ctx, offset, limit)}
+}
+
+func (_c *ORM_ListWorkflowSpecs_Call) Run(run func(ctx context.Context, offset int, limit int)) *ORM_ListWorkflowSpecs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ORM_ListWorkflowSpecs_Call) Return(_a0 []job.WorkflowSpec, _a1 int, _a2 error) *ORM_ListWorkflowSpecs_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ORM_ListWorkflowSpecs_Call) RunAndReturn(run func(context.Context, int, int) ([]job.WorkflowSpec, int, error)) *ORM_ListWorkflowSpecs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, secretsURL, contents
 func (_m *ORM) Update(ctx context.Context, secretsURL string, contents string) (int64, error) {
 	ret := _m.Called(ctx, secretsURL, contents)
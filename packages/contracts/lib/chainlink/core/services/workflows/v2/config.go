@@ -58,6 +58,8 @@ const (
 
 	defaultHeartbeatFrequencyMs = 1000 * 60 // 1 minute
 	defaultShutdownTimeoutMs    = 5000
+
+	unfinishedExecutionsBatchSize = 100
 )
 
 type EngineLimits struct {
@@ -126,7 +126,12 @@ func (e *Engine) start(_ context.Context) error {
 	e.cfg.Module.Start()
 	e.srvcEng.Go(e.heartbeatLoop)
 	e.srvcEng.Go(e.init)
-	e.srvcEng.Go(e.handleAllTriggerEvents)
+	e.srvcEng.Go(func(ctx context.Context) {
+		// executions left over from a previous run must be failed before new ones start, so that they can't be mistaken
+		// for each other
+		e.failUnfinishedExecutions(ctx)
+		e.handleAllTriggerEvents(ctx)
+	})
 	return nil
 }
 
@@ -159,6 +164,34 @@ func (e *Engine) init(ctx context.Context) {
 	e.cfg.Hooks.OnInitialized(nil)
 }
 
+// failUnfinishedExecutions marks executions of this workflow that were still in flight when the node last stopped
+// as errored. Unlike DAG-based workflows, the state of a WASM module cannot be restored mid-execution, so these
+// executions cannot be resumed.
+func (e *Engine) failUnfinishedExecutions(ctx context.Context) {
+	var unfinished []store.WorkflowExecution
+	for offset := 0; ; offset += unfinishedExecutionsBatchSize {
+		executions, err := e.cfg.ExecutionsStore.GetUnfinished(ctx, e.cfg.WorkflowID, offset, unfinishedExecutionsBatchSize)
+		if err != nil {
+			e.lggr.Errorw("Failed to load unfinished executions", "err", err)
+			return
+		}
+		unfinished = append(unfinished, executions...)
+		if len(executions) < unfinishedExecutionsBatchSize {
+			break
+		}
+	}
+
+	for _, execution := range unfinished {
+		_, err := e.cfg.ExecutionsStore.FinishExecution(ctx, execution.ExecutionID, store.StatusErrored)
+		if err != nil {
+			e.lggr.Errorw("Failed to fail unfinished execution", "executionID", execution.ExecutionID, "err", err)
+			continue
+		}
+		e.lggr.Warnw("Unfinished workflow execution from a previous run marked as errored", "executionID", execution.ExecutionID)
+		_ = events.EmitExecutionFinishedEvent(ctx, e.loggerLabels, store.StatusErrored, execution.ExecutionID)
+	}
+}
+
 func (e *Engine) runTriggerSubscriptionPhase(ctx context.Context) error {
 	// call into the workflow to get trigger subscriptions
 	subCtx, cancel := context.WithTimeout(ctx, time.Millisecond*time.Duration(e.cfg.LocalLimits.TriggerSubscriptionRequestTimeoutMs))
@@ -307,6 +340,12 @@ func (e *Engine) startExecution(ctx context.Context, wrappedTriggerEvent enqueue
 		return
 	}
 
+	_, err = e.cfg.ExecutionsStore.Add(ctx, map[string]*store.WorkflowExecutionStep{}, executionID, e.cfg.WorkflowID, store.StatusStarted)
+	if err != nil {
+		executionLogger.Errorw("Failed to store workflow execution", "err", err)
+		return
+	}
+
 	e.meterReports.Add(executionID, metering.NewReport(e.cfg.Lggr))
 
 	executionLogger.Infow("Workflow execution starting ...")
@@ -328,6 +367,7 @@ func (e *Engine) startExecution(ctx context.Context, wrappedTriggerEvent enqueue
 			status = store.StatusTimeout
 		}
 		executionLogger.Errorw("Workflow execution failed", "err", err, "status", status)
+		e.finishExecution(ctx, executionLogger, executionID, status)
 		_ = events.EmitExecutionFinishedEvent(ctx, e.loggerLabels, status, executionID)
 		e.meterReports.Delete(executionID)
 		return
@@ -335,6 +375,7 @@ func (e *Engine) startExecution(ctx context.Context, wrappedTriggerEvent enqueue
 	// TODO(CAPPL-737): measure and report execution time
 
 	executionLogger.Infow("Workflow execution finished successfully")
+	e.finishExecution(ctx, executionLogger, executionID, store.StatusCompleted)
 	_ = events.EmitExecutionFinishedEvent(ctx, e.loggerLabels, store.StatusCompleted, executionID)
 	e.meterReports.Delete(executionID)
 
@@ -342,6 +383,17 @@ func (e *Engine) startExecution(ctx context.Context, wrappedTriggerEvent enqueue
 	e.cfg.Hooks.OnExecutionFinished(executionID)
 }
 
+// finishExecution records the final status of an execution in the executions store
+func (e *Engine) finishExecution(ctx context.Context, lggr logger.Logger, executionID string, status string) {
+	// use a fresh context so that executions cut short by their deadline are still recorded
+	storeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Millisecond*time.Duration(e.cfg.LocalLimits.ShutdownTimeoutMs))
+	defer cancel()
+	_, err := e.cfg.ExecutionsStore.FinishExecution(storeCtx, executionID, status)
+	if err != nil {
+		lggr.Errorw("Failed to mark workflow execution as finished", "err", err, "status", status)
+	}
+}
+
 func (e *Engine) close() error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*time.Duration(e.cfg.LocalLimits.ShutdownTimeoutMs))
 	defer cancel()
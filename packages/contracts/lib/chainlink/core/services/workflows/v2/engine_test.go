@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"sync"
 	"testing"
+	"time"
 
+	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -35,6 +37,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/wasmtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/syncerlimiter"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/types"
 	v2 "github.com/smartcontractkit/chainlink/v2/core/services/workflows/v2"
@@ -320,6 +323,90 @@ func TestEngine_Execution(t *testing.T) {
 	})
 }
 
+// blockingStore holds the lookup of unfinished executions until released
+type blockingStore struct {
+	*store.InMemoryStore
+	release chan struct{}
+}
+
+func (s *blockingStore) GetUnfinished(ctx context.Context, workflowID string, offset, limit int) ([]store.WorkflowExecution, error) {
+	select {
+	case <-s.release:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return s.InMemoryStore.GetUnfinished(ctx, workflowID, offset, limit)
+}
+
+func TestEngine_Restart_FailsOnlyPreviousExecutions(t *testing.T) {
+	module := modulemocks.NewModuleV2(t)
+	module.EXPECT().Start()
+	module.EXPECT().Close()
+	capreg := regmocks.NewCapabilitiesRegistry(t)
+	capreg.EXPECT().LocalNode(matches.AnyContext).Return(newNode(t), nil)
+
+	initDoneCh := make(chan error)
+	executionFinishedCh := make(chan string)
+
+	cfg := defaultTestConfig(t)
+	cfg.Module = module
+	cfg.CapRegistry = capreg
+	cfg.Hooks = v2.LifecycleHooks{
+		OnInitialized: func(err error) {
+			initDoneCh <- err
+		},
+		OnExecutionFinished: func(executionID string) {
+			executionFinishedCh <- executionID
+		},
+	}
+	execStore := &blockingStore{
+		InMemoryStore: store.NewInMemoryStore(logger.TestLogger(t), clockwork.NewRealClock()),
+		release:       make(chan struct{}),
+	}
+	cfg.ExecutionsStore = execStore
+
+	// an execution in flight when the node stopped
+	_, err := execStore.Add(t.Context(), map[string]*store.WorkflowExecutionStep{}, "previous_execution", cfg.WorkflowID, store.StatusStarted)
+	require.NoError(t, err)
+
+	engine, err := v2.NewEngine(testutils.Context(t), cfg)
+	require.NoError(t, err)
+	module.EXPECT().Execute(matches.AnyContext, mock.Anything, mock.Anything).Return(newTriggerSubs(1), nil).Once()
+	trigger := capmocks.NewTriggerCapability(t)
+	capreg.EXPECT().GetTrigger(matches.AnyContext, "id_0").Return(trigger, nil).Once()
+	eventCh := make(chan capabilities.TriggerResponse)
+	trigger.EXPECT().RegisterTrigger(matches.AnyContext, mock.Anything).Return(eventCh, nil).Once()
+	trigger.EXPECT().UnregisterTrigger(matches.AnyContext, mock.Anything).Return(nil).Once()
+
+	require.NoError(t, engine.Start(t.Context()))
+	require.NoError(t, <-initDoneCh)
+
+	// a trigger fires while the previous executions are still being failed
+	mockTriggerEvent := capabilities.TriggerEvent{
+		TriggerType: "basic-trigger@1.0.0",
+		ID:          "event_012345",
+	}
+	executionID, err := types.GenerateExecutionID(cfg.WorkflowID, mockTriggerEvent.ID)
+	require.NoError(t, err)
+	module.EXPECT().Execute(matches.AnyContext, mock.Anything, mock.Anything).Return(nil, nil).Once()
+	eventCh <- capabilities.TriggerResponse{Event: mockTriggerEvent}
+
+	require.Never(t, func() bool {
+		_, err := execStore.Get(t.Context(), executionID)
+		return err == nil
+	}, 100*time.Millisecond, 10*time.Millisecond, "execution started before previous executions were failed")
+	close(execStore.release)
+	require.Equal(t, executionID, <-executionFinishedCh)
+	require.NoError(t, engine.Close())
+
+	previous, err := execStore.Get(t.Context(), "previous_execution")
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusErrored, previous.Status)
+	current, err := execStore.Get(t.Context(), executionID)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusCompleted, current.Status)
+}
+
 func TestEngine_MockCapabilityRegistry_NoDAGBinary(t *testing.T) {
 	cmd := "core/services/workflows/test/wasm/v2/cmd"
 	log := logger.TestLogger(t)
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Executions are now persisted for every running engine, including engines whose workflow spec
+-- is replaced by a new version (and therefore a new workflow_id) while executions are in flight.
+-- Expired executions are removed by the store's pruning loop instead of the foreign key cascade.
+ALTER TABLE workflow_executions
+    DROP CONSTRAINT workflow_executions_workflow_id_fkey;
+
+CREATE INDEX IF NOT EXISTS idx_workflow_executions_workflow_id_status_created_at
+    ON workflow_executions (workflow_id, status, created_at);
+
+CREATE INDEX IF NOT EXISTS idx_workflow_executions_status_updated_at
+    ON workflow_executions (status, updated_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_workflow_executions_status_updated_at;
+DROP INDEX IF EXISTS idx_workflow_executions_workflow_id_status_created_at;
+
+DELETE FROM workflow_executions
+    WHERE workflow_id NOT IN (SELECT workflow_id FROM workflow_specs);
+
+ALTER TABLE workflow_executions
+    ADD CONSTRAINT workflow_executions_workflow_id_fkey
+    FOREIGN KEY (workflow_id)
+    REFERENCES workflow_specs(workflow_id)
+    ON DELETE CASCADE;
+-- +goose StatementEnd
@@ -0,0 +1,8 @@
+-- +goose Up
+
+-- Paused jobs keep their spec and run history, but their services are not started
+ALTER TABLE jobs ADD COLUMN paused_at TIMESTAMPTZ;
+
+-- +goose Down
+
+ALTER TABLE jobs DROP COLUMN paused_at;
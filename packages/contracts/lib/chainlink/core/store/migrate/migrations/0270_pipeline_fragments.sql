@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Reusable pipeline fragments invoked by the fragment task. Versions are immutable: updating a fragment
+-- creates a new version, and jobs keep using the version they reference until their spec is updated.
+CREATE TABLE pipeline_fragments (
+    id BIGSERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    version INT NOT NULL CHECK (version > 0),
+    dot_dag_source TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    UNIQUE (name, version)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE pipeline_fragments;
+-- +goose StatementEnd
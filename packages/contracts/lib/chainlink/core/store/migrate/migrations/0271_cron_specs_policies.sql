@@ -0,0 +1,18 @@
+-- +goose Up
+
+-- last_fired_at is the latest schedule tick a cron job fired for, it is used to catch up on ticks missed while the node was down
+ALTER TABLE cron_specs
+    ADD COLUMN catch_up_policy TEXT NOT NULL DEFAULT 'skip',
+    ADD COLUMN catch_up_window BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN concurrency_policy TEXT NOT NULL DEFAULT 'allow',
+    ADD COLUMN jitter BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN last_fired_at TIMESTAMPTZ;
+
+-- +goose Down
+
+ALTER TABLE cron_specs
+    DROP COLUMN catch_up_policy,
+    DROP COLUMN catch_up_window,
+    DROP COLUMN concurrency_policy,
+    DROP COLUMN jitter,
+    DROP COLUMN last_fired_at;
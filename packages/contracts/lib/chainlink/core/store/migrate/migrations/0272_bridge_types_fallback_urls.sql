@@ -0,0 +1,8 @@
+-- +goose Up
+
+-- fallback_urls are tried in order when the url of the bridge fails
+ALTER TABLE bridge_types ADD COLUMN fallback_urls TEXT[] NOT NULL DEFAULT '{}';
+
+-- +goose Down
+
+ALTER TABLE bridge_types DROP COLUMN fallback_urls;
@@ -0,0 +1,8 @@
+-- +goose Up
+
+-- requests to bridges with a signing_scheme are signed, see core/bridges/signing
+ALTER TABLE bridge_types ADD COLUMN signing_scheme TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+
+ALTER TABLE bridge_types DROP COLUMN signing_scheme;
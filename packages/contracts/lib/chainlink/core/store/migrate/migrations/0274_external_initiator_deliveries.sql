@@ -0,0 +1,24 @@
+-- +goose Up
+
+-- external_initiator_deliveries is the outbox of the job notifications sent to external initiators
+CREATE TABLE external_initiator_deliveries (
+    id BIGSERIAL PRIMARY KEY,
+    external_initiator_id BIGINT NOT NULL REFERENCES external_initiators (id) ON DELETE CASCADE,
+    job_id UUID NOT NULL,
+    type TEXT NOT NULL CHECK (type IN ('create', 'delete')),
+    spec JSONB NOT NULL DEFAULT '{}',
+    state TEXT NOT NULL DEFAULT 'pending' CHECK (state IN ('pending', 'delivered', 'dead')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_external_initiator_deliveries_pending ON external_initiator_deliveries (next_attempt_at) WHERE state = 'pending';
+-- the deliveries of a job are sent in order, each one waiting for the earlier ones to be delivered
+CREATE INDEX idx_external_initiator_deliveries_undelivered ON external_initiator_deliveries (external_initiator_id, job_id, id) WHERE state <> 'delivered';
+
+-- +goose Down
+
+DROP TABLE external_initiator_deliveries;
@@ -0,0 +1,8 @@
+-- +goose Up
+
+-- retention overrides the pruning of the pipeline runs of the job, see pipeline.RunRetention
+ALTER TABLE jobs ADD COLUMN retention JSONB NOT NULL DEFAULT '{}';
+
+-- +goose Down
+
+ALTER TABLE jobs DROP COLUMN retention;
@@ -0,0 +1,8 @@
+-- +goose Up
+
+-- limits bounds the concurrency, rate and resources of the pipeline runs of the job, see pipeline.RunLimits
+ALTER TABLE jobs ADD COLUMN limits JSONB NOT NULL DEFAULT '{}';
+
+-- +goose Down
+
+ALTER TABLE jobs DROP COLUMN limits;
@@ -0,0 +1,24 @@
+-- +goose Up
+
+-- gateway_audit_log records the requests of the users of gateways, with the responses of the nodes and of the gateway
+CREATE TABLE gateway_audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    don_id TEXT NOT NULL,
+    message_id TEXT NOT NULL,
+    method TEXT NOT NULL,
+    sender TEXT NOT NULL,
+    request JSONB NOT NULL,
+    response JSONB,
+    node_responses JSONB NOT NULL DEFAULT '[]',
+    error_code INT NOT NULL DEFAULT 0,
+    error_message TEXT NOT NULL DEFAULT '',
+    received_at TIMESTAMPTZ NOT NULL,
+    responded_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_gateway_audit_log_sender ON gateway_audit_log (sender, received_at);
+CREATE INDEX idx_gateway_audit_log_method ON gateway_audit_log (method, received_at);
+
+-- +goose Down
+
+DROP TABLE gateway_audit_log;
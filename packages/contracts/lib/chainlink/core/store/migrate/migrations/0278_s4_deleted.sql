@@ -0,0 +1,7 @@
+-- +goose Up
+
+ALTER TABLE "s4".shared ADD COLUMN deleted BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+
+ALTER TABLE "s4".shared DROP COLUMN deleted;
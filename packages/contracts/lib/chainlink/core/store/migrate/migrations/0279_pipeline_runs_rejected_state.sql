@@ -0,0 +1,35 @@
+-- +goose Up
+-- runs rejected by the limits of their job, see 0276_jobs_run_limits.sql
+ALTER TYPE pipeline_runs_state ADD VALUE 'rejected';
+
+-- +goose Down
+-- +goose StatementBegin
+
+-- Values cannot be removed from an enum, so the type is re-created without 'rejected', whose runs were deleted by the
+-- rollback of 0280_pipeline_runs_rejected_check.sql. The constraint, index and default depending on the type are
+-- dropped and re-created around it.
+ALTER TABLE pipeline_runs DROP CONSTRAINT pipeline_runs_check;
+DROP INDEX pipeline_runs_suspended;
+ALTER TABLE pipeline_runs ALTER COLUMN state DROP DEFAULT;
+
+ALTER TYPE pipeline_runs_state RENAME TO pipeline_runs_state_old;
+CREATE TYPE pipeline_runs_state AS ENUM (
+    'running',
+    'suspended',
+    'errored',
+    'completed'
+);
+ALTER TABLE pipeline_runs ALTER COLUMN state TYPE pipeline_runs_state USING state::text::pipeline_runs_state;
+DROP TYPE pipeline_runs_state_old;
+
+ALTER TABLE pipeline_runs ALTER COLUMN state SET DEFAULT 'completed';
+CREATE INDEX pipeline_runs_suspended ON pipeline_runs (id) WHERE state = 'suspended';
+ALTER TABLE pipeline_runs ADD CONSTRAINT pipeline_runs_check CHECK (
+	((state IN ('completed')) AND (finished_at IS NOT NULL) AND (num_nulls(outputs) = 0))
+		OR
+	((state IN ('errored')) AND (finished_at IS NOT NULL) AND (num_nulls(fatal_errors, all_errors) = 0))
+		OR
+	((state IN ('running', 'suspended')) AND num_nulls(finished_at, outputs, fatal_errors) = 3)
+);
+
+-- +goose StatementEnd
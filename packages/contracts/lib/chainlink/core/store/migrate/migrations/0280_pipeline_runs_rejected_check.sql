@@ -0,0 +1,21 @@
+-- +goose Up
+-- rejected runs are finished without being executed, so they have errors but no outputs
+	ALTER TABLE pipeline_runs DROP CONSTRAINT pipeline_runs_check;
+	ALTER TABLE pipeline_runs ADD CONSTRAINT pipeline_runs_check CHECK (
+		((state IN ('completed')) AND (finished_at IS NOT NULL) AND (num_nulls(outputs) = 0))
+			OR
+		((state IN ('errored', 'rejected')) AND (finished_at IS NOT NULL) AND (num_nulls(fatal_errors, all_errors) = 0))
+			OR
+		((state IN ('running', 'suspended')) AND num_nulls(finished_at, outputs, fatal_errors) = 3)
+	);
+
+-- +goose Down
+	DELETE FROM pipeline_runs WHERE state = 'rejected';
+	ALTER TABLE pipeline_runs DROP CONSTRAINT pipeline_runs_check;
+	ALTER TABLE pipeline_runs ADD CONSTRAINT pipeline_runs_check CHECK (
+		((state IN ('completed')) AND (finished_at IS NOT NULL) AND (num_nulls(outputs) = 0))
+			OR
+		((state IN ('errored')) AND (finished_at IS NOT NULL) AND (num_nulls(fatal_errors, all_errors) = 0))
+			OR
+		((state IN ('running', 'suspended')) AND num_nulls(finished_at, outputs, fatal_errors) = 3)
+	);
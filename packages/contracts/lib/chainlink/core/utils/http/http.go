@@ -1,6 +1,7 @@
 package http
 
 import (
+	"crypto/tls"
 	"fmt"
 	"io"
 	"net/http"
@@ -28,6 +29,34 @@ func NewUnrestrictedHTTPClient() *http.Client {
 	return &http.Client{Transport: unrestrictedTr}
 }
 
+// NewClientWithCertificate returns a copy of client presenting the certificate at certPath, with the key at keyPath,
+// to servers requesting a client certificate. The key pair is loaded on each TLS handshake, so that it can be
+// rotated without a restart.
+func NewClientWithCertificate(client *http.Client, certPath, keyPath string) *http.Client {
+	if client == nil {
+		client = &http.Client{}
+	}
+	var tr *http.Transport
+	if t, ok := client.Transport.(*http.Transport); ok {
+		tr = t.Clone()
+	} else {
+		tr = newDefaultTransport()
+	}
+	if tr.TLSClientConfig == nil {
+		tr.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	tr.TLSClientConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		return &cert, nil
+	}
+	c := *client
+	c.Transport = tr
+	return &c
+}
+
 func newDefaultTransport() *http.Transport {
 	t := http.DefaultTransport.(*http.Transport).Clone()
 	// There are certain classes of vulnerabilities that open up when
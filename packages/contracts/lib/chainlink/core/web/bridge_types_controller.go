@@ -11,6 +11,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/bridges/signing"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
@@ -46,6 +47,15 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest) error {
 	if len(strings.TrimSpace(u)) == 0 {
 		fe.Add("URL must be present")
 	}
+	for _, fallback := range bt.FallbackURLs {
+		if len(strings.TrimSpace(fallback.String())) == 0 {
+			fe.Add("Fallback URLs must be present")
+			break
+		}
+	}
+	if _, err := signing.ParseScheme(string(bt.SigningScheme)); err != nil {
+		fe.Add(err.Error())
+	}
 	if bt.MinimumContractPayment != nil &&
 		bt.MinimumContractPayment.Cmp(assets.NewLinkFromJuels(0)) < 0 {
 		fe.Add("MinimumContractPayment must be positive")
@@ -104,6 +114,8 @@ func (btc *BridgeTypesController) Create(c *gin.Context) {
 		"bridgeConfirmations":          bta.Confirmations,
 		"bridgeMinimumContractPayment": bta.MinimumContractPayment,
 		"bridgeURL":                    bta.URL,
+		"bridgeFallbackURLs":           bt.FallbackURLs,
+		"bridgeSigningScheme":          bt.SigningScheme,
 	})
 
 	jsonAPIResponse(c, resource, "bridge")
@@ -116,7 +128,7 @@ func (btc *BridgeTypesController) Index(c *gin.Context, size, page, offset int)
 
 	var resources []presenters.BridgeResource
 	for _, bridge := range bridges {
-		resources = append(resources, *presenters.NewBridgeResource(bridge))
+		resources = append(resources, *btc.newBridgeResource(bridge))
 	}
 
 	paginatedResponse(c, "Bridges", size, page, resources, count, err)
@@ -143,7 +155,7 @@ func (btc *BridgeTypesController) Show(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
+	jsonAPIResponse(c, btc.newBridgeResource(bt), "bridge")
 }
 
 // Update can change the restricted attributes for a bridge
@@ -187,9 +199,11 @@ func (btc *BridgeTypesController) Update(c *gin.Context) {
 		"bridgeConfirmations":          bt.Confirmations,
 		"bridgeMinimumContractPayment": bt.MinimumContractPayment,
 		"bridgeURL":                    bt.URL,
+		"bridgeFallbackURLs":           bt.FallbackURLs,
+		"bridgeSigningScheme":          bt.SigningScheme,
 	})
 
-	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
+	jsonAPIResponse(c, btc.newBridgeResource(bt), "bridge")
 }
 
 // Destroy removes a specific Bridge.
@@ -227,7 +241,18 @@ func (btc *BridgeTypesController) Destroy(c *gin.Context) {
 		return
 	}
 
+	btc.App.BridgeHealth().Forget(bt.Name)
+
 	btc.App.GetAuditLogger().Audit(audit.BridgeDeleted, map[string]interface{}{"name": name})
 
 	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
 }
+
+// newBridgeResource returns the resource of bt, with its health if it is known.
+func (btc *BridgeTypesController) newBridgeResource(bt bridges.BridgeType) *presenters.BridgeResource {
+	resource := presenters.NewBridgeResource(bt)
+	if h, ok := btc.App.BridgeHealth().Health(bt.Name); ok {
+		resource.Health = presenters.NewBridgeHealthResource(h)
+	}
+	return resource
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 
@@ -116,3 +117,45 @@ func (eic *ExternalInitiatorsController) Destroy(c *gin.Context) {
 	eic.App.GetAuditLogger().Audit(audit.ExternalInitiatorDeleted, map[string]interface{}{"name": name})
 	jsonAPIResponseWithStatus(c, nil, "external initiator", http.StatusNoContent)
 }
+
+// Deliveries lists the notifications sent to external initiators, optionally filtered by the state query parameter
+func (eic *ExternalInitiatorsController) Deliveries(c *gin.Context, size, page, offset int) {
+	ctx := c.Request.Context()
+	state, err := webhook.ParseDeliveryState(c.Query("state"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	deliveries, count, err := eic.App.GetExternalInitiatorManager().Deliveries(ctx, state, offset, size)
+	var resources []presenters.ExternalInitiatorDeliveryResource
+	for _, d := range deliveries {
+		resources = append(resources, presenters.NewExternalInitiatorDeliveryResource(d))
+	}
+
+	paginatedResponse(c, "externalInitiatorDeliveries", size, page, resources, count, err)
+}
+
+// ReplayDeliveries resends the notifications to external initiators with the requested IDs, or all dead ones
+func (eic *ExternalInitiatorsController) ReplayDeliveries(c *gin.Context) {
+	ctx := c.Request.Context()
+	request := webhook.ReplayDeliveriesRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	count, err := eic.App.GetExternalInitiatorManager().ReplayDeliveries(ctx, request.IDs, request.ExternalInitiator)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	eic.App.GetAuditLogger().Audit(audit.ExternalInitiatorDeliveriesReplayed, map[string]interface{}{
+		"deliveryIDs":           request.IDs,
+		"externalInitiatorName": request.ExternalInitiator,
+		"count":                 count,
+	})
+
+	resp := presenters.ExternalInitiatorDeliveriesReplayResource{JAID: presenters.NewJAID("replay"), Count: count}
+	jsonAPIResponse(c, resp, "externalInitiatorDeliveriesReplay")
+}
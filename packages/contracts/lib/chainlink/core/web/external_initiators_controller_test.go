@@ -13,9 +13,11 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 
+	"github.com/google/uuid"
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -259,3 +261,58 @@ func TestExternalInitiatorsController_DeleteNotFound(t *testing.T) {
 		})
 	}
 }
+
+func TestExternalInitiatorsController_Deliveries(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithConfig(t,
+		configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+			c.JobPipeline.ExternalInitiatorsEnabled = ptr(true)
+		}), cltest.UseRealExternalInitiatorManager)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(nil)
+
+	db := app.GetDB()
+	ei := cltest.MustInsertExternalInitiator(t, bridges.NewORM(db))
+	jobID := uuid.New()
+	pgtest.MustExec(t, db, `INSERT INTO external_initiator_deliveries (external_initiator_id, job_id, type, state, attempts, last_error)
+VALUES ($1, $2, 'create', 'dead', 12, 'connection refused')`, ei.ID, jobID)
+
+	resp, cleanup := client.Get("/v2/external_initiator_deliveries?state=unknown")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Get("/v2/external_initiator_deliveries?state=dead")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var links jsonapi.Links
+	var deliveries []presenters.ExternalInitiatorDeliveryResource
+	require.NoError(t, web.ParsePaginatedResponse(cltest.ParseResponseBody(t, resp), &deliveries, &links))
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, ei.Name, deliveries[0].ExternalInitiator)
+	assert.Equal(t, jobID.String(), deliveries[0].JobID)
+	assert.Equal(t, "create", deliveries[0].Type)
+	assert.Equal(t, "dead", deliveries[0].State)
+	assert.Equal(t, int32(12), deliveries[0].Attempts)
+	require.NotNil(t, deliveries[0].LastError)
+	assert.Equal(t, "connection refused", *deliveries[0].LastError)
+
+	body, err := json.Marshal(webhook.ReplayDeliveriesRequest{ExternalInitiator: ei.Name})
+	require.NoError(t, err)
+	resp, cleanup = client.Post("/v2/external_initiator_deliveries/replay", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var replay presenters.ExternalInitiatorDeliveriesReplayResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &replay))
+	assert.Equal(t, int64(1), replay.Count)
+
+	resp, cleanup = client.Get("/v2/external_initiator_deliveries?state=dead")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	deliveries = nil
+	require.NoError(t, web.ParsePaginatedResponse(cltest.ParseResponseBody(t, resp), &deliveries, &links))
+	assert.Empty(t, deliveries)
+}
@@ -161,6 +161,55 @@ func (jc *JobsController) Delete(c *gin.Context) {
 	jsonAPIResponseWithStatus(c, nil, "job", http.StatusNoContent)
 }
 
+// Pause stops the services of a job without deleting it.
+// Example:
+// "POST <application>/jobs/:ID/pause"
+func (jc *JobsController) Pause(c *gin.Context) {
+	jc.setPaused(c, true)
+}
+
+// Resume starts the services of a paused job.
+// Example:
+// "POST <application>/jobs/:ID/resume"
+func (jc *JobsController) Resume(c *gin.Context) {
+	jc.setPaused(c, false)
+}
+
+func (jc *JobsController) setPaused(c *gin.Context, paused bool) {
+	ctx := c.Request.Context()
+	j := job.Job{}
+	err := j.SetID(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	event := audit.JobResumed
+	if paused {
+		event = audit.JobPaused
+		err = jc.App.JobSpawner().PauseJob(ctx, j.ID)
+	} else {
+		err = jc.App.JobSpawner().ResumeJob(ctx, j.ID)
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jc.App.GetAuditLogger().Audit(event, map[string]interface{}{"id": j.ID})
+
+	jb, err := jc.App.JobORM().FindJob(ctx, j.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+}
+
 // UpdateJobRequest represents a request to update a job with new toml and start a job (V2).
 type UpdateJobRequest struct {
 	TOML string `json:"toml"`
@@ -191,6 +240,14 @@ func (jc *JobsController) Update(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
 
+	// A paused job stays paused once updated
+	existing, err := jc.App.JobORM().FindJob(ctx, jb.ID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jb.PausedAt = existing.PausedAt
+
 	// If the provided job id is not matching any job, delete will fail with 404 leaving state unchanged.
 	err = jc.App.DeleteJob(ctx, jb.ID)
 	// Error can be either come from ORM or from the activeJobs map.
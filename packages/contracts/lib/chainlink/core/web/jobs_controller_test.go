@@ -629,6 +629,33 @@ func TestJobsController_Show_NonExistentID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusNotFound)
 }
 
+func TestJobsController_PauseResume(t *testing.T) {
+	app, client, _, _, _, jobID := setupJobSpecsControllerTestsWithJobs(t)
+	cltest.AwaitJobActive(t, app.JobSpawner(), jobID, 3*time.Second)
+
+	response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%d/pause", jobID), nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	resource := presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+	assert.True(t, resource.PausedAt.Valid)
+	assert.NotContains(t, app.JobSpawner().ActiveJobs(), jobID)
+
+	response, cleanup = client.Post(fmt.Sprintf("/v2/jobs/%d/resume", jobID), nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	resource = presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+	assert.False(t, resource.PausedAt.Valid)
+	cltest.AwaitJobActive(t, app.JobSpawner(), jobID, 3*time.Second)
+
+	response, cleanup = client.Post("/v2/jobs/999999999/pause", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
 func TestJobsController_Update_HappyPath(t *testing.T) {
 	ctx := testutils.Context(t)
 	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
@@ -693,6 +720,61 @@ func TestJobsController_Update_HappyPath(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusOK)
 }
 
+func TestJobsController_Update_KeepsJobPaused(t *testing.T) {
+	ctx := testutils.Context(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.OCR.Enabled = ptr(true)
+		c.P2P.V2.Enabled = ptr(true)
+		c.P2P.V2.ListenAddresses = &[]string{fmt.Sprintf("127.0.0.1:%d", freeport.GetOne(t))}
+		c.P2P.PeerID = &cltest.DefaultP2PPeerID
+	})
+	app := cltest.NewApplicationWithConfigAndKey(t, cfg, cltest.DefaultP2PKey)
+
+	require.NoError(t, app.KeyStore.OCR().Add(ctx, cltest.DefaultOCRKey))
+	require.NoError(t, app.Start(ctx))
+
+	_, bridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+	_, bridge2 := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+
+	client := app.NewHTTPClient(nil)
+
+	var jb job.Job
+	ocrspec := testspecs.GenerateOCRSpec(testspecs.OCRSpecParams{
+		DS1BridgeName: bridge.Name.String(),
+		DS2BridgeName: bridge2.Name.String(),
+		Name:          "old OCR job",
+	})
+	require.NoError(t, toml.Unmarshal([]byte(ocrspec.Toml()), &jb))
+	require.NoError(t, utils.JustError(
+		app.GetDB().ExecContext(ctx, `SET CONSTRAINTS job_spec_errors_v2_job_id_fkey DEFERRED`)))
+	var ocrSpec job.OCROracleSpec
+	require.NoError(t, toml.Unmarshal([]byte(ocrspec.Toml()), &ocrSpec))
+	jb.OCROracleSpec = &ocrSpec
+	jb.OCROracleSpec.TransmitterAddress = &app.Keys[0].EIP55Address
+	require.NoError(t, app.AddJobV2(ctx, &jb))
+	require.NoError(t, app.JobSpawner().PauseJob(ctx, jb.ID))
+
+	updatedSpec := testspecs.GenerateOCRSpec(testspecs.OCRSpecParams{
+		DS1BridgeName:      bridge2.Name.String(),
+		DS2BridgeName:      bridge.Name.String(),
+		Name:               "updated OCR job",
+		TransmitterAddress: app.Keys[0].Address.Hex(),
+	})
+	body, err := json.Marshal(web.UpdateJobRequest{
+		TOML: updatedSpec.Toml(),
+	})
+	require.NoError(t, err)
+	response, cleanup := client.Put("/v2/jobs/"+strconv.Itoa(int(jb.ID)), bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	dbJb, err := app.JobORM().FindJob(ctx, jb.ID)
+	require.NoError(t, err)
+	require.Equal(t, updatedSpec.Name, dbJb.Name.String)
+	assert.True(t, dbJb.IsPaused())
+	assert.NotContains(t, app.JobSpawner().ActiveJobs(), jb.ID)
+}
+
 func TestJobsController_Update_NonExistentID(t *testing.T) {
 	ctx := testutils.Context(t)
 	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
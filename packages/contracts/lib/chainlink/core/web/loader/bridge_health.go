@@ -0,0 +1,32 @@
+package loader
+
+import (
+	"context"
+
+	"github.com/graph-gophers/dataloader"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+)
+
+type bridgeHealthBatcher struct {
+	app chainlink.Application
+}
+
+func (b *bridgeHealthBatcher) loadByNames(_ context.Context, keys dataloader.Keys) []*dataloader.Result {
+	monitor := b.app.BridgeHealth()
+
+	// Construct the output array of dataloader results
+	results := make([]*dataloader.Result, len(keys))
+	for ix, key := range keys {
+		name := bridges.BridgeName(key.String())
+		h, ok := monitor.Health(name)
+		if !ok {
+			// the bridge was neither requested nor probed yet
+			h = bridges.BridgeHealth{Name: name, Status: bridges.HealthStatusUnknown}
+		}
+		results[ix] = &dataloader.Result{Data: h, Error: nil}
+	}
+
+	return results
+}
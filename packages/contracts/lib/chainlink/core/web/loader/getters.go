@@ -9,6 +9,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr"
 	"github.com/smartcontractkit/chainlink-evm/pkg/types"
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
@@ -19,6 +20,24 @@ import (
 // ErrInvalidType indicates that results loaded is not the type expected
 var ErrInvalidType = errors.New("invalid type")
 
+// GetBridgeHealthByName fetches the health of the bridge by name.
+func GetBridgeHealthByName(ctx context.Context, name string) (*bridges.BridgeHealth, error) {
+	ldr := For(ctx)
+
+	thunk := ldr.BridgeHealthsByNameLoader.Load(ctx, dataloader.StringKey(name))
+	result, err := thunk()
+	if err != nil {
+		return nil, err
+	}
+
+	health, ok := result.(bridges.BridgeHealth)
+	if !ok {
+		return nil, ErrInvalidType
+	}
+
+	return &health, nil
+}
+
 // GetChainByID fetches the chain by it's id.
 // Deprecated: use GetChainByRelayID.
 func GetChainByID(ctx context.Context, id string) (*chainlink.NetworkChainStatus, error) {
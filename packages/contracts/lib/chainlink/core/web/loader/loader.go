@@ -14,6 +14,7 @@ type loadersKey struct{}
 type Dataloader struct {
 	app chainlink.Application
 
+	BridgeHealthsByNameLoader                 *dataloader.Loader
 	ChainsByIDLoader                          *dataloader.Loader
 	ChainsByRelayIDLoader                     *dataloader.Loader
 	EthTxAttemptsByEthTxIDLoader              *dataloader.Loader
@@ -30,6 +31,7 @@ type Dataloader struct {
 
 func New(app chainlink.Application) *Dataloader {
 	var (
+		health   = &bridgeHealthBatcher{app: app}
 		nodes    = &nodeBatcher{app: app}
 		chains   = &chainBatcher{app: app}
 		mgrs     = &feedsBatcher{app: app}
@@ -45,6 +47,7 @@ func New(app chainlink.Application) *Dataloader {
 	return &Dataloader{
 		app: app,
 
+		BridgeHealthsByNameLoader:                 dataloader.NewBatchedLoader(health.loadByNames),
 		ChainsByIDLoader:                          dataloader.NewBatchedLoader(chains.loadByIDs),
 		ChainsByRelayIDLoader:                     dataloader.NewBatchedLoader(chains.loadByRelayIDs),
 		EthTxAttemptsByEthTxIDLoader:              dataloader.NewBatchedLoader(attmpts.loadByEthTransactionIDs),
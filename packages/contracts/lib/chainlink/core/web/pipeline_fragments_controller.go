@@ -0,0 +1,99 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// PipelineFragmentsController manages reusable pipeline fragments.
+type PipelineFragmentsController struct {
+	App chainlink.Application
+}
+
+// CreatePipelineFragmentRequest represents a request to create a new version of a pipeline fragment.
+type CreatePipelineFragmentRequest struct {
+	Name   string `json:"name"`
+	Source string `json:"source"`
+}
+
+// Index lists all versions of all pipeline fragments.
+// Example:
+// "GET <application>/pipeline/fragments"
+func (pfc *PipelineFragmentsController) Index(c *gin.Context) {
+	fragments, err := pfc.App.PipelineORM().FindFragments(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineFragmentResources(fragments), "pipelineFragments")
+}
+
+// Show returns a version of a pipeline fragment, and the jobs invoking the fragment.
+// Example:
+// "GET <application>/pipeline/fragments/:name/:version"
+func (pfc *PipelineFragmentsController) Show(c *gin.Context) {
+	ctx := c.Request.Context()
+	name := c.Param("name")
+	version, err := strconv.ParseInt(c.Param("version"), 10, 32)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid version"))
+		return
+	}
+
+	fragment, err := pfc.App.PipelineORM().FindFragment(ctx, name, int32(version))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("fragment not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jobIDs, err := pfc.App.JobORM().FindJobIDsWithFragment(ctx, name)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineFragmentResource(fragment, jobIDs), "pipelineFragments")
+}
+
+// Create stores the source as the next version of the named fragment. Jobs keep invoking the version in their
+// spec: the response lists the jobs invoking the fragment, which must be updated to use the new version.
+// Example:
+// "POST <application>/pipeline/fragments"
+func (pfc *PipelineFragmentsController) Create(c *gin.Context) {
+	ctx := c.Request.Context()
+	request := CreatePipelineFragmentRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	fragment, err := pfc.App.PipelineORM().CreateFragment(ctx, request.Name, request.Source)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	jobIDs, err := pfc.App.JobORM().FindJobIDsWithFragment(ctx, fragment.Name)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	pfc.App.GetAuditLogger().Audit(audit.PipelineFragmentCreated, map[string]interface{}{
+		"name":    fragment.Name,
+		"version": fragment.Version,
+		"source":  fragment.DotDagSource,
+	})
+
+	jsonAPIResponseWithStatus(c, presenters.NewPipelineFragmentResource(fragment, jobIDs), "pipelineFragments", http.StatusCreated)
+}
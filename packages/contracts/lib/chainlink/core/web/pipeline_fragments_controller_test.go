@@ -0,0 +1,103 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestPipelineFragmentsController(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+
+	const source = `
+mul [type=multiply input="$(params.x)" times=2];
+`
+	create := func(t *testing.T, request web.CreatePipelineFragmentRequest) *http.Response {
+		body, err := json.Marshal(request)
+		require.NoError(t, err)
+		response, cleanup := client.Post("/v2/pipeline/fragments", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		return response
+	}
+
+	t.Run("creates versions", func(t *testing.T) {
+		for _, version := range []int32{1, 2} {
+			response := create(t, web.CreatePipelineFragmentRequest{Name: "double", Source: source})
+			cltest.AssertServerResponse(t, response, http.StatusCreated)
+
+			var resource presenters.PipelineFragmentResource
+			require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+			assert.Equal(t, "double", resource.Name)
+			assert.Equal(t, version, resource.Version)
+			assert.Equal(t, fmt.Sprintf("double@%d", version), resource.ID)
+			assert.Empty(t, resource.JobIDs)
+		}
+	})
+
+	t.Run("rejects invalid fragments", func(t *testing.T) {
+		response := create(t, web.CreatePipelineFragmentRequest{Name: "double", Source: "mul [type=multiply"})
+		cltest.AssertServerResponse(t, response, http.StatusBadRequest)
+
+		response = create(t, web.CreatePipelineFragmentRequest{Name: "dou ble", Source: source})
+		cltest.AssertServerResponse(t, response, http.StatusBadRequest)
+	})
+
+	t.Run("lists all versions", func(t *testing.T) {
+		response, cleanup := client.Get("/v2/pipeline/fragments")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var resources []presenters.PipelineFragmentResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resources))
+		require.Len(t, resources, 2)
+		assert.Equal(t, int32(1), resources[0].Version)
+		assert.Equal(t, int32(2), resources[1].Version)
+	})
+
+	t.Run("shows a version with the jobs invoking the fragment", func(t *testing.T) {
+		tomlStr := fmt.Sprintf(`
+type            = "webhook"
+schemaVersion   = 1
+externalJobID   = "%s"
+observationSource   = """
+f [type=fragment name=double version=1 params=<{"x": 21}>];
+"""
+`, uuid.New())
+		jb, err := webhook.ValidatedWebhookSpec(ctx, tomlStr, app.GetExternalInitiatorManager())
+		require.NoError(t, err)
+		require.NoError(t, app.AddJobV2(ctx, &jb))
+
+		response, cleanup := client.Get("/v2/pipeline/fragments/double/1")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var resource presenters.PipelineFragmentResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		assert.Equal(t, int32(1), resource.Version)
+		assert.Equal(t, source, resource.DotDagSource)
+		assert.Equal(t, []int32{jb.ID}, resource.JobIDs)
+	})
+
+	t.Run("returns 404 for missing versions", func(t *testing.T) {
+		response, cleanup := client.Get("/v2/pipeline/fragments/double/3")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusNotFound)
+	})
+}
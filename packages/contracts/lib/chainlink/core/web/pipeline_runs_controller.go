@@ -5,6 +5,7 @@ import (
 	"io"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -85,6 +86,55 @@ func (prc *PipelineRunsController) Show(c *gin.Context) {
 	jsonAPIResponse(c, res, "pipelineRun")
 }
 
+// eventStreamHeartbeat is the interval of the comments sent to keep idle event streams open.
+const eventStreamHeartbeat = 15 * time.Second
+
+// Events streams the lifecycle events of pipeline runs as server-sent events, until the client disconnects.
+// The name of each event is its type, and its data is the JSON encoded pipeline.RunEvent.
+// Example:
+// "GET <application>/pipeline/runs/events"
+// "GET <application>/jobs/:ID/runs/events"
+func (prc *PipelineRunsController) Events(c *gin.Context) {
+	var jobID int32
+	if id := c.Param("ID"); id != "" {
+		jobSpec := job.Job{}
+		if err := jobSpec.SetID(id); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jobID = jobSpec.ID
+	}
+
+	events, unsubscribe := prc.App.SubscribePipelineRunEvents(jobID)
+	defer unsubscribe()
+
+	// the stream outlives the write timeout of the server
+	if err := http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{}); err != nil {
+		prc.App.GetLogger().Debugw("Unable to clear write deadline of pipeline run events stream", "err", err)
+	}
+	c.Header("X-Accel-Buffering", "no")
+
+	heartbeat := time.NewTicker(eventStreamHeartbeat)
+	defer heartbeat.Stop()
+
+	ctx := c.Request.Context()
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-ctx.Done():
+			return false
+		case event, ok := <-events:
+			if !ok {
+				return false
+			}
+			c.SSEvent(string(event.Type), event)
+			return true
+		case <-heartbeat.C:
+			_, err := io.WriteString(w, ": heartbeat\n\n")
+			return err == nil
+		}
+	})
+}
+
 // Create triggers a pipeline run for a job.
 // Example:
 // "POST <application>/jobs/:ID/runs"
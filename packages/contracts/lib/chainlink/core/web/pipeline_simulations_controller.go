@@ -0,0 +1,66 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// PipelineSimulationsController runs job pipelines without side effects.
+type PipelineSimulationsController struct {
+	App chainlink.Application
+}
+
+// SimulatePipelineRequest represents a request to simulate the pipeline of a job spec.
+type SimulatePipelineRequest struct {
+	TOML  string                       `json:"toml"`
+	Vars  map[string]interface{}       `json:"vars"`
+	Stubs map[string]pipeline.TaskStub `json:"stubs"`
+}
+
+// Create validates a job spec and runs its pipeline in-memory, using the stubbed outputs of the given tasks.
+// Nothing is persisted and tasks with side effects are never executed.
+// Example:
+// "POST <application>/pipeline/simulate"
+func (psc *PipelineSimulationsController) Create(c *gin.Context) {
+	request := SimulatePipelineRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jc := JobsController{App: psc.App}
+	jb, status, err := jc.validateJobSpec(c.Request.Context(), request.TOML)
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+	if jb.Pipeline.Source == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("%s jobs do not have a pipeline to simulate", jb.Type))
+		return
+	}
+
+	spec := pipeline.Spec{
+		DotDagSource:      jb.Pipeline.Source,
+		MaxTaskDuration:   jb.MaxTaskDuration,
+		ForwardingAllowed: jb.ForwardingAllowed,
+		JobName:           jb.Name.ValueOrZero(),
+		JobType:           string(jb.Type),
+	}
+	if jb.GasLimit.Valid {
+		spec.GasLimit = &jb.GasLimit.Uint32
+	}
+
+	run, trrs, err := psc.App.SimulatePipelineRun(c.Request.Context(), spec, pipeline.NewVarsFrom(request.Vars), request.Stubs)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineSimulationResource(*run, trrs, psc.App.GetLogger()), "pipelineSimulation")
+}
@@ -0,0 +1,76 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestPipelineSimulationsController_Create(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	simulate := func(t *testing.T, request web.SimulatePipelineRequest) *http.Response {
+		body, err := json.Marshal(request)
+		require.NoError(t, err)
+		response, cleanup := client.Post("/v2/pipeline/simulate", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		return response
+	}
+	tomlStr := fmt.Sprintf(testspecs.CronSpecTemplate, uuid.New())
+
+	t.Run("runs the pipeline with stubbed tasks", func(t *testing.T) {
+		response := simulate(t, web.SimulatePipelineRequest{
+			TOML: tomlStr,
+			Stubs: map[string]pipeline.TaskStub{
+				"ds": {Value: `{"data":{"price":1.5}}`},
+			},
+		})
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var resource presenters.PipelineSimulationResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		assert.Equal(t, pipeline.RunStatusCompleted, resource.State)
+		require.Len(t, resource.Outputs, 1)
+		assert.Equal(t, "150", *resource.Outputs[0])
+		require.Len(t, resource.TaskRuns, 3)
+		for _, tr := range resource.TaskRuns {
+			assert.Equal(t, tr.DotID == "ds", tr.Stubbed)
+			assert.Nil(t, tr.Error)
+		}
+
+		runs, count, err := app.JobORM().PipelineRuns(testutils.Context(t), nil, 0, 10)
+		require.NoError(t, err)
+		assert.Empty(t, runs)
+		assert.Zero(t, count)
+	})
+
+	t.Run("rejects unknown stubs", func(t *testing.T) {
+		response := simulate(t, web.SimulatePipelineRequest{
+			TOML:  tomlStr,
+			Stubs: map[string]pipeline.TaskStub{"nope": {Value: 1}},
+		})
+		cltest.AssertServerResponse(t, response, http.StatusBadRequest)
+	})
+
+	t.Run("rejects invalid specs", func(t *testing.T) {
+		response := simulate(t, web.SimulatePipelineRequest{TOML: "not toml"})
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+	})
+}
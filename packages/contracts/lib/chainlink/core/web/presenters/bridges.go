@@ -10,14 +10,18 @@ import (
 // BridgeResource represents a Bridge JSONAPI resource.
 type BridgeResource struct {
 	JAID
-	Name          string `json:"name"`
-	URL           string `json:"url"`
-	Confirmations uint32 `json:"confirmations"`
+	Name          string   `json:"name"`
+	URL           string   `json:"url"`
+	FallbackURLs  []string `json:"fallbackURLs"`
+	SigningScheme string   `json:"signingScheme"`
+	Confirmations uint32   `json:"confirmations"`
 	// The IncomingToken is only provided when creating a Bridge
 	IncomingToken          string       `json:"incomingToken,omitempty"`
 	OutgoingToken          string       `json:"outgoingToken"`
 	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
 	CreatedAt              time.Time    `json:"createdAt"`
+	// Health is only provided once the bridge was requested or probed
+	Health *BridgeHealthResource `json:"health,omitempty"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -32,9 +36,48 @@ func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
 		JAID:                   NewJAID(b.Name.String()),
 		Name:                   b.Name.String(),
 		URL:                    b.URL.String(),
+		FallbackURLs:           b.URLs()[1:],
+		SigningScheme:          string(b.SigningScheme),
 		Confirmations:          b.Confirmations,
 		OutgoingToken:          b.OutgoingToken,
 		MinimumContractPayment: b.MinimumContractPayment,
 		CreatedAt:              b.CreatedAt,
 	}
 }
+
+// BridgeHealthResource represents the health of a bridge.
+type BridgeHealthResource struct {
+	Status string                    `json:"status"`
+	URLs   []BridgeURLHealthResource `json:"urls"`
+}
+
+// BridgeURLHealthResource represents the health of a URL of a bridge.
+type BridgeURLHealthResource struct {
+	URL                 string     `json:"url"`
+	Breaker             string     `json:"breaker"`
+	ConsecutiveFailures uint32     `json:"consecutiveFailures"`
+	LastCheckedAt       *time.Time `json:"lastCheckedAt"`
+	LastError           string     `json:"lastError,omitempty"`
+}
+
+// NewBridgeHealthResource constructs a new BridgeHealthResource
+func NewBridgeHealthResource(h bridges.BridgeHealth) *BridgeHealthResource {
+	r := &BridgeHealthResource{
+		Status: string(h.Status),
+		URLs:   []BridgeURLHealthResource{},
+	}
+	for _, u := range h.URLs {
+		ur := BridgeURLHealthResource{
+			URL:                 u.URL,
+			Breaker:             string(u.Breaker),
+			ConsecutiveFailures: u.ConsecutiveFailures,
+			LastError:           u.LastError,
+		}
+		if !u.LastCheckedAt.IsZero() {
+			lastCheckedAt := u.LastCheckedAt
+			ur.LastCheckedAt = &lastCheckedAt
+		}
+		r.URLs = append(r.URLs, ur)
+	}
+	return r
+}
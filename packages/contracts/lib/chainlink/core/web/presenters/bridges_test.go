@@ -43,6 +43,8 @@ func TestBridgeResource(t *testing.T) {
 		"attributes":{
 			"name":"test",
 			"url":"https://bridge.example.com/api",
+			"fallbackURLs":[],
+			"signingScheme":"",
 			"confirmations":1,
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 			"minimumContractPayment":"1",
@@ -67,6 +69,8 @@ func TestBridgeResource(t *testing.T) {
 		"attributes":{
 			"name":"test",
 			"url":"https://bridge.example.com/api",
+			"fallbackURLs":[],
+			"signingScheme":"",
 			"confirmations":1,
 			"incomingToken": "cd+OfGXy3UHEDAlD0y27F6/rJE14X1UI",
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
@@ -6,6 +6,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
@@ -80,3 +81,48 @@ func NewExternalInitiatorResource(ei bridges.ExternalInitiator) ExternalInitiato
 func (ExternalInitiatorResource) GetName() string {
 	return "externalInitiators"
 }
+
+// ExternalInitiatorDeliveryResource represents a notification of a job to an external initiator.
+type ExternalInitiatorDeliveryResource struct {
+	JAID
+	ExternalInitiator string    `json:"externalInitiator"`
+	JobID             string    `json:"jobID"`
+	Type              string    `json:"type"`
+	State             string    `json:"state"`
+	Attempts          int32     `json:"attempts"`
+	LastError         *string   `json:"lastError"`
+	NextAttemptAt     time.Time `json:"nextAttemptAt"`
+	CreatedAt         time.Time `json:"createdAt"`
+	UpdatedAt         time.Time `json:"updatedAt"`
+}
+
+func NewExternalInitiatorDeliveryResource(d webhook.Delivery) ExternalInitiatorDeliveryResource {
+	return ExternalInitiatorDeliveryResource{
+		JAID:              NewJAID(strconv.FormatInt(d.ID, 10)),
+		ExternalInitiator: d.ExternalInitiatorName,
+		JobID:             d.JobID.String(),
+		Type:              string(d.Type),
+		State:             string(d.State),
+		Attempts:          d.Attempts,
+		LastError:         d.LastError.Ptr(),
+		NextAttemptAt:     d.NextAttemptAt,
+		CreatedAt:         d.CreatedAt,
+		UpdatedAt:         d.UpdatedAt,
+	}
+}
+
+// GetName returns the collection name for jsonapi.
+func (ExternalInitiatorDeliveryResource) GetName() string {
+	return "externalInitiatorDeliveries"
+}
+
+// ExternalInitiatorDeliveriesReplayResource represents the replay of external initiator deliveries.
+type ExternalInitiatorDeliveriesReplayResource struct {
+	JAID
+	Count int64 `json:"count"`
+}
+
+// GetName returns the collection name for jsonapi.
+func (ExternalInitiatorDeliveriesReplayResource) GetName() string {
+	return "externalInitiatorDeliveriesReplays"
+}
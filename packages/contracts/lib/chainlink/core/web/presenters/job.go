@@ -256,19 +256,29 @@ func NewWebhookSpec(spec *job.WebhookSpec) *WebhookSpec {
 
 // CronSpec defines the spec details of a Cron Job
 type CronSpec struct {
-	CronSchedule string    `json:"schedule"`
-	CreatedAt    time.Time `json:"createdAt"`
-	UpdatedAt    time.Time `json:"updatedAt"`
-	EVMChainID   *big.Big  `json:"evmChainID"`
+	CronSchedule      string                    `json:"schedule"`
+	CatchUpPolicy     job.CronCatchUpPolicy     `json:"catchUpPolicy"`
+	CatchUpWindow     models.Interval           `json:"catchUpWindow"`
+	ConcurrencyPolicy job.CronConcurrencyPolicy `json:"concurrencyPolicy"`
+	Jitter            models.Interval           `json:"jitter"`
+	LastFiredAt       null.Time                 `json:"lastFiredAt"`
+	CreatedAt         time.Time                 `json:"createdAt"`
+	UpdatedAt         time.Time                 `json:"updatedAt"`
+	EVMChainID        *big.Big                  `json:"evmChainID"`
 }
 
 // NewCronSpec generates a new CronSpec from a job.CronSpec
 func NewCronSpec(spec *job.CronSpec) *CronSpec {
 	return &CronSpec{
-		CronSchedule: spec.CronSchedule,
-		CreatedAt:    spec.CreatedAt,
-		UpdatedAt:    spec.UpdatedAt,
-		EVMChainID:   spec.EVMChainID,
+		CronSchedule:      spec.CronSchedule,
+		CatchUpPolicy:     spec.CatchUpPolicy,
+		CatchUpWindow:     spec.CatchUpWindow,
+		ConcurrencyPolicy: spec.ConcurrencyPolicy,
+		Jitter:            spec.Jitter,
+		LastFiredAt:       spec.LastFiredAt,
+		CreatedAt:         spec.CreatedAt,
+		UpdatedAt:         spec.UpdatedAt,
+		EVMChainID:        spec.EVMChainID,
 	}
 }
 
@@ -539,6 +549,7 @@ type JobResource struct {
 	CCIPSpec                 *CCIPSpec                 `json:"ccipSpec"`
 	PipelineSpec             PipelineSpec              `json:"pipelineSpec"`
 	Errors                   []JobError                `json:"errors"`
+	PausedAt                 null.Time                 `json:"pausedAt"`
 }
 
 // NewJobResource initializes a new JSONAPI job resource
@@ -554,6 +565,7 @@ func NewJobResource(j job.Job) *JobResource {
 		MaxTaskDuration:   j.MaxTaskDuration,
 		PipelineSpec:      NewPipelineSpec(j.PipelineSpec),
 		ExternalJobID:     j.ExternalJobID,
+		PausedAt:          j.PausedAt,
 	}
 
 	switch j.Type {
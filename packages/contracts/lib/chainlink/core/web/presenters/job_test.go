@@ -131,6 +131,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"pausedAt": null,
 						"errors": []
 					}
 				}
@@ -210,6 +211,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"pausedAt": null,
 						"errors": []
 					}
 				}
@@ -299,6 +301,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"pausedAt": null,
 						"errors": []
 					}
 				}
@@ -365,6 +368,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"pausedAt": null,
 						"errors": []
 					}
 				}
@@ -428,6 +432,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+                        "pausedAt": null,
                         "errors": []
                     }
                 }
@@ -487,6 +492,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"pausedAt": null,
 						"errors": []
 					}
 				}
@@ -575,6 +581,7 @@ func TestJob(t *testing.T) {
 						"standardCapabilitiesSpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"pausedAt": null,
 						"errors": []
 					}
 				}
@@ -658,6 +665,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"pausedAt": null,
 						"errors": []
 					}
 				}
@@ -741,6 +749,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"pausedAt": null,
 						"errors": []
 					}
 				}
@@ -808,6 +817,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"pausedAt": null,
 						"errors": []
 					}
 				}
@@ -872,6 +882,7 @@ func TestJob(t *testing.T) {
 							"jobID": 0,
 							"dotDagSource": ""
 						},
+						"pausedAt": null,
 						"errors": []
 					}
 				}
@@ -937,6 +948,7 @@ func TestJob(t *testing.T) {
 							"jobID": 0,
 							"dotDagSource": ""
 						},
+						"pausedAt": null,
 						"errors": []
 					}
 				}
@@ -998,6 +1010,7 @@ func TestJob(t *testing.T) {
 							"jobID": 0,
 							"dotDagSource": ""
 						},
+						"pausedAt": null,
 						"errors": []
 					}
 				}
@@ -1063,6 +1076,7 @@ func TestJob(t *testing.T) {
 							"jobID": 0,
 							"dotDagSource": ""
 						},
+						"pausedAt": null,
 						"errors": []
 					}
 				}
@@ -1138,6 +1152,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"ccipSpec": null,
+						"pausedAt": null,
 						"errors": [{
 							"id": 200,
 							"description": "some error",
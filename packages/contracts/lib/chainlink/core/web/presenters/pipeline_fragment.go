@@ -0,0 +1,45 @@
+package presenters
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// PipelineFragmentResource represents a version of a pipeline fragment JSONAPI resource.
+type PipelineFragmentResource struct {
+	JAID
+	Name         string    `json:"name"`
+	Version      int32     `json:"version"`
+	DotDagSource string    `json:"dotDagSource"`
+	CreatedAt    time.Time `json:"createdAt"`
+	// JobIDs are the jobs invoking any version of the fragment
+	JobIDs []int32 `json:"jobIDs,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineFragmentResource) GetName() string {
+	return "pipelineFragments"
+}
+
+// NewPipelineFragmentResource constructs a new PipelineFragmentResource
+func NewPipelineFragmentResource(f pipeline.Fragment, jobIDs []int32) *PipelineFragmentResource {
+	return &PipelineFragmentResource{
+		JAID:         NewJAID(fmt.Sprintf("%s@%d", f.Name, f.Version)),
+		Name:         f.Name,
+		Version:      f.Version,
+		DotDagSource: f.DotDagSource,
+		CreatedAt:    f.CreatedAt,
+		JobIDs:       jobIDs,
+	}
+}
+
+// NewPipelineFragmentResources constructs a slice of PipelineFragmentResources
+func NewPipelineFragmentResources(fs []pipeline.Fragment) []PipelineFragmentResource {
+	rs := []PipelineFragmentResource{}
+	for _, f := range fs {
+		rs = append(rs, *NewPipelineFragmentResource(f, nil))
+	}
+	return rs
+}
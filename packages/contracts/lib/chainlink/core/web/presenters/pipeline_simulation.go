@@ -0,0 +1,97 @@
+package presenters
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// PipelineSimulationResource is the result of a simulated pipeline run
+type PipelineSimulationResource struct {
+	JAID
+	State       pipeline.RunStatus                  `json:"state"`
+	Outputs     []*string                           `json:"outputs"`
+	AllErrors   []*string                           `json:"allErrors"`
+	FatalErrors []*string                           `json:"fatalErrors"`
+	TaskRuns    []PipelineSimulationTaskRunResource `json:"taskRuns"`
+	CreatedAt   time.Time                           `json:"createdAt"`
+	FinishedAt  null.Time                           `json:"finishedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineSimulationResource) GetName() string {
+	return "pipelineSimulation"
+}
+
+// PipelineSimulationTaskRunResource is the result of a single task of a simulated pipeline run
+type PipelineSimulationTaskRunResource struct {
+	DotID      string            `json:"dotId"`
+	Type       pipeline.TaskType `json:"type"`
+	Inputs     []string          `json:"inputs"`
+	Stubbed    bool              `json:"stubbed"`
+	Output     *string           `json:"output"`
+	Error      *string           `json:"error"`
+	CreatedAt  time.Time         `json:"createdAt"`
+	FinishedAt null.Time         `json:"finishedAt"`
+	Duration   string            `json:"duration"`
+}
+
+func NewPipelineSimulationResource(run pipeline.Run, trrs pipeline.TaskRunResults, lggr logger.Logger) PipelineSimulationResource {
+	lggr = lggr.Named("PipelineSimulationResource")
+	outputs, err := run.StringOutputs()
+	if err != nil {
+		lggr.Errorw(err.Error(), "out", run.Outputs)
+	}
+
+	var trs []PipelineSimulationTaskRunResource
+	for _, trr := range trrs {
+		trs = append(trs, NewPipelineSimulationTaskRunResource(trr))
+	}
+
+	return PipelineSimulationResource{
+		JAID:        NewJAID("simulation"),
+		State:       run.State,
+		Outputs:     outputs,
+		AllErrors:   run.StringAllErrors(),
+		FatalErrors: run.StringFatalErrors(),
+		TaskRuns:    trs,
+		CreatedAt:   run.CreatedAt,
+		FinishedAt:  run.FinishedAt,
+	}
+}
+
+func NewPipelineSimulationTaskRunResource(trr pipeline.TaskRunResult) PipelineSimulationTaskRunResource {
+	var inputs []string
+	for _, input := range trr.Task.Inputs() {
+		inputs = append(inputs, input.InputTask.DotID())
+	}
+	var output *string
+	if trr.Result.Error == nil {
+		outputBytes, _ := trr.Result.OutputDB().MarshalJSON()
+		outputStr := string(outputBytes)
+		output = &outputStr
+	}
+	var errString *string
+	if trr.Result.Error != nil {
+		errStr := trr.Result.Error.Error()
+		errString = &errStr
+	}
+	var duration string
+	if trr.FinishedAt.Valid {
+		duration = trr.FinishedAt.Time.Sub(trr.CreatedAt).String()
+	}
+	return PipelineSimulationTaskRunResource{
+		DotID:      trr.Task.DotID(),
+		Type:       trr.Task.Type(),
+		Inputs:     inputs,
+		Stubbed:    pipeline.IsStubbed(trr.Task),
+		Output:     output,
+		Error:      errString,
+		CreatedAt:  trr.CreatedAt,
+		FinishedAt: trr.FinishedAt,
+		Duration:   duration,
+	}
+}
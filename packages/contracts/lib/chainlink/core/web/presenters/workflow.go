@@ -0,0 +1,213 @@
+package presenters
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/admin"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+// WorkflowResource represents a workflow JSONAPI resource.
+type WorkflowResource struct {
+	JAID
+	WorkflowID    string                  `json:"workflowID"`
+	Owner         string                  `json:"owner"`
+	Name          string                  `json:"name"`
+	Status        job.WorkflowSpecStatus  `json:"status"`
+	SpecType      job.WorkflowSpecType    `json:"specType"`
+	BinaryURL     string                  `json:"binaryURL"`
+	ConfigURL     string                  `json:"configURL"`
+	PausedLocally bool                    `json:"pausedLocally"`
+	Engine        *WorkflowEngineResource `json:"engine"`
+	CreatedAt     time.Time               `json:"createdAt"`
+	UpdatedAt     time.Time               `json:"updatedAt"`
+}
+
+// WorkflowEngineResource represents the engine running a workflow on this node.
+type WorkflowEngineResource struct {
+	// WorkflowID is the ID of the workflow version run by the engine
+	WorkflowID string `json:"workflowID"`
+	Ready      bool   `json:"ready"`
+	Healthy    bool   `json:"healthy"`
+	// Errors holds the errors of the unhealthy services of the engine, by service name
+	Errors map[string]string `json:"errors"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r WorkflowResource) GetName() string {
+	return "workflows"
+}
+
+// NewWorkflowResource constructs a new WorkflowResource
+func NewWorkflowResource(wf admin.Workflow) *WorkflowResource {
+	r := &WorkflowResource{
+		JAID:          NewJAID(wf.Spec.WorkflowID),
+		WorkflowID:    wf.Spec.WorkflowID,
+		Owner:         wf.Spec.WorkflowOwner,
+		Name:          wf.Spec.WorkflowName,
+		Status:        wf.Spec.Status,
+		SpecType:      wf.Spec.SpecType,
+		BinaryURL:     wf.Spec.BinaryURL,
+		ConfigURL:     wf.Spec.ConfigURL,
+		PausedLocally: wf.PausedLocally,
+		CreatedAt:     wf.Spec.CreatedAt,
+		UpdatedAt:     wf.Spec.UpdatedAt,
+	}
+
+	if wf.Engine != nil {
+		r.Engine = &WorkflowEngineResource{
+			WorkflowID: wf.Engine.WorkflowID,
+			Ready:      wf.Engine.Ready == nil,
+			Healthy:    true,
+			Errors:     map[string]string{},
+		}
+		for name, err := range wf.Engine.Health {
+			if err != nil {
+				r.Engine.Healthy = false
+				r.Engine.Errors[name] = err.Error()
+			}
+		}
+	}
+
+	return r
+}
+
+// NewWorkflowResources initializes a slice of JSONAPI workflow resources
+func NewWorkflowResources(wfs []admin.Workflow) []WorkflowResource {
+	rs := []WorkflowResource{}
+	for _, wf := range wfs {
+		rs = append(rs, *NewWorkflowResource(wf))
+	}
+
+	return rs
+}
+
+// WorkflowExecutionResource represents a workflow execution JSONAPI resource.
+type WorkflowExecutionResource struct {
+	JAID
+	WorkflowID string                          `json:"workflowID"`
+	Status     string                          `json:"status"`
+	Steps      []WorkflowExecutionStepResource `json:"steps"`
+	CreatedAt  *time.Time                      `json:"createdAt"`
+	UpdatedAt  *time.Time                      `json:"updatedAt"`
+	FinishedAt *time.Time                      `json:"finishedAt"`
+}
+
+// WorkflowExecutionStepResource represents the state of a step of a workflow execution.
+type WorkflowExecutionStepResource struct {
+	Ref       string     `json:"ref"`
+	Status    string     `json:"status"`
+	Error     *string    `json:"error"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r WorkflowExecutionResource) GetName() string {
+	return "workflowExecutions"
+}
+
+// NewWorkflowExecutionResource constructs a new WorkflowExecutionResource
+func NewWorkflowExecutionResource(execution store.WorkflowExecution) *WorkflowExecutionResource {
+	steps := []WorkflowExecutionStepResource{}
+	for _, step := range execution.Steps {
+		s := WorkflowExecutionStepResource{
+			Ref:       step.Ref,
+			Status:    step.Status,
+			UpdatedAt: step.UpdatedAt,
+		}
+		if step.Outputs.Err != nil {
+			errMsg := step.Outputs.Err.Error()
+			s.Error = &errMsg
+		}
+		steps = append(steps, s)
+	}
+	sort.Slice(steps, func(i, j int) bool {
+		return steps[i].Ref < steps[j].Ref
+	})
+
+	return &WorkflowExecutionResource{
+		JAID:       NewJAID(execution.ExecutionID),
+		WorkflowID: execution.WorkflowID,
+		Status:     execution.Status,
+		Steps:      steps,
+		CreatedAt:  execution.CreatedAt,
+		UpdatedAt:  execution.UpdatedAt,
+		FinishedAt: execution.FinishedAt,
+	}
+}
+
+// NewWorkflowExecutionResources initializes a slice of JSONAPI workflow execution resources
+func NewWorkflowExecutionResources(executions []store.WorkflowExecution) []WorkflowExecutionResource {
+	rs := []WorkflowExecutionResource{}
+	for _, execution := range executions {
+		rs = append(rs, *NewWorkflowExecutionResource(execution))
+	}
+
+	return rs
+}
+
+// CapabilityResource represents a capability JSONAPI resource.
+type CapabilityResource struct {
+	JAID
+	CapabilityType string       `json:"capabilityType"`
+	Description    string       `json:"description"`
+	IsLocal        bool         `json:"isLocal"`
+	DON            *DONResource `json:"don"`
+}
+
+// DONResource represents the DON a remote capability is exposed by.
+type DONResource struct {
+	ID               string   `json:"id"`
+	ConfigVersion    uint32   `json:"configVersion"`
+	F                uint8    `json:"f"`
+	Members          []string `json:"members"`
+	IsPublic         bool     `json:"isPublic"`
+	AcceptsWorkflows bool     `json:"acceptsWorkflows"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r CapabilityResource) GetName() string {
+	return "capabilities"
+}
+
+// NewCapabilityResource constructs a new CapabilityResource
+func NewCapabilityResource(info capabilities.CapabilityInfo) *CapabilityResource {
+	r := &CapabilityResource{
+		JAID:           NewJAID(info.ID),
+		CapabilityType: string(info.CapabilityType),
+		Description:    info.Description,
+		IsLocal:        info.IsLocal,
+	}
+
+	if info.DON != nil {
+		members := make([]string, 0, len(info.DON.Members))
+		for _, m := range info.DON.Members {
+			members = append(members, m.String())
+		}
+		r.DON = &DONResource{
+			ID:               strconv.FormatUint(uint64(info.DON.ID), 10),
+			ConfigVersion:    info.DON.ConfigVersion,
+			F:                info.DON.F,
+			Members:          members,
+			IsPublic:         info.DON.IsPublic,
+			AcceptsWorkflows: info.DON.AcceptsWorkflows,
+		}
+	}
+
+	return r
+}
+
+// NewCapabilityResources initializes a slice of JSONAPI capability resources
+func NewCapabilityResources(infos []capabilities.CapabilityInfo) []CapabilityResource {
+	rs := []CapabilityResource{}
+	for _, info := range infos {
+		rs = append(rs, *NewCapabilityResource(info))
+	}
+
+	return rs
+}
@@ -1,11 +1,55 @@
 package resolver
 
 import (
+	"context"
+
 	"github.com/graph-gophers/graphql-go"
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
+)
+
+type BridgeHealthStatus string
+
+const (
+	BridgeHealthStatusUnknown   BridgeHealthStatus = "UNKNOWN"
+	BridgeHealthStatusHealthy   BridgeHealthStatus = "HEALTHY"
+	BridgeHealthStatusDegraded  BridgeHealthStatus = "DEGRADED"
+	BridgeHealthStatusUnhealthy BridgeHealthStatus = "UNHEALTHY"
+)
+
+func NewBridgeHealthStatus(status bridges.HealthStatus) BridgeHealthStatus {
+	switch status {
+	case bridges.HealthStatusHealthy:
+		return BridgeHealthStatusHealthy
+	case bridges.HealthStatusDegraded:
+		return BridgeHealthStatusDegraded
+	case bridges.HealthStatusUnhealthy:
+		return BridgeHealthStatusUnhealthy
+	default:
+		return BridgeHealthStatusUnknown
+	}
+}
+
+type BridgeBreakerState string
+
+const (
+	BridgeBreakerStateClosed   BridgeBreakerState = "CLOSED"
+	BridgeBreakerStateOpen     BridgeBreakerState = "OPEN"
+	BridgeBreakerStateHalfOpen BridgeBreakerState = "HALF_OPEN"
 )
 
+func NewBridgeBreakerState(state bridges.BreakerState) BridgeBreakerState {
+	switch state {
+	case bridges.BreakerOpen:
+		return BridgeBreakerStateOpen
+	case bridges.BreakerHalfOpen:
+		return BridgeBreakerStateHalfOpen
+	default:
+		return BridgeBreakerStateClosed
+	}
+}
+
 // BridgeResolver resolves the Bridge type.
 type BridgeResolver struct {
 	bridge bridges.BridgeType
@@ -39,6 +83,16 @@ func (r *BridgeResolver) URL() string {
 	return r.bridge.URL.String()
 }
 
+// FallbackURLs resolves the bridge's fallback urls.
+func (r *BridgeResolver) FallbackURLs() []string {
+	return r.bridge.URLs()[1:]
+}
+
+// SigningScheme resolves the scheme the bridge's requests are signed with.
+func (r *BridgeResolver) SigningScheme() string {
+	return string(r.bridge.SigningScheme)
+}
+
 // Confirmations resolves the bridge's url.
 func (r *BridgeResolver) Confirmations() int32 {
 	return int32(r.bridge.Confirmations)
@@ -59,6 +113,78 @@ func (r *BridgeResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.bridge.CreatedAt}
 }
 
+// Health resolves the bridge's health.
+func (r *BridgeResolver) Health(ctx context.Context) (*BridgeHealthResolver, error) {
+	health, err := loader.GetBridgeHealthByName(ctx, r.bridge.Name.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBridgeHealth(*health), nil
+}
+
+// BridgeHealthResolver resolves the BridgeHealth type.
+type BridgeHealthResolver struct {
+	health bridges.BridgeHealth
+}
+
+func NewBridgeHealth(health bridges.BridgeHealth) *BridgeHealthResolver {
+	return &BridgeHealthResolver{health: health}
+}
+
+// Status resolves the bridge's health status.
+func (r *BridgeHealthResolver) Status() BridgeHealthStatus {
+	return NewBridgeHealthStatus(r.health.Status)
+}
+
+// URLs resolves the health of the bridge's url, followed by its fallback urls.
+func (r *BridgeHealthResolver) URLs() []*BridgeURLHealthResolver {
+	resolvers := []*BridgeURLHealthResolver{}
+	for _, u := range r.health.URLs {
+		resolvers = append(resolvers, &BridgeURLHealthResolver{health: u})
+	}
+
+	return resolvers
+}
+
+// BridgeURLHealthResolver resolves the BridgeURLHealth type.
+type BridgeURLHealthResolver struct {
+	health bridges.URLHealth
+}
+
+// URL resolves the url.
+func (r *BridgeURLHealthResolver) URL() string {
+	return r.health.URL
+}
+
+// Breaker resolves the state of the url's circuit breaker.
+func (r *BridgeURLHealthResolver) Breaker() BridgeBreakerState {
+	return NewBridgeBreakerState(r.health.Breaker)
+}
+
+// ConsecutiveFailures resolves the number of consecutive failed requests to the url.
+func (r *BridgeURLHealthResolver) ConsecutiveFailures() int32 {
+	return int32(r.health.ConsecutiveFailures) //nolint:gosec // failures never reach MaxInt32
+}
+
+// LastCheckedAt resolves the time of the last request to the url, if any.
+func (r *BridgeURLHealthResolver) LastCheckedAt() *graphql.Time {
+	if r.health.LastCheckedAt.IsZero() {
+		return nil
+	}
+
+	return &graphql.Time{Time: r.health.LastCheckedAt}
+}
+
+// LastError resolves the error of the last request to the url, if it failed.
+func (r *BridgeURLHealthResolver) LastError() *string {
+	if r.health.LastError == "" {
+		return nil
+	}
+
+	return &r.health.LastError
+}
+
 // BridgePayloadResolver resolves a single bridge response
 type BridgePayloadResolver struct {
 	bridge bridges.BridgeType
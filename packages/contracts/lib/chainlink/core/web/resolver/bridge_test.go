@@ -4,8 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -13,6 +15,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
@@ -161,6 +164,106 @@ func Test_Bridge(t *testing.T) {
 	RunGQLTests(t, testCases)
 }
 
+type bridgeHealthConfig struct{}
+
+func (bridgeHealthConfig) ProbeInterval() time.Duration { return 0 }
+func (bridgeHealthConfig) ProbeTimeout() time.Duration  { return time.Second }
+func (bridgeHealthConfig) FailureThreshold() uint32     { return 1 }
+func (bridgeHealthConfig) OpenTimeout() time.Duration   { return time.Hour }
+
+func Test_BridgeHealth(t *testing.T) {
+	t.Parallel()
+
+	var (
+		query = `
+			query GetBridge {
+				bridge(id: "bridge1") {
+					... on Bridge {
+						fallbackURLs
+						health {
+							status
+							urls {
+								url
+								breaker
+								consecutiveFailures
+								lastError
+							}
+						}
+					}
+				}
+			}`
+
+		name = bridges.BridgeName("bridge1")
+	)
+	bridgeURL, err := url.Parse("https://external.adapter")
+	require.NoError(t, err)
+	fallbackURL, err := url.Parse("https://fallback.adapter")
+	require.NoError(t, err)
+	bridge := bridges.BridgeType{
+		Name:         name,
+		URL:          models.WebURL(*bridgeURL),
+		FallbackURLs: bridges.WebURLs{models.WebURL(*fallbackURL)},
+	}
+
+	testCases := []GQLTestCase{
+		{
+			name:          "unknown",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				monitor := bridges.NewHealthMonitor(f.Mocks.bridgeORM, bridgeHealthConfig{}, nil, logger.TestLogger(t))
+				f.App.On("BridgeORM").Return(f.Mocks.bridgeORM)
+				f.App.On("BridgeHealth").Return(monitor)
+				f.Mocks.bridgeORM.On("FindBridge", mock.Anything, name).Return(bridge, nil)
+			},
+			query: query,
+			result: `{
+				"bridge": {
+					"fallbackURLs": ["https://fallback.adapter"],
+					"health": {
+						"status": "UNKNOWN",
+						"urls": []
+					}
+				}
+			}`,
+		},
+		{
+			name:          "degraded",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				monitor := bridges.NewHealthMonitor(f.Mocks.bridgeORM, bridgeHealthConfig{}, nil, logger.TestLogger(t))
+				monitor.SetURLs(name, bridge.URLs())
+				monitor.Record(name, bridgeURL.String(), errors.New("connection refused"))
+				monitor.Record(name, fallbackURL.String(), nil)
+				f.App.On("BridgeORM").Return(f.Mocks.bridgeORM)
+				f.App.On("BridgeHealth").Return(monitor)
+				f.Mocks.bridgeORM.On("FindBridge", mock.Anything, name).Return(bridge, nil)
+			},
+			query: query,
+			result: `{
+				"bridge": {
+					"fallbackURLs": ["https://fallback.adapter"],
+					"health": {
+						"status": "DEGRADED",
+						"urls": [{
+							"url": "https://external.adapter",
+							"breaker": "OPEN",
+							"consecutiveFailures": 1,
+							"lastError": "connection refused"
+						}, {
+							"url": "https://fallback.adapter",
+							"breaker": "CLOSED",
+							"consecutiveFailures": 0,
+							"lastError": null
+						}]
+					}
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
 func Test_CreateBridge(t *testing.T) {
 	t.Parallel()
 
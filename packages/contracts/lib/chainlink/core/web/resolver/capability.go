@@ -0,0 +1,106 @@
+package resolver
+
+import (
+	"strconv"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+)
+
+// CapabilityResolver resolves the Capability type.
+type CapabilityResolver struct {
+	info capabilities.CapabilityInfo
+}
+
+func NewCapabilities(infos []capabilities.CapabilityInfo) []*CapabilityResolver {
+	var resolvers []*CapabilityResolver
+	for _, info := range infos {
+		resolvers = append(resolvers, &CapabilityResolver{info: info})
+	}
+
+	return resolvers
+}
+
+// ID resolves the capability's ID.
+func (r *CapabilityResolver) ID() graphql.ID {
+	return graphql.ID(r.info.ID)
+}
+
+// CapabilityType resolves the capability's type.
+func (r *CapabilityResolver) CapabilityType() string {
+	return string(r.info.CapabilityType)
+}
+
+// Description resolves the capability's description.
+func (r *CapabilityResolver) Description() string {
+	return r.info.Description
+}
+
+// IsLocal resolves whether the capability is provided by this node.
+func (r *CapabilityResolver) IsLocal() bool {
+	return r.info.IsLocal
+}
+
+// DON resolves the DON exposing the capability.
+func (r *CapabilityResolver) DON() *DONResolver {
+	if r.info.DON == nil {
+		return nil
+	}
+
+	return &DONResolver{don: *r.info.DON}
+}
+
+// DONResolver resolves the DON type.
+type DONResolver struct {
+	don capabilities.DON
+}
+
+// ID resolves the DON's ID.
+func (r *DONResolver) ID() graphql.ID {
+	return graphql.ID(strconv.FormatUint(uint64(r.don.ID), 10))
+}
+
+// ConfigVersion resolves the DON's config version.
+func (r *DONResolver) ConfigVersion() int32 {
+	return int32(r.don.ConfigVersion)
+}
+
+// F resolves the number of faulty nodes tolerated by the DON.
+func (r *DONResolver) F() int32 {
+	return int32(r.don.F)
+}
+
+// Members resolves the peer IDs of the DON's members.
+func (r *DONResolver) Members() []string {
+	members := []string{}
+	for _, m := range r.don.Members {
+		members = append(members, m.String())
+	}
+
+	return members
+}
+
+// IsPublic resolves whether the DON is public.
+func (r *DONResolver) IsPublic() bool {
+	return r.don.IsPublic
+}
+
+// AcceptsWorkflows resolves whether the DON accepts workflows.
+func (r *DONResolver) AcceptsWorkflows() bool {
+	return r.don.AcceptsWorkflows
+}
+
+// CapabilitiesPayloadResolver resolves a list of capabilities
+type CapabilitiesPayloadResolver struct {
+	infos []capabilities.CapabilityInfo
+}
+
+func NewCapabilitiesPayload(infos []capabilities.CapabilityInfo) *CapabilitiesPayloadResolver {
+	return &CapabilitiesPayloadResolver{infos: infos}
+}
+
+// Results returns the capabilities.
+func (r *CapabilitiesPayloadResolver) Results() []*CapabilityResolver {
+	return NewCapabilities(r.infos)
+}
@@ -0,0 +1,68 @@
+package resolver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+)
+
+func Test_Capabilities(t *testing.T) {
+	t.Parallel()
+
+	var (
+		query = `
+			query GetCapabilities {
+				capabilities {
+					results {
+						id
+						capabilityType
+						isLocal
+						don {
+							id
+							f
+						}
+					}
+				}
+			}`
+	)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "capabilities"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("GetWorkflowsService").Return(f.Mocks.workflowsSvc)
+				f.Mocks.workflowsSvc.On("ListCapabilities", mock.Anything).Return([]capabilities.CapabilityInfo{
+					{ID: "cron-trigger@1.0.0", CapabilityType: capabilities.CapabilityTypeTrigger, IsLocal: true},
+					{ID: "write_chain@1.0.0", CapabilityType: capabilities.CapabilityTypeTarget, DON: &capabilities.DON{ID: 2, F: 1}},
+				}, nil)
+			},
+			query: query,
+			result: `
+			{
+				"capabilities": {
+					"results": [{
+						"id": "cron-trigger@1.0.0",
+						"capabilityType": "trigger",
+						"isLocal": true,
+						"don": null
+					}, {
+						"id": "write_chain@1.0.0",
+						"capabilityType": "target",
+						"isLocal": false,
+						"don": {
+							"id": "2",
+							"f": 1
+						}
+					}]
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"net/url"
 	"strings"
 
 	"github.com/graph-gophers/graphql-go"
@@ -11,6 +12,8 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/bridges/signing"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 )
 
@@ -88,3 +91,28 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest) error {
 
 	return nil
 }
+
+// parseBridgeFallbackURLs parses the fallback urls of a bridge input
+func parseBridgeFallbackURLs(urls *[]string) (bridges.WebURLs, error) {
+	if urls == nil {
+		return nil, nil
+	}
+	fallbackURLs := bridges.WebURLs{}
+	for _, u := range *urls {
+		rURL, err := url.ParseRequestURI(u)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid fallback url %q", u)
+		}
+		fallbackURLs = append(fallbackURLs, models.WebURL(*rURL))
+	}
+
+	return fallbackURLs, nil
+}
+
+// parseBridgeSigningScheme parses the signing scheme of a bridge input
+func parseBridgeSigningScheme(scheme *string) (signing.Scheme, error) {
+	if scheme == nil {
+		return signing.SchemeNone, nil
+	}
+	return signing.ParseScheme(*scheme)
+}
@@ -21,6 +21,8 @@ const (
 	JobRunStatusSuspended JobRunStatus = "SUSPENDED"
 	JobRunStatusErrored   JobRunStatus = "ERRORED"
 	JobRunStatusCompleted JobRunStatus = "COMPLETED"
+	JobRunStatusQueued    JobRunStatus = "QUEUED"
+	JobRunStatusRejected  JobRunStatus = "REJECTED"
 )
 
 func NewJobRunStatus(status pipeline.RunStatus) JobRunStatus {
@@ -33,6 +35,10 @@ func NewJobRunStatus(status pipeline.RunStatus) JobRunStatus {
 		return JobRunStatusErrored
 	case pipeline.RunStatusCompleted:
 		return JobRunStatusCompleted
+	case pipeline.RunStatusQueued:
+		return JobRunStatusQueued
+	case pipeline.RunStatusRejected:
+		return JobRunStatusRejected
 	default:
 		return JobRunStatusUnknown
 	}
@@ -64,6 +70,10 @@ func (r *JobRunResolver) ID() graphql.ID {
 }
 
 func (r *JobRunResolver) Outputs() []*string {
+	if r.run.State == pipeline.RunStatusRejected {
+		// rejected runs were never executed
+		return []*string{}
+	}
 	if !r.run.Outputs.Valid {
 		return []*string{&outputRetrievalErrorStr}
 	}
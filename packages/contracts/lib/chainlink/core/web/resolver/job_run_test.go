@@ -177,6 +177,49 @@ func TestResolver_JobRun(t *testing.T) {
 					}
 				}`,
 		},
+		{
+			name:          "rejected run",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindPipelineRunByID", mock.Anything, int64(2)).Return(pipeline.Run{
+					ID:             2,
+					PipelineSpecID: 5,
+					CreatedAt:      f.Timestamp(),
+					FinishedAt:     null.TimeFrom(f.Timestamp()),
+					AllErrors:      pipeline.RunErrors{null.StringFrom("run rejected by job limits")},
+					FatalErrors:    pipeline.RunErrors{null.StringFrom("run rejected by job limits")},
+					Inputs:         inputs,
+					State:          pipeline.RunStatusRejected,
+				}, nil)
+				f.Mocks.jobORM.On("FindJobsByPipelineSpecIDs", mock.Anything, []int32{5}).Return([]job.Job{
+					{
+						ID:             2,
+						PipelineSpecID: 5,
+						Name:           null.StringFrom("second-one"),
+					},
+				}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"jobRun": {
+						"id": "2",
+						"allErrors": ["run rejected by job limits"],
+						"createdAt": "2021-01-01T00:00:00Z",
+						"fatalErrors": ["run rejected by job limits"],
+						"finishedAt": "2021-01-01T00:00:00Z",
+						"inputs": "{\"foo\":\"bar\"}",
+						"job": {
+							"id": "2",
+							"name": "second-one"
+						},
+						"outputs": [],
+						"status": "REJECTED"
+					}
+				}`,
+		},
 		{
 			name:          "not found error",
 			authenticated: true,
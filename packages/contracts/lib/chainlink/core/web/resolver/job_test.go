@@ -563,3 +563,112 @@ func TestResolver_DeleteJob(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_PauseResumeJob(t *testing.T) {
+	t.Parallel()
+
+	id := int32(123)
+	pausedAt := time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)
+	pauseMutation := `
+		mutation PauseJob($id: ID!) {
+			pauseJob(id: $id) {
+				... on PauseJobSuccess {
+					job {
+						id
+						pausedAt
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	resumeMutation := `
+		mutation ResumeJob($id: ID!) {
+			resumeJob(id: $id) {
+				... on ResumeJobSuccess {
+					job {
+						id
+						pausedAt
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "123",
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: pauseMutation, variables: variables}, "pauseJob"),
+		{
+			name:          "pause success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("JobSpawner").Return(f.Mocks.jobSpawner)
+				f.Mocks.jobSpawner.On("PauseJob", mock.Anything, id).Return(nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, id).Return(job.Job{
+					ID:       id,
+					PausedAt: null.TimeFrom(pausedAt),
+				}, nil)
+			},
+			query:     pauseMutation,
+			variables: variables,
+			result: `
+				{
+					"pauseJob": {
+						"job": {
+							"id": "123",
+							"pausedAt": "2021-01-02T00:00:00Z"
+						}
+					}
+				}`,
+		},
+		{
+			name:          "pause not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("JobSpawner").Return(f.Mocks.jobSpawner)
+				f.Mocks.jobSpawner.On("PauseJob", mock.Anything, id).Return(errors.Wrap(sql.ErrNoRows, "failed to pause job 123"))
+			},
+			query:     pauseMutation,
+			variables: variables,
+			result: `
+				{
+					"pauseJob": {
+						"code": "NOT_FOUND",
+						"message": "job not found"
+					}
+				}`,
+		},
+		unauthorizedTestCase(GQLTestCase{query: resumeMutation, variables: variables}, "resumeJob"),
+		{
+			name:          "resume success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("JobSpawner").Return(f.Mocks.jobSpawner)
+				f.Mocks.jobSpawner.On("ResumeJob", mock.Anything, id).Return(nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, id).Return(job.Job{ID: id}, nil)
+			},
+			query:     resumeMutation,
+			variables: variables,
+			result: `
+				{
+					"resumeJob": {
+						"job": {
+							"id": "123",
+							"pausedAt": null
+						}
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
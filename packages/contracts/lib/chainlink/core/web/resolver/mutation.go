@@ -43,6 +43,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/admin"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/crypto"
@@ -57,6 +58,8 @@ type Resolver struct {
 type createBridgeInput struct {
 	Name                   string
 	URL                    string
+	FallbackURLs           *[]string
+	SigningScheme          *string
 	Confirmations          int32
 	MinimumContractPayment string
 }
@@ -75,6 +78,14 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 		}
 		webURL = models.WebURL(*rURL)
 	}
+	fallbackURLs, err := parseBridgeFallbackURLs(args.Input.FallbackURLs)
+	if err != nil {
+		return nil, err
+	}
+	signingScheme, err := parseBridgeSigningScheme(args.Input.SigningScheme)
+	if err != nil {
+		return nil, err
+	}
 	minContractPayment := &assets.Link{}
 	if err := minContractPayment.UnmarshalText([]byte(args.Input.MinimumContractPayment)); err != nil {
 		return nil, err
@@ -83,6 +94,8 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 	btr := &bridges.BridgeTypeRequest{
 		Name:                   bridges.BridgeName(args.Input.Name),
 		URL:                    webURL,
+		FallbackURLs:           fallbackURLs,
+		SigningScheme:          signingScheme,
 		Confirmations:          uint32(args.Input.Confirmations),
 		MinimumContractPayment: minContractPayment,
 	}
@@ -107,6 +120,8 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 		"bridgeConfirmations":          bta.Confirmations,
 		"bridgeMinimumContractPayment": bta.MinimumContractPayment,
 		"bridgeURL":                    bta.URL,
+		"bridgeFallbackURLs":           bt.FallbackURLs,
+		"bridgeSigningScheme":          bt.SigningScheme,
 	})
 
 	return NewCreateBridgePayload(*bt, bta.IncomingToken), nil
@@ -450,6 +465,8 @@ func (r *Resolver) CreateFeedsManager(ctx context.Context, args struct {
 type updateBridgeInput struct {
 	Name                   string
 	URL                    string
+	FallbackURLs           *[]string
+	SigningScheme          *string
 	Confirmations          int32
 	MinimumContractPayment string
 }
@@ -470,6 +487,14 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 		}
 		webURL = models.WebURL(*rURL)
 	}
+	fallbackURLs, err := parseBridgeFallbackURLs(args.Input.FallbackURLs)
+	if err != nil {
+		return nil, err
+	}
+	signingScheme, err := parseBridgeSigningScheme(args.Input.SigningScheme)
+	if err != nil {
+		return nil, err
+	}
 	minContractPayment := &assets.Link{}
 	if err := minContractPayment.UnmarshalText([]byte(args.Input.MinimumContractPayment)); err != nil {
 		return nil, err
@@ -478,6 +503,8 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 	btr := &bridges.BridgeTypeRequest{
 		Name:                   bridges.BridgeName(args.Input.Name),
 		URL:                    webURL,
+		FallbackURLs:           fallbackURLs,
+		SigningScheme:          signingScheme,
 		Confirmations:          uint32(args.Input.Confirmations),
 		MinimumContractPayment: minContractPayment,
 	}
@@ -511,6 +538,8 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 		"bridgeConfirmations":          bridge.Confirmations,
 		"bridgeMinimumContractPayment": bridge.MinimumContractPayment,
 		"bridgeURL":                    bridge.URL,
+		"bridgeFallbackURLs":           bridge.FallbackURLs,
+		"bridgeSigningScheme":          bridge.SigningScheme,
 	})
 
 	return NewUpdateBridgePayload(&bridge, nil), nil
@@ -857,6 +886,28 @@ func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 	return NewCancelJobProposalSpecPayload(spec, err), nil
 }
 
+// PauseWorkflowEngine stops the engine of a workflow on this node only.
+func (r *Resolver) PauseWorkflowEngine(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*PauseWorkflowEnginePayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	wf, err := r.App.GetWorkflowsService().PauseEngine(ctx, string(args.ID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, admin.ErrEngineControlUnavailable) {
+			return NewPauseWorkflowEnginePayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.WorkflowEnginePaused, map[string]interface{}{"workflowID": wf.Spec.WorkflowID})
+
+	return NewPauseWorkflowEnginePayload(r.App, &wf, nil), nil
+}
+
 // RejectJobProposalSpec rejects the job proposal spec.
 func (r *Resolver) RejectJobProposalSpec(ctx context.Context, args struct {
 	ID graphql.ID
@@ -892,6 +943,28 @@ func (r *Resolver) RejectJobProposalSpec(ctx context.Context, args struct {
 	return NewRejectJobProposalSpecPayload(spec, err), nil
 }
 
+// ResumeWorkflowEngine starts the engine of a workflow previously paused on this node.
+func (r *Resolver) ResumeWorkflowEngine(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*ResumeWorkflowEnginePayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	wf, err := r.App.GetWorkflowsService().ResumeEngine(ctx, string(args.ID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, admin.ErrEngineControlUnavailable) || errors.Is(err, admin.ErrWorkflowNotActive) {
+			return NewResumeWorkflowEnginePayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.WorkflowEngineResumed, map[string]interface{}{"workflowID": wf.Spec.WorkflowID})
+
+	return NewResumeWorkflowEnginePayload(r.App, &wf, nil), nil
+}
+
 // UpdateJobProposalSpecDefinition updates the spec definition.
 func (r *Resolver) UpdateJobProposalSpecDefinition(ctx context.Context, args struct {
 	ID    graphql.ID
@@ -1171,6 +1244,68 @@ func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 	return NewDeleteJobPayload(r.App, &j, nil), nil
 }
 
+// PauseJob stops the services of a job, keeping its spec and run history.
+func (r *Resolver) PauseJob(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*PauseJobPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.App.JobSpawner().PauseJob(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewPauseJobPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.JobPaused, map[string]interface{}{"id": args.ID})
+
+	j, err := r.App.JobORM().FindJobWithoutSpecErrors(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPauseJobPayload(r.App, &j, nil), nil
+}
+
+// ResumeJob starts the services of a paused job.
+func (r *Resolver) ResumeJob(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*ResumeJobPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.App.JobSpawner().ResumeJob(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewResumeJobPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.JobResumed, map[string]interface{}{"id": args.ID})
+
+	j, err := r.App.JobORM().FindJobWithoutSpecErrors(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewResumeJobPayload(r.App, &j, nil), nil
+}
+
 func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DismissJobErrorPayloadResolver, error) {
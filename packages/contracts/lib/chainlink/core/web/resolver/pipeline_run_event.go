@@ -0,0 +1,199 @@
+package resolver
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
+)
+
+type PipelineRunEventType string
+
+const (
+	PipelineRunEventTypeRunCreated   PipelineRunEventType = "RUN_CREATED"
+	PipelineRunEventTypeTaskFinished PipelineRunEventType = "TASK_FINISHED"
+	PipelineRunEventTypeRunFinished  PipelineRunEventType = "RUN_FINISHED"
+	PipelineRunEventTypeRunQueued    PipelineRunEventType = "RUN_QUEUED"
+	PipelineRunEventTypeRunRejected  PipelineRunEventType = "RUN_REJECTED"
+)
+
+func NewPipelineRunEventType(eventType pipeline.RunEventType) PipelineRunEventType {
+	switch eventType {
+	case pipeline.RunEventCreated:
+		return PipelineRunEventTypeRunCreated
+	case pipeline.RunEventTaskFinished:
+		return PipelineRunEventTypeTaskFinished
+	case pipeline.RunEventQueued:
+		return PipelineRunEventTypeRunQueued
+	case pipeline.RunEventRejected:
+		return PipelineRunEventTypeRunRejected
+	default:
+		return PipelineRunEventTypeRunFinished
+	}
+}
+
+type PipelineRunEventResolver struct {
+	event pipeline.RunEvent
+}
+
+func NewPipelineRunEvent(event pipeline.RunEvent) *PipelineRunEventResolver {
+	return &PipelineRunEventResolver{event: event}
+}
+
+func (r *PipelineRunEventResolver) Type() PipelineRunEventType {
+	return NewPipelineRunEventType(r.event.Type)
+}
+
+func (r *PipelineRunEventResolver) JobID() graphql.ID {
+	return int32GQLID(r.event.JobID)
+}
+
+// RunID resolves the ID of the run, if it is saved.
+func (r *PipelineRunEventResolver) RunID() *graphql.ID {
+	if r.event.RunID == 0 {
+		return nil
+	}
+	id := int64GQLID(r.event.RunID)
+	return &id
+}
+
+func (r *PipelineRunEventResolver) ExecutionID() graphql.ID {
+	return graphql.ID(r.event.ExecutionID.String())
+}
+
+func (r *PipelineRunEventResolver) Status() JobRunStatus {
+	return NewJobRunStatus(r.event.State)
+}
+
+func (r *PipelineRunEventResolver) Timestamp() graphql.Time {
+	return graphql.Time{Time: r.event.Timestamp}
+}
+
+func (r *PipelineRunEventResolver) Task() *PipelineRunTaskEventResolver {
+	if r.event.Task == nil {
+		return nil
+	}
+	return &PipelineRunTaskEventResolver{task: *r.event.Task}
+}
+
+func (r *PipelineRunEventResolver) run() *pipeline.Run {
+	return &pipeline.Run{
+		Outputs:     r.event.Outputs,
+		AllErrors:   r.event.AllErrors,
+		FatalErrors: r.event.FatalErrors,
+	}
+}
+
+func (r *PipelineRunEventResolver) Outputs() []*string {
+	if !r.event.Outputs.Valid {
+		return []*string{}
+	}
+
+	outputs, err := r.run().StringOutputs()
+	if err != nil {
+		errMsg := err.Error()
+		return []*string{&errMsg}
+	}
+
+	return outputs
+}
+
+func (r *PipelineRunEventResolver) AllErrors() []string {
+	var errs []string
+
+	for _, err := range r.run().StringAllErrors() {
+		if err != nil {
+			errs = append(errs, *err)
+		}
+	}
+
+	return errs
+}
+
+func (r *PipelineRunEventResolver) FatalErrors() []string {
+	var errs []string
+
+	for _, err := range r.run().StringFatalErrors() {
+		if err != nil {
+			errs = append(errs, *err)
+		}
+	}
+
+	return errs
+}
+
+type PipelineRunTaskEventResolver struct {
+	task pipeline.TaskRunEvent
+}
+
+func (r *PipelineRunTaskEventResolver) ID() graphql.ID {
+	return graphql.ID(r.task.ID.String())
+}
+
+func (r *PipelineRunTaskEventResolver) DotID() string {
+	return r.task.DotID
+}
+
+func (r *PipelineRunTaskEventResolver) Type() string {
+	return string(r.task.Type)
+}
+
+func (r *PipelineRunTaskEventResolver) Output() string {
+	val, err := r.task.Output.MarshalJSON()
+	if err != nil {
+		return "error: unable to retrieve output"
+	}
+	return string(val)
+}
+
+func (r *PipelineRunTaskEventResolver) Error() *string {
+	if r.task.Error.Valid {
+		return r.task.Error.Ptr()
+	}
+
+	return nil
+}
+
+// PipelineRunEvents streams the lifecycle events of the runs of a job, or of all jobs if no job ID is given.
+func (r *Resolver) PipelineRunEvents(ctx context.Context, args struct {
+	JobID *graphql.ID
+}) (<-chan *PipelineRunEventResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	var jobID int32
+	if args.JobID != nil {
+		id, err := stringutils.ToInt32(string(*args.JobID))
+		if err != nil {
+			return nil, err
+		}
+		jobID = id
+	}
+
+	events, unsubscribe := r.App.SubscribePipelineRunEvents(jobID)
+	ch := make(chan *PipelineRunEventResolver)
+	go func() {
+		defer close(ch)
+		defer unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				select {
+				case ch <- NewPipelineRunEvent(event):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
@@ -0,0 +1,102 @@
+package resolver
+
+import (
+	"testing"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestResolver_PipelineRunEvents(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		subscription {
+			pipelineRunEvents(jobID: "1") {
+				type
+				jobID
+				runID
+				status
+				task {
+					dotID
+					output
+					error
+				}
+			}
+		}`
+
+	t.Run("streams run events", func(t *testing.T) {
+		f := setupFramework(t)
+		ctx := f.withAuthenticatedUser(testutils.Context(t))
+
+		events := make(chan pipeline.RunEvent, 1)
+		f.App.On("SubscribePipelineRunEvents", int32(1)).Return((<-chan pipeline.RunEvent)(events), func() {}).Once()
+
+		responses, err := f.RootSchema.Subscribe(ctx, query, "", nil)
+		require.NoError(t, err)
+
+		events <- pipeline.RunEvent{
+			Type:  pipeline.RunEventTaskFinished,
+			JobID: 1,
+			State: pipeline.RunStatusRunning,
+			Task: &pipeline.TaskRunEvent{
+				DotID:  "ds",
+				Type:   pipeline.TaskTypeMemo,
+				Output: jsonserializable.JSONSerializable{Val: 42, Valid: true},
+			},
+		}
+
+		response := (<-responses).(*graphql.Response)
+		require.Empty(t, response.Errors)
+		assert.JSONEq(t, `{
+			"pipelineRunEvents": {
+				"type": "TASK_FINISHED",
+				"jobID": "1",
+				"runID": null,
+				"status": "RUNNING",
+				"task": {"dotID": "ds", "output": "42", "error": null}
+			}
+		}`, string(response.Data))
+	})
+
+	t.Run("streams queued and rejected runs", func(t *testing.T) {
+		f := setupFramework(t)
+		ctx := f.withAuthenticatedUser(testutils.Context(t))
+
+		events := make(chan pipeline.RunEvent, 1)
+		f.App.On("SubscribePipelineRunEvents", int32(1)).Return((<-chan pipeline.RunEvent)(events), func() {}).Once()
+
+		responses, err := f.RootSchema.Subscribe(ctx, query, "", nil)
+		require.NoError(t, err)
+
+		events <- pipeline.RunEvent{Type: pipeline.RunEventQueued, JobID: 1, State: pipeline.RunStatusQueued}
+		response := (<-responses).(*graphql.Response)
+		require.Empty(t, response.Errors)
+		assert.JSONEq(t, `{
+			"pipelineRunEvents": {"type": "RUN_QUEUED", "jobID": "1", "runID": null, "status": "QUEUED", "task": null}
+		}`, string(response.Data))
+
+		events <- pipeline.RunEvent{Type: pipeline.RunEventRejected, JobID: 1, RunID: 7, State: pipeline.RunStatusRejected}
+		response = (<-responses).(*graphql.Response)
+		require.Empty(t, response.Errors)
+		assert.JSONEq(t, `{
+			"pipelineRunEvents": {"type": "RUN_REJECTED", "jobID": "1", "runID": "7", "status": "REJECTED", "task": null}
+		}`, string(response.Data))
+	})
+
+	t.Run("not authorized", func(t *testing.T) {
+		f := setupFramework(t)
+
+		responses, err := f.RootSchema.Subscribe(testutils.Context(t), query, "", nil)
+		require.NoError(t, err)
+
+		response := (<-responses).(*graphql.Response)
+		require.Len(t, response.Errors, 1)
+		assert.Equal(t, "Unauthorized", response.Errors[0].Message)
+	})
+}
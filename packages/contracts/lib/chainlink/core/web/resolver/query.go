@@ -18,6 +18,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
 	evmrelay "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/admin"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 )
@@ -65,6 +66,20 @@ func (r *Resolver) Bridges(ctx context.Context, args struct {
 	return NewBridgesPayload(brdgs, int32(count)), nil
 }
 
+// Capabilities retrieves the local and remote capabilities known to the node.
+func (r *Resolver) Capabilities(ctx context.Context) (*CapabilitiesPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	infos, err := r.App.GetWorkflowsService().ListCapabilities(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCapabilitiesPayload(infos), nil
+}
+
 // Chain retrieves a chain by id.
 func (r *Resolver) Chain(ctx context.Context,
 	args struct {
@@ -671,3 +686,41 @@ func (r *Resolver) OCR2KeyBundles(ctx context.Context) (*OCR2KeyBundlesPayloadRe
 
 	return NewOCR2KeyBundlesPayload(ekbs), nil
 }
+
+// Workflow retrieves a workflow by id.
+func (r *Resolver) Workflow(ctx context.Context, args struct{ ID graphql.ID }) (*WorkflowPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	wf, err := r.App.GetWorkflowsService().GetWorkflow(ctx, string(args.ID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewWorkflowPayload(r.App, admin.Workflow{}, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewWorkflowPayload(r.App, wf, nil), nil
+}
+
+// Workflows retrieves a paginated list of workflows.
+func (r *Resolver) Workflows(ctx context.Context, args struct {
+	Offset *int32
+	Limit  *int32
+}) (*WorkflowsPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	offset := pageOffset(args.Offset)
+	limit := pageLimit(args.Limit)
+
+	wfs, count, err := r.App.GetWorkflowsService().ListWorkflows(ctx, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWorkflowsPayload(r.App, wfs, int32(count)), nil
+}
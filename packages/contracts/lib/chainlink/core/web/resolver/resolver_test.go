@@ -27,6 +27,7 @@ import (
 	keystoreMocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
 	pipelineMocks "github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
 	webhookmocks "github.com/smartcontractkit/chainlink/v2/core/services/webhook/mocks"
+	workflowsAdminMocks "github.com/smartcontractkit/chainlink/v2/core/services/workflows/admin/mocks"
 	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
 	authProviderMocks "github.com/smartcontractkit/chainlink/v2/core/sessions/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
@@ -38,9 +39,11 @@ type mocks struct {
 	bridgeORM            *bridgeORMMocks.ORM
 	evmORM               *evmtest.TestConfigs
 	jobORM               *jobORMMocks.ORM
+	jobSpawner           *jobORMMocks.Spawner
 	authProvider         *authProviderMocks.AuthenticationProvider
 	pipelineORM          *pipelineMocks.ORM
 	feedsSvc             *feedsMocks.Service
+	workflowsSvc         *workflowsAdminMocks.Service
 	cfg                  *chainlinkMocks.GeneralConfig
 	scfg                 *evmConfigMocks.ChainScopedConfig
 	ocr                  *keystoreMocks.OCR
@@ -98,7 +101,9 @@ func setupFramework(t *testing.T) *gqlTestFramework {
 		bridgeORM:            bridgeORMMocks.NewORM(t),
 		evmORM:               evmtest.NewTestConfigs(),
 		jobORM:               jobORMMocks.NewORM(t),
+		jobSpawner:           jobORMMocks.NewSpawner(t),
 		feedsSvc:             feedsMocks.NewService(t),
+		workflowsSvc:         workflowsAdminMocks.NewService(t),
 		authProvider:         authProviderMocks.NewAuthenticationProvider(t),
 		pipelineORM:          pipelineMocks.NewORM(t),
 		cfg:                  chainlinkMocks.NewGeneralConfig(t),
@@ -175,6 +175,47 @@ func (r *CronSpecResolver) EVMChainID() *string {
 	return &chainID
 }
 
+// CatchUpPolicy resolves the spec's catch-up policy.
+func (r *CronSpecResolver) CatchUpPolicy() string {
+	return string(r.spec.CatchUpPolicy)
+}
+
+// CatchUpWindow resolves the spec's catch-up window.
+func (r *CronSpecResolver) CatchUpWindow() *string {
+	if r.spec.CatchUpWindow.Duration() == 0 {
+		return nil
+	}
+
+	window := r.spec.CatchUpWindow.Duration().String()
+
+	return &window
+}
+
+// ConcurrencyPolicy resolves the spec's concurrency policy.
+func (r *CronSpecResolver) ConcurrencyPolicy() string {
+	return string(r.spec.ConcurrencyPolicy)
+}
+
+// Jitter resolves the spec's maximum jitter.
+func (r *CronSpecResolver) Jitter() *string {
+	if r.spec.Jitter.Duration() == 0 {
+		return nil
+	}
+
+	jitter := r.spec.Jitter.Duration().String()
+
+	return &jitter
+}
+
+// LastFiredAt resolves the latest schedule tick the job fired for.
+func (r *CronSpecResolver) LastFiredAt() *graphql.Time {
+	if !r.spec.LastFiredAt.Valid {
+		return nil
+	}
+
+	return &graphql.Time{Time: r.spec.LastFiredAt.Time}
+}
+
 // CreatedAt resolves the spec's created at timestamp.
 func (r *CronSpecResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.spec.CreatedAt}
@@ -42,9 +42,13 @@ func TestResolver_CronSpec(t *testing.T) {
 				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, id).Return(job.Job{
 					Type: job.Cron,
 					CronSpec: &job.CronSpec{
-						CronSchedule: "CRON_TZ=UTC 0 0 1 1 *",
-						EVMChainID:   ubig.NewI(42),
-						CreatedAt:    f.Timestamp(),
+						CronSchedule:      "CRON_TZ=UTC 0 0 1 1 *",
+						EVMChainID:        ubig.NewI(42),
+						CatchUpPolicy:     job.CronCatchUpRunOnce,
+						CatchUpWindow:     models.Interval(time.Hour),
+						ConcurrencyPolicy: job.CronConcurrencyForbid,
+						LastFiredAt:       null.TimeFrom(f.Timestamp()),
+						CreatedAt:         f.Timestamp(),
 					},
 				}, nil)
 			},
@@ -57,6 +61,11 @@ func TestResolver_CronSpec(t *testing.T) {
 								... on CronSpec {
 									schedule
 									evmChainID
+									catchUpPolicy
+									catchUpWindow
+									concurrencyPolicy
+									jitter
+									lastFiredAt
 									createdAt
 								}
 							}
@@ -71,6 +80,11 @@ func TestResolver_CronSpec(t *testing.T) {
 							"__typename": "CronSpec",
 							"schedule": "CRON_TZ=UTC 0 0 1 1 *",
 							"evmChainID": "42",
+							"catchUpPolicy": "runOnce",
+							"catchUpWindow": "1h0m0s",
+							"concurrencyPolicy": "forbid",
+							"jitter": null,
+							"lastFiredAt": "2021-01-01T00:00:00Z",
 							"createdAt": "2021-01-01T00:00:00Z"
 						}
 					}
@@ -0,0 +1,429 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/admin"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+// WorkflowResolver resolves the Workflow type.
+type WorkflowResolver struct {
+	app chainlink.Application
+	wf  admin.Workflow
+}
+
+func NewWorkflow(app chainlink.Application, wf admin.Workflow) *WorkflowResolver {
+	return &WorkflowResolver{app: app, wf: wf}
+}
+
+func NewWorkflows(app chainlink.Application, wfs []admin.Workflow) []*WorkflowResolver {
+	var resolvers []*WorkflowResolver
+	for _, wf := range wfs {
+		resolvers = append(resolvers, NewWorkflow(app, wf))
+	}
+
+	return resolvers
+}
+
+// ID resolves the workflow's ID.
+func (r *WorkflowResolver) ID() graphql.ID {
+	return graphql.ID(r.wf.Spec.WorkflowID)
+}
+
+// Owner resolves the workflow's owner.
+func (r *WorkflowResolver) Owner() string {
+	return r.wf.Spec.WorkflowOwner
+}
+
+// Name resolves the workflow's name.
+func (r *WorkflowResolver) Name() string {
+	return r.wf.Spec.WorkflowName
+}
+
+// Status resolves the workflow's status.
+func (r *WorkflowResolver) Status() string {
+	return string(r.wf.Spec.Status)
+}
+
+// SpecType resolves the workflow's spec type.
+func (r *WorkflowResolver) SpecType() string {
+	return string(r.wf.Spec.SpecType)
+}
+
+// BinaryURL resolves the workflow's binary url.
+func (r *WorkflowResolver) BinaryURL() string {
+	return r.wf.Spec.BinaryURL
+}
+
+// ConfigURL resolves the workflow's config url.
+func (r *WorkflowResolver) ConfigURL() string {
+	return r.wf.Spec.ConfigURL
+}
+
+// PausedLocally resolves whether the workflow's engine was paused on this node.
+func (r *WorkflowResolver) PausedLocally() bool {
+	return r.wf.PausedLocally
+}
+
+// Engine resolves the workflow's engine.
+func (r *WorkflowResolver) Engine() *WorkflowEngineResolver {
+	if r.wf.Engine == nil {
+		return nil
+	}
+
+	return &WorkflowEngineResolver{engine: *r.wf.Engine}
+}
+
+// Executions resolves a page of the workflow's executions, newest first.
+func (r *WorkflowResolver) Executions(ctx context.Context, args struct {
+	Offset *int32
+	Limit  *int32
+}) (*WorkflowExecutionsPayloadResolver, error) {
+	executions, count, err := r.app.GetWorkflowsService().ListExecutions(ctx, r.wf.Spec.WorkflowID, pageOffset(args.Offset), pageLimit(args.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWorkflowExecutionsPayload(executions, int32(count)), nil
+}
+
+// CreatedAt resolves the workflow's created at field.
+func (r *WorkflowResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.wf.Spec.CreatedAt}
+}
+
+// UpdatedAt resolves the workflow's updated at field.
+func (r *WorkflowResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: r.wf.Spec.UpdatedAt}
+}
+
+// WorkflowEngineResolver resolves the WorkflowEngine type.
+type WorkflowEngineResolver struct {
+	engine admin.Engine
+}
+
+// WorkflowID resolves the ID of the workflow version run by the engine.
+func (r *WorkflowEngineResolver) WorkflowID() string {
+	return r.engine.WorkflowID
+}
+
+// Ready resolves whether the engine is ready.
+func (r *WorkflowEngineResolver) Ready() bool {
+	return r.engine.Ready == nil
+}
+
+// Healthy resolves whether all the services of the engine are healthy.
+func (r *WorkflowEngineResolver) Healthy() bool {
+	return len(r.Errors()) == 0
+}
+
+// Errors resolves the errors of the unhealthy services of the engine.
+func (r *WorkflowEngineResolver) Errors() []*WorkflowEngineErrorResolver {
+	resolvers := []*WorkflowEngineErrorResolver{}
+	for name, err := range r.engine.Health {
+		if err != nil {
+			resolvers = append(resolvers, &WorkflowEngineErrorResolver{name: name, message: err.Error()})
+		}
+	}
+	sort.Slice(resolvers, func(i, j int) bool {
+		return resolvers[i].name < resolvers[j].name
+	})
+
+	return resolvers
+}
+
+// WorkflowEngineErrorResolver resolves the WorkflowEngineError type.
+type WorkflowEngineErrorResolver struct {
+	name    string
+	message string
+}
+
+// Name resolves the name of the unhealthy service.
+func (r *WorkflowEngineErrorResolver) Name() string {
+	return r.name
+}
+
+// Message resolves the health error of the service.
+func (r *WorkflowEngineErrorResolver) Message() string {
+	return r.message
+}
+
+// WorkflowExecutionResolver resolves the WorkflowExecution type.
+type WorkflowExecutionResolver struct {
+	execution store.WorkflowExecution
+}
+
+func NewWorkflowExecutions(executions []store.WorkflowExecution) []*WorkflowExecutionResolver {
+	var resolvers []*WorkflowExecutionResolver
+	for _, e := range executions {
+		resolvers = append(resolvers, &WorkflowExecutionResolver{execution: e})
+	}
+
+	return resolvers
+}
+
+// ID resolves the execution's ID.
+func (r *WorkflowExecutionResolver) ID() graphql.ID {
+	return graphql.ID(r.execution.ExecutionID)
+}
+
+// WorkflowID resolves the ID of the executed workflow.
+func (r *WorkflowExecutionResolver) WorkflowID() string {
+	return r.execution.WorkflowID
+}
+
+// Status resolves the execution's status.
+func (r *WorkflowExecutionResolver) Status() string {
+	return r.execution.Status
+}
+
+// Steps resolves the execution's steps, ordered by ref.
+func (r *WorkflowExecutionResolver) Steps() []*WorkflowExecutionStepResolver {
+	resolvers := []*WorkflowExecutionStepResolver{}
+	for _, step := range r.execution.Steps {
+		resolvers = append(resolvers, &WorkflowExecutionStepResolver{step: *step})
+	}
+	sort.Slice(resolvers, func(i, j int) bool {
+		return resolvers[i].step.Ref < resolvers[j].step.Ref
+	})
+
+	return resolvers
+}
+
+// CreatedAt resolves the execution's created at field.
+func (r *WorkflowExecutionResolver) CreatedAt() *graphql.Time {
+	return toGraphQLTime(r.execution.CreatedAt)
+}
+
+// UpdatedAt resolves the execution's updated at field.
+func (r *WorkflowExecutionResolver) UpdatedAt() *graphql.Time {
+	return toGraphQLTime(r.execution.UpdatedAt)
+}
+
+// FinishedAt resolves the execution's finished at field.
+func (r *WorkflowExecutionResolver) FinishedAt() *graphql.Time {
+	return toGraphQLTime(r.execution.FinishedAt)
+}
+
+// WorkflowExecutionStepResolver resolves the WorkflowExecutionStep type.
+type WorkflowExecutionStepResolver struct {
+	step store.WorkflowExecutionStep
+}
+
+// Ref resolves the step's ref.
+func (r *WorkflowExecutionStepResolver) Ref() string {
+	return r.step.Ref
+}
+
+// Status resolves the step's status.
+func (r *WorkflowExecutionStepResolver) Status() string {
+	return r.step.Status
+}
+
+// Error resolves the step's error, if any.
+func (r *WorkflowExecutionStepResolver) Error() *string {
+	if r.step.Outputs.Err == nil {
+		return nil
+	}
+
+	msg := r.step.Outputs.Err.Error()
+	return &msg
+}
+
+// UpdatedAt resolves the step's updated at field.
+func (r *WorkflowExecutionStepResolver) UpdatedAt() *graphql.Time {
+	return toGraphQLTime(r.step.UpdatedAt)
+}
+
+func toGraphQLTime(t *time.Time) *graphql.Time {
+	if t == nil {
+		return nil
+	}
+
+	return &graphql.Time{Time: *t}
+}
+
+// -- Workflow Query --
+
+// WorkflowPayloadResolver resolves a single workflow response
+type WorkflowPayloadResolver struct {
+	app chainlink.Application
+	wf  admin.Workflow
+	NotFoundErrorUnionType
+}
+
+func NewWorkflowPayload(app chainlink.Application, wf admin.Workflow, err error) *WorkflowPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "workflow not found"}
+
+	return &WorkflowPayloadResolver{app: app, wf: wf, NotFoundErrorUnionType: e}
+}
+
+// ToWorkflow implements the Workflow union type of the payload
+func (r *WorkflowPayloadResolver) ToWorkflow() (*WorkflowResolver, bool) {
+	if r.err == nil {
+		return NewWorkflow(r.app, r.wf), true
+	}
+
+	return nil, false
+}
+
+// -- Workflows Query --
+
+// WorkflowsPayloadResolver resolves a page of workflows
+type WorkflowsPayloadResolver struct {
+	app   chainlink.Application
+	wfs   []admin.Workflow
+	total int32
+}
+
+func NewWorkflowsPayload(app chainlink.Application, wfs []admin.Workflow, total int32) *WorkflowsPayloadResolver {
+	return &WorkflowsPayloadResolver{app: app, wfs: wfs, total: total}
+}
+
+// Results returns the workflows.
+func (r *WorkflowsPayloadResolver) Results() []*WorkflowResolver {
+	return NewWorkflows(r.app, r.wfs)
+}
+
+// Metadata returns the pagination metadata.
+func (r *WorkflowsPayloadResolver) Metadata() *PaginationMetadataResolver {
+	return NewPaginationMetadata(r.total)
+}
+
+// WorkflowExecutionsPayloadResolver resolves a page of workflow executions
+type WorkflowExecutionsPayloadResolver struct {
+	executions []store.WorkflowExecution
+	total      int32
+}
+
+func NewWorkflowExecutionsPayload(executions []store.WorkflowExecution, total int32) *WorkflowExecutionsPayloadResolver {
+	return &WorkflowExecutionsPayloadResolver{executions: executions, total: total}
+}
+
+// Results returns the workflow executions.
+func (r *WorkflowExecutionsPayloadResolver) Results() []*WorkflowExecutionResolver {
+	return NewWorkflowExecutions(r.executions)
+}
+
+// Metadata returns the pagination metadata.
+func (r *WorkflowExecutionsPayloadResolver) Metadata() *PaginationMetadataResolver {
+	return NewPaginationMetadata(r.total)
+}
+
+// -- PauseWorkflowEngine and ResumeWorkflowEngine Mutations --
+
+// WorkflowEngineControlUnavailableErrorResolver resolves the error returned when engines cannot be controlled
+type WorkflowEngineControlUnavailableErrorResolver struct {
+	message string
+}
+
+func (r *WorkflowEngineControlUnavailableErrorResolver) Message() string {
+	return r.message
+}
+
+func (r *WorkflowEngineControlUnavailableErrorResolver) Code() ErrorCode {
+	return ErrorCodeUnprocessable
+}
+
+type workflowEngineControlPayload struct {
+	app chainlink.Application
+	wf  *admin.Workflow
+	err error
+	NotFoundErrorUnionType
+}
+
+func newWorkflowEngineControlPayload(app chainlink.Application, wf *admin.Workflow, err error) workflowEngineControlPayload {
+	e := NotFoundErrorUnionType{err: err, message: "workflow not found"}
+
+	return workflowEngineControlPayload{app: app, wf: wf, err: err, NotFoundErrorUnionType: e}
+}
+
+func (r *workflowEngineControlPayload) ToWorkflowEngineControlUnavailableError() (*WorkflowEngineControlUnavailableErrorResolver, bool) {
+	if errors.Is(r.err, admin.ErrEngineControlUnavailable) {
+		return &WorkflowEngineControlUnavailableErrorResolver{message: r.err.Error()}, true
+	}
+
+	return nil, false
+}
+
+// PauseWorkflowEnginePayloadResolver resolves the response when pausing a workflow engine
+type PauseWorkflowEnginePayloadResolver struct {
+	workflowEngineControlPayload
+}
+
+func NewPauseWorkflowEnginePayload(app chainlink.Application, wf *admin.Workflow, err error) *PauseWorkflowEnginePayloadResolver {
+	return &PauseWorkflowEnginePayloadResolver{newWorkflowEngineControlPayload(app, wf, err)}
+}
+
+func (r *PauseWorkflowEnginePayloadResolver) ToPauseWorkflowEngineSuccess() (*PauseWorkflowEngineSuccessResolver, bool) {
+	if r.wf == nil {
+		return nil, false
+	}
+
+	return &PauseWorkflowEngineSuccessResolver{app: r.app, wf: *r.wf}, true
+}
+
+// PauseWorkflowEngineSuccessResolver resolves the success response when pausing a workflow engine
+type PauseWorkflowEngineSuccessResolver struct {
+	app chainlink.Application
+	wf  admin.Workflow
+}
+
+func (r *PauseWorkflowEngineSuccessResolver) Workflow() *WorkflowResolver {
+	return NewWorkflow(r.app, r.wf)
+}
+
+// ResumeWorkflowEnginePayloadResolver resolves the response when resuming a workflow engine
+type ResumeWorkflowEnginePayloadResolver struct {
+	workflowEngineControlPayload
+}
+
+func NewResumeWorkflowEnginePayload(app chainlink.Application, wf *admin.Workflow, err error) *ResumeWorkflowEnginePayloadResolver {
+	return &ResumeWorkflowEnginePayloadResolver{newWorkflowEngineControlPayload(app, wf, err)}
+}
+
+func (r *ResumeWorkflowEnginePayloadResolver) ToResumeWorkflowEngineSuccess() (*ResumeWorkflowEngineSuccessResolver, bool) {
+	if r.wf == nil {
+		return nil, false
+	}
+
+	return &ResumeWorkflowEngineSuccessResolver{app: r.app, wf: *r.wf}, true
+}
+
+// WorkflowNotActiveErrorResolver resolves the error returned when resuming the engine of an inactive workflow
+type WorkflowNotActiveErrorResolver struct {
+	message string
+}
+
+func (r *WorkflowNotActiveErrorResolver) Message() string {
+	return r.message
+}
+
+func (r *WorkflowNotActiveErrorResolver) Code() ErrorCode {
+	return ErrorCodeUnprocessable
+}
+
+func (r *ResumeWorkflowEnginePayloadResolver) ToWorkflowNotActiveError() (*WorkflowNotActiveErrorResolver, bool) {
+	if errors.Is(r.err, admin.ErrWorkflowNotActive) {
+		return &WorkflowNotActiveErrorResolver{message: r.err.Error()}, true
+	}
+
+	return nil, false
+}
+
+// ResumeWorkflowEngineSuccessResolver resolves the success response when resuming a workflow engine
+type ResumeWorkflowEngineSuccessResolver struct {
+	app chainlink.Application
+	wf  admin.Workflow
+}
+
+func (r *ResumeWorkflowEngineSuccessResolver) Workflow() *WorkflowResolver {
+	return NewWorkflow(r.app, r.wf)
+}
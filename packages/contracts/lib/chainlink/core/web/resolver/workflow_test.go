@@ -0,0 +1,369 @@
+package resolver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/admin"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+func testWorkflow(f *gqlTestFramework) admin.Workflow {
+	return admin.Workflow{
+		Spec: job.WorkflowSpec{
+			WorkflowID:    "wf-1",
+			WorkflowOwner: "0a0b",
+			WorkflowName:  "workflow-1",
+			Status:        job.WorkflowSpecStatusActive,
+			SpecType:      job.WASMFile,
+			BinaryURL:     "http://example.com/binary",
+			ConfigURL:     "http://example.com/config",
+			CreatedAt:     f.Timestamp(),
+			UpdatedAt:     f.Timestamp(),
+		},
+		Engine: &admin.Engine{
+			WorkflowID: "wf-1",
+			Health:     map[string]error{"WorkflowEngine": errors.New("unhealthy")},
+		},
+	}
+}
+
+func Test_Workflows(t *testing.T) {
+	t.Parallel()
+
+	var (
+		query = `
+			query GetWorkflows {
+				workflows {
+					results {
+						id
+						owner
+						name
+						status
+						specType
+						binaryURL
+						configURL
+						pausedLocally
+						engine {
+							workflowID
+							ready
+							healthy
+							errors {
+								name
+								message
+							}
+						}
+						createdAt
+					}
+					metadata {
+						total
+					}
+				}
+			}`
+	)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "workflows"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("GetWorkflowsService").Return(f.Mocks.workflowsSvc)
+				f.Mocks.workflowsSvc.On("ListWorkflows", mock.Anything, PageDefaultOffset, PageDefaultLimit).
+					Return([]admin.Workflow{testWorkflow(f)}, 1, nil)
+			},
+			query: query,
+			result: `
+			{
+				"workflows": {
+					"results": [{
+						"id": "wf-1",
+						"owner": "0a0b",
+						"name": "workflow-1",
+						"status": "active",
+						"specType": "wasm_file",
+						"binaryURL": "http://example.com/binary",
+						"configURL": "http://example.com/config",
+						"pausedLocally": false,
+						"engine": {
+							"workflowID": "wf-1",
+							"ready": true,
+							"healthy": false,
+							"errors": [{
+								"name": "WorkflowEngine",
+								"message": "unhealthy"
+							}]
+						},
+						"createdAt": "2021-01-01T00:00:00Z"
+					}],
+					"metadata": {
+						"total": 1
+					}
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func Test_Workflow(t *testing.T) {
+	t.Parallel()
+
+	var (
+		query = `
+			query GetWorkflow {
+				workflow(id: "wf-1") {
+					... on Workflow {
+						id
+						name
+						executions {
+							results {
+								id
+								status
+								steps {
+									ref
+									status
+									error
+								}
+							}
+							metadata {
+								total
+							}
+						}
+					}
+					... on NotFoundError {
+						message
+						code
+					}
+				}
+			}`
+	)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "workflow"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("GetWorkflowsService").Return(f.Mocks.workflowsSvc)
+				f.Mocks.workflowsSvc.On("GetWorkflow", mock.Anything, "wf-1").Return(testWorkflow(f), nil)
+				f.Mocks.workflowsSvc.On("ListExecutions", mock.Anything, "wf-1", PageDefaultOffset, PageDefaultLimit).
+					Return([]store.WorkflowExecution{{
+						ExecutionID: "exec-1",
+						WorkflowID:  "wf-1",
+						Status:      store.StatusStarted,
+						Steps: map[string]*store.WorkflowExecutionStep{
+							"trigger": {Ref: "trigger", Status: store.StatusCompleted},
+						},
+					}}, 1, nil)
+			},
+			query: query,
+			result: `
+			{
+				"workflow": {
+					"id": "wf-1",
+					"name": "workflow-1",
+					"executions": {
+						"results": [{
+							"id": "exec-1",
+							"status": "started",
+							"steps": [{
+								"ref": "trigger",
+								"status": "completed",
+								"error": null
+							}]
+						}],
+						"metadata": {
+							"total": 1
+						}
+					}
+				}
+			}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("GetWorkflowsService").Return(f.Mocks.workflowsSvc)
+				f.Mocks.workflowsSvc.On("GetWorkflow", mock.Anything, "wf-1").Return(admin.Workflow{}, sql.ErrNoRows)
+			},
+			query: query,
+			result: `
+			{
+				"workflow": {
+					"message": "workflow not found",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func Test_PauseWorkflowEngine(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mutation = `
+			mutation PauseWorkflowEngine($id: ID!) {
+				pauseWorkflowEngine(id: $id) {
+					... on PauseWorkflowEngineSuccess {
+						workflow {
+							id
+							pausedLocally
+						}
+					}
+					... on WorkflowEngineControlUnavailableError {
+						message
+						code
+					}
+					... on NotFoundError {
+						message
+						code
+					}
+				}
+			}`
+		variables = map[string]interface{}{"id": "wf-1"}
+	)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "pauseWorkflowEngine"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				wf := testWorkflow(f)
+				wf.Engine = nil
+				wf.PausedLocally = true
+				f.App.On("GetWorkflowsService").Return(f.Mocks.workflowsSvc)
+				f.Mocks.workflowsSvc.On("PauseEngine", mock.Anything, "wf-1").Return(wf, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"pauseWorkflowEngine": {
+					"workflow": {
+						"id": "wf-1",
+						"pausedLocally": true
+					}
+				}
+			}`,
+		},
+		{
+			name:          "engine control unavailable",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("GetWorkflowsService").Return(f.Mocks.workflowsSvc)
+				f.Mocks.workflowsSvc.On("PauseEngine", mock.Anything, "wf-1").Return(admin.Workflow{}, admin.ErrEngineControlUnavailable)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"pauseWorkflowEngine": {
+					"message": "` + admin.ErrEngineControlUnavailable.Error() + `",
+					"code": "UNPROCESSABLE"
+				}
+			}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("GetWorkflowsService").Return(f.Mocks.workflowsSvc)
+				f.Mocks.workflowsSvc.On("PauseEngine", mock.Anything, "wf-1").Return(admin.Workflow{}, sql.ErrNoRows)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"pauseWorkflowEngine": {
+					"message": "workflow not found",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func Test_ResumeWorkflowEngine(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mutation = `
+			mutation ResumeWorkflowEngine($id: ID!) {
+				resumeWorkflowEngine(id: $id) {
+					... on ResumeWorkflowEngineSuccess {
+						workflow {
+							id
+							pausedLocally
+						}
+					}
+					... on WorkflowNotActiveError {
+						message
+						code
+					}
+					... on NotFoundError {
+						message
+						code
+					}
+				}
+			}`
+		variables = map[string]interface{}{"id": "wf-1"}
+	)
+
+	notActiveErr := fmt.Errorf("%w: it is paused, its engine will start once it is activated", admin.ErrWorkflowNotActive)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "resumeWorkflowEngine"),
+		{
+			name:          "workflow not active",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("GetWorkflowsService").Return(f.Mocks.workflowsSvc)
+				f.Mocks.workflowsSvc.On("ResumeEngine", mock.Anything, "wf-1").Return(admin.Workflow{}, notActiveErr)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"resumeWorkflowEngine": {
+					"message": "` + notActiveErr.Error() + `",
+					"code": "UNPROCESSABLE"
+				}
+			}`,
+		},
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("GetWorkflowsService").Return(f.Mocks.workflowsSvc)
+				f.Mocks.workflowsSvc.On("ResumeEngine", mock.Anything, "wf-1").Return(testWorkflow(f), nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"resumeWorkflowEngine": {
+					"workflow": {
+						"id": "wf-1",
+						"pausedLocally": false
+					}
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
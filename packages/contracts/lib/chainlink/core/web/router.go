@@ -24,6 +24,7 @@ import (
 	limits "github.com/gin-contrib/size"
 	"github.com/gin-gonic/gin"
 	"github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
 	"github.com/graph-gophers/graphql-go/relay"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -125,10 +126,60 @@ func graphqlHandler(app chainlink.Application) gin.HandlerFunc {
 	h := relay.Handler{Schema: schema}
 
 	return func(c *gin.Context) {
+		if strings.Contains(c.GetHeader("Accept"), "text/event-stream") {
+			serveGraphQLSubscription(c, schema)
+			return
+		}
 		h.ServeHTTP(c.Writer, c.Request)
 	}
 }
 
+// serveGraphQLSubscription executes a subscription and streams its results as server-sent events, following the
+// distinct connections mode of the GraphQL over SSE protocol: each result is sent as a `next` event, and a `complete`
+// event is sent when the subscription ends.
+func serveGraphQLSubscription(c *gin.Context, schema *graphql.Schema) {
+	var params struct {
+		Query         string                 `json:"query"`
+		OperationName string                 `json:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+	if err := json.NewDecoder(c.Request.Body).Decode(&params); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &graphql.Response{Errors: []*gqlerrors.QueryError{{Message: err.Error()}}})
+		return
+	}
+
+	ctx := c.Request.Context()
+	responses, err := schema.Subscribe(ctx, params.Query, params.OperationName, params.Variables)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, &graphql.Response{Errors: []*gqlerrors.QueryError{{Message: err.Error()}}})
+		return
+	}
+
+	// the stream outlives the write timeout of the server
+	_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+	c.Header("X-Accel-Buffering", "no")
+
+	heartbeat := time.NewTicker(eventStreamHeartbeat)
+	defer heartbeat.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-ctx.Done():
+			return false
+		case response, ok := <-responses:
+			if !ok {
+				c.SSEvent("complete", "")
+				return false
+			}
+			c.SSEvent("next", response)
+			return true
+		case <-heartbeat.C:
+			_, err := io.WriteString(w, ": heartbeat\n\n")
+			return err == nil
+		}
+	})
+}
+
 func rateLimiter(period time.Duration, limit int64) gin.HandlerFunc {
 	store := memory.NewStore()
 	rate := limiter.Rate{
@@ -259,6 +310,8 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/external_initiators", paginatedRequest(eia.Index))
 		authv2.POST("/external_initiators", auth.RequiresEditRole(eia.Create))
 		authv2.DELETE("/external_initiators/:Name", auth.RequiresEditRole(eia.Destroy))
+		authv2.GET("/external_initiator_deliveries", paginatedRequest(eia.Deliveries))
+		authv2.POST("/external_initiator_deliveries/replay", auth.RequiresEditRole(eia.ReplayDeliveries))
 
 		bt := BridgeTypesController{app}
 		authv2.GET("/bridge_types", paginatedRequest(bt.Index))
@@ -374,12 +427,26 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
 		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
 		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
+		authv2.POST("/jobs/:ID/pause", auth.RequiresEditRole(jc.Pause))
+		authv2.POST("/jobs/:ID/resume", auth.RequiresEditRole(jc.Resume))
 
 		// PipelineRunsController
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
+		authv2.GET("/pipeline/runs/events", prc.Events)
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
+		authv2.GET("/jobs/:ID/runs/events", prc.Events)
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
 
+		// PipelineFragmentsController
+		pfc := PipelineFragmentsController{app}
+		authv2.GET("/pipeline/fragments", pfc.Index)
+		authv2.GET("/pipeline/fragments/:name/:version", pfc.Show)
+		authv2.POST("/pipeline/fragments", auth.RequiresEditRole(pfc.Create))
+
+		// PipelineSimulationsController
+		psimc := PipelineSimulationsController{app}
+		authv2.POST("/pipeline/simulate", auth.RequiresEditRole(psimc.Create))
+
 		// FeaturesController
 		fc := FeaturesController{app}
 		authv2.GET("/features", fc.Index)
@@ -415,6 +482,16 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/nodes/evm/forwarders/track", auth.RequiresEditRole(efc.Track))
 		authv2.DELETE("/nodes/evm/forwarders/:fwdID", auth.RequiresEditRole(efc.Delete))
 
+		wfc := WorkflowsController{app}
+		authv2.GET("/workflows", paginatedRequest(wfc.Index))
+		authv2.GET("/workflows/:ID", wfc.Show)
+		authv2.GET("/workflows/:ID/executions", paginatedRequest(wfc.Executions))
+		authv2.POST("/workflows/:ID/pause", auth.RequiresAdminRole(wfc.Pause))
+		authv2.POST("/workflows/:ID/resume", auth.RequiresAdminRole(wfc.Resume))
+
+		capc := CapabilitiesController{app}
+		authv2.GET("/capabilities", capc.Index)
+
 		buildInfo := BuildInfoController{app}
 		authv2.GET("/build_info", buildInfo.Show)
 
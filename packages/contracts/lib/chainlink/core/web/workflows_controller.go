@@ -0,0 +1,107 @@
+package web
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/admin"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// WorkflowsController exposes the workflows of the node and the state of their engines.
+type WorkflowsController struct {
+	App chainlink.Application
+}
+
+// Index lists workflows, one page at a time.
+// Example:
+// "GET <application>/workflows"
+func (wc *WorkflowsController) Index(c *gin.Context, size, page, offset int) {
+	workflows, count, err := wc.App.GetWorkflowsService().ListWorkflows(c.Request.Context(), offset, size)
+
+	paginatedResponse(c, "workflows", size, page, presenters.NewWorkflowResources(workflows), count, err)
+}
+
+// Show returns the details of a workflow and its engine.
+// Example:
+// "GET <application>/workflows/:ID"
+func (wc *WorkflowsController) Show(c *gin.Context) {
+	workflow, err := wc.App.GetWorkflowsService().GetWorkflow(c.Request.Context(), c.Param("ID"))
+	if err != nil {
+		wc.workflowError(c, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewWorkflowResource(workflow), "workflow")
+}
+
+// Executions lists the executions of a workflow from newest to oldest, one page at a time.
+// Example:
+// "GET <application>/workflows/:ID/executions"
+func (wc *WorkflowsController) Executions(c *gin.Context, size, page, offset int) {
+	executions, count, err := wc.App.GetWorkflowsService().ListExecutions(c.Request.Context(), c.Param("ID"), offset, size)
+
+	paginatedResponse(c, "workflowExecutions", size, page, presenters.NewWorkflowExecutionResources(executions), count, err)
+}
+
+// Pause stops the engine of a workflow on this node only.
+// Example:
+// "POST <application>/workflows/:ID/pause"
+func (wc *WorkflowsController) Pause(c *gin.Context) {
+	workflow, err := wc.App.GetWorkflowsService().PauseEngine(c.Request.Context(), c.Param("ID"))
+	if err != nil {
+		wc.workflowError(c, err)
+		return
+	}
+
+	wc.App.GetAuditLogger().Audit(audit.WorkflowEnginePaused, map[string]interface{}{"workflowID": workflow.Spec.WorkflowID})
+	jsonAPIResponse(c, presenters.NewWorkflowResource(workflow), "workflow")
+}
+
+// Resume starts the engine of a workflow previously paused on this node.
+// Example:
+// "POST <application>/workflows/:ID/resume"
+func (wc *WorkflowsController) Resume(c *gin.Context) {
+	workflow, err := wc.App.GetWorkflowsService().ResumeEngine(c.Request.Context(), c.Param("ID"))
+	if err != nil {
+		wc.workflowError(c, err)
+		return
+	}
+
+	wc.App.GetAuditLogger().Audit(audit.WorkflowEngineResumed, map[string]interface{}{"workflowID": workflow.Spec.WorkflowID})
+	jsonAPIResponse(c, presenters.NewWorkflowResource(workflow), "workflow")
+}
+
+func (wc *WorkflowsController) workflowError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		jsonAPIError(c, http.StatusNotFound, errors.New("workflow not found"))
+	case errors.Is(err, admin.ErrEngineControlUnavailable), errors.Is(err, admin.ErrWorkflowNotActive):
+		jsonAPIError(c, http.StatusConflict, err)
+	default:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+	}
+}
+
+// CapabilitiesController lists the capabilities known to the node.
+type CapabilitiesController struct {
+	App chainlink.Application
+}
+
+// Index lists the local and remote capabilities.
+// Example:
+// "GET <application>/capabilities"
+func (cc *CapabilitiesController) Index(c *gin.Context) {
+	infos, err := cc.App.GetWorkflowsService().ListCapabilities(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewCapabilityResources(infos), "capabilities")
+}
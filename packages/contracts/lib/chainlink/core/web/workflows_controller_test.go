@@ -0,0 +1,130 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/artifacts"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func setupWorkflowsControllerTest(t *testing.T) (*cltest.TestApplication, cltest.HTTPClientCleaner) {
+	t.Helper()
+	ctx := testutils.Context(t)
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	lggr := logger.TestLogger(t)
+	_, err := artifacts.NewWorkflowRegistryDS(app.GetDB(), lggr).UpsertWorkflowSpec(ctx, &job.WorkflowSpec{
+		Workflow:      "test_workflow",
+		WorkflowID:    "wf-1",
+		WorkflowOwner: "0a0b",
+		WorkflowName:  "workflow-1",
+		Status:        job.WorkflowSpecStatusActive,
+		BinaryURL:     "http://example.com/binary",
+		CreatedAt:     time.Now(),
+		SpecType:      job.WASMFile,
+	})
+	require.NoError(t, err)
+
+	executions := store.NewDBStore(app.GetDB(), lggr, clockwork.NewRealClock())
+	_, err = executions.Add(ctx, map[string]*store.WorkflowExecutionStep{
+		"trigger": {ExecutionID: "exec-1", Ref: "trigger", Status: store.StatusCompleted},
+	}, "exec-1", "wf-1", store.StatusStarted)
+	require.NoError(t, err)
+
+	return app, app.NewHTTPClient(nil)
+}
+
+func TestWorkflowsController_Index(t *testing.T) {
+	_, client := setupWorkflowsControllerTest(t)
+
+	resp, cleanup := client.Get("/v2/workflows?size=10")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	body := cltest.ParseResponseBody(t, resp)
+	count, err := cltest.ParseJSONAPIResponseMetaCount(body)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	var resources []presenters.WorkflowResource
+	require.NoError(t, web.ParseJSONAPIResponse(body, &resources))
+	require.Len(t, resources, 1)
+	assert.Equal(t, "wf-1", resources[0].ID)
+	assert.Equal(t, "workflow-1", resources[0].Name)
+	assert.Equal(t, "0a0b", resources[0].Owner)
+	assert.Equal(t, job.WorkflowSpecStatusActive, resources[0].Status)
+	assert.Nil(t, resources[0].Engine)
+}
+
+func TestWorkflowsController_Show(t *testing.T) {
+	_, client := setupWorkflowsControllerTest(t)
+
+	resp, cleanup := client.Get("/v2/workflows/wf-1")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var resource presenters.WorkflowResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resource))
+	assert.Equal(t, "workflow-1", resource.Name)
+	assert.Equal(t, "http://example.com/binary", resource.BinaryURL)
+
+	resp, cleanup = client.Get("/v2/workflows/unknown")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestWorkflowsController_Executions(t *testing.T) {
+	_, client := setupWorkflowsControllerTest(t)
+
+	resp, cleanup := client.Get("/v2/workflows/wf-1/executions?size=10")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var resources []presenters.WorkflowExecutionResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resources))
+	require.Len(t, resources, 1)
+	assert.Equal(t, "exec-1", resources[0].ID)
+	assert.Equal(t, store.StatusStarted, resources[0].Status)
+	require.Len(t, resources[0].Steps, 1)
+	assert.Equal(t, "trigger", resources[0].Steps[0].Ref)
+	assert.Equal(t, store.StatusCompleted, resources[0].Steps[0].Status)
+}
+
+func TestWorkflowsController_PauseResume(t *testing.T) {
+	_, client := setupWorkflowsControllerTest(t)
+
+	// The workflow registry syncer is not enabled in the test application
+	resp, cleanup := client.Post("/v2/workflows/wf-1/pause", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusConflict)
+
+	resp, cleanup = client.Post("/v2/workflows/wf-1/resume", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusConflict)
+}
+
+func TestCapabilitiesController_Index(t *testing.T) {
+	_, client := setupWorkflowsControllerTest(t)
+
+	resp, cleanup := client.Get("/v2/capabilities")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var resources []presenters.CapabilityResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resources))
+	assert.Empty(t, resources)
+}